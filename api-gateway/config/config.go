@@ -1,6 +1,10 @@
 package config
 
 import (
+	"api-gateway/internal/media"
+	"pkg/mtls"
+	"pkg/tracing"
+
 	"github.com/spf13/viper"
 )
 
@@ -8,7 +12,29 @@ type Config struct {
 	SERVER_PORT          string `mapstructure:"SERVER_PORT"`
 	SERVER_NAME          string `mapstructure:"SERVER_NAME"`
 	SERVER_ENV           string `mapstructure:"SERVER_ENV"`
-	JWT_SECRET           string `mapstructure:"JWT_SECRET"`
+	LOG_LEVEL            string `mapstructure:"LOG_LEVEL"`
+	GRPC_TLS_MODE        string `mapstructure:"GRPC_TLS_MODE"`
+	GRPC_TLS_CA          string `mapstructure:"GRPC_TLS_CA"`
+	GRPC_TLS_CERT        string `mapstructure:"GRPC_TLS_CERT"`
+	GRPC_TLS_KEY         string `mapstructure:"GRPC_TLS_KEY"`
+	GRPC_ALLOWED_CALLERS string `mapstructure:"GRPC_ALLOWED_CALLERS"`
+	OTEL_TRACES_EXPORTER string `mapstructure:"OTEL_TRACES_EXPORTER"`
+	OTEL_EXPORTER_OTLP_ENDPOINT string `mapstructure:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+	OTEL_EXPORTER_OTLP_INSECURE bool `mapstructure:"OTEL_EXPORTER_OTLP_INSECURE"`
+	OTEL_TRACES_SAMPLER_ARG string `mapstructure:"OTEL_TRACES_SAMPLER_ARG"`
+	REDIS_HOST           string `mapstructure:"REDIS_HOST"`
+	REDIS_PORT           string `mapstructure:"REDIS_PORT"`
+	RATE_LIMIT_DEFAULT   string `mapstructure:"RATE_LIMIT_DEFAULT"`
+	RATE_LIMIT_ROUTES    string `mapstructure:"RATE_LIMIT_ROUTES"`
+	MINIO_ENDPOINT       string `mapstructure:"MINIO_ENDPOINT"`
+	MINIO_ACCESS_KEY     string `mapstructure:"MINIO_ACCESS_KEY"`
+	MINIO_SECRET_KEY     string `mapstructure:"MINIO_SECRET_KEY"`
+	MINIO_BUCKET         string `mapstructure:"MINIO_BUCKET"`
+	MINIO_USE_SSL        bool   `mapstructure:"MINIO_USE_SSL"`
+	BLOB_BACKEND         string `mapstructure:"BLOB_BACKEND"`
+	BLOB_LOCAL_DIR       string `mapstructure:"BLOB_LOCAL_DIR"`
+	BLOB_PUBLIC_URL      string `mapstructure:"BLOB_PUBLIC_URL"`
+	BLOB_SIGNING_KEY     string `mapstructure:"BLOB_SIGNING_KEY"`
 	TWEET_SERVER_NAME   string `mapstructure:"TWEET_SERVER_NAME"`
 	TWEET_SERVER_PORT   string `mapstructure:"TWEET_SERVER_PORT"`
 	LIKE_SERVER_NAME     string `mapstructure:"LIKE_SERVER_NAME"`
@@ -17,6 +43,8 @@ type Config struct {
 	COMMENT_SERVER_PORT  string `mapstructure:"COMMENT_SERVER_PORT"`
 	DIRECT_SERVER_NAME   string `mapstructure:"DIRECT_SERVER_NAME"`
 	DIRECT_SERVER_PORT   string `mapstructure:"DIRECT_SERVER_PORT"`
+	NOTIFICATION_SERVER_NAME string `mapstructure:"NOTIFICATION_SERVER_NAME"`
+	NOTIFICATION_SERVER_PORT string `mapstructure:"NOTIFICATION_SERVER_PORT"`
 	USER_SERVER_NAME     string `mapstructure:"USER_SERVER_NAME"`
 	USER_SERVER_PORT     string `mapstructure:"USER_SERVER_PORT"`
 }
@@ -41,3 +69,41 @@ func LoadConfig() (Config, error) {
 
 	return config, nil
 }
+
+// MTLS servislararo gRPC ulanishlari uchun TLS sozlamalari
+func (c Config) MTLS() mtls.Config {
+	return mtls.Config{
+		Mode:           c.GRPC_TLS_MODE,
+		CAFile:         c.GRPC_TLS_CA,
+		CertFile:       c.GRPC_TLS_CERT,
+		KeyFile:        c.GRPC_TLS_KEY,
+		AllowedCallers: mtls.ParseIdentities(c.GRPC_ALLOWED_CALLERS),
+	}
+}
+
+// Tracing OpenTelemetry exporter sozlamalari
+func (c Config) Tracing() tracing.Config {
+	return tracing.Config{
+		ServiceName: c.SERVER_NAME,
+		Environment: c.SERVER_ENV,
+		Exporter:    c.OTEL_TRACES_EXPORTER,
+		Endpoint:    c.OTEL_EXPORTER_OTLP_ENDPOINT,
+		Insecure:    c.OTEL_EXPORTER_OTLP_INSECURE,
+		SampleRatio: c.OTEL_TRACES_SAMPLER_ARG,
+	}
+}
+
+// Blob media fayllar saqlanadigan backend sozlamalari
+func (c Config) Blob() media.BlobConfig {
+	return media.BlobConfig{
+		Backend:        c.BLOB_BACKEND,
+		LocalDir:       c.BLOB_LOCAL_DIR,
+		PublicURL:      c.BLOB_PUBLIC_URL,
+		SigningKey:     c.BLOB_SIGNING_KEY,
+		MinioEndpoint:  c.MINIO_ENDPOINT,
+		MinioAccessKey: c.MINIO_ACCESS_KEY,
+		MinioSecretKey: c.MINIO_SECRET_KEY,
+		MinioBucket:    c.MINIO_BUCKET,
+		MinioUseSSL:    c.MINIO_USE_SSL,
+	}
+}
@@ -0,0 +1,157 @@
+// Package apierror gateway ning barcha xato javoblarini bitta JSON ko'rinishida
+// qaytaradi. Downstream gRPC status kodlari HTTP kodlarga aylantiriladi, servis
+// ErrorInfo detail ida yuborgan reason esa barqaror "code" maydoni bo'ladi.
+package apierror
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"unicode"
+
+	"pkg/requestid"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StatusClientClosedRequest mijoz so'rovni bekor qilganda (nginx dagi kabi)
+const StatusClientClosedRequest = 499
+
+// FieldError noto'g'ri maydon haqida ma'lumot
+type FieldError struct {
+	Field       string `json:"field" example:"email"`
+	Description string `json:"description" example:"either email or phone must be provided"`
+}
+
+// ErrorResponse represents an error response
+type ErrorResponse struct {
+	Code      string       `json:"code" example:"USER_NOT_FOUND"`
+	Message   string       `json:"message" example:"user not found"`
+	RequestID string       `json:"request_id,omitempty" example:"4f9c1e0a7b2d4c3e8f6a5b4c3d2e1f00"`
+	Fields    []FieldError `json:"fields,omitempty"`
+	// Debug faqat SERVER_ENV=dev bo'lganda to'ldiriladi
+	Debug string `json:"debug,omitempty"`
+}
+
+var devMode atomic.Bool
+
+// SetDevMode yoqilganda 5xx xabarlari va ichki tafsilotlar javobga qo'shiladi
+func SetDevMode(enabled bool) {
+	devMode.Store(enabled)
+}
+
+// Abort gateway ning o'zi aniqlagan xato bilan so'rovni to'xtatadi
+func Abort(c *gin.Context, httpStatus int, code, message string) {
+	c.AbortWithStatusJSON(httpStatus, newResponse(c, code, message))
+}
+
+// BadRequest so'rov parametrlari noto'g'ri bo'lganda
+func BadRequest(c *gin.Context, code, message string) {
+	Abort(c, http.StatusBadRequest, code, message)
+}
+
+// FromError downstream chaqiruv xatosini HTTP javobga aylantiradi. Status bo'lmagan
+// xatolar (masalan MinIO) 500 bo'ladi.
+func FromError(c *gin.Context, err error) {
+	httpStatus, resp := Convert(c.Request.Context(), err)
+	_ = c.Error(err)
+	c.AbortWithStatusJSON(httpStatus, resp)
+}
+
+// Convert xatoni HTTP status va javob tanasiga aylantiradi. GraphQL xatolari ham
+// shu qoidalar bilan code va message oladi.
+func Convert(ctx context.Context, err error) (int, ErrorResponse) {
+	st := status.Convert(err)
+	httpStatus := HTTPStatus(st.Code())
+
+	resp := ErrorResponse{
+		Code:      codeName(st.Code()),
+		Message:   st.Message(),
+		RequestID: requestid.FromContext(ctx),
+	}
+	var debug []string
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			if d.Reason != "" {
+				resp.Code = d.Reason
+			}
+		case *errdetails.BadRequest:
+			for _, v := range d.FieldViolations {
+				resp.Fields = append(resp.Fields, FieldError{Field: v.Field, Description: v.Description})
+			}
+		case *errdetails.DebugInfo:
+			debug = append(debug, d.Detail)
+		}
+	}
+
+	if httpStatus >= http.StatusInternalServerError {
+		if len(debug) == 0 {
+			debug = append(debug, err.Error())
+		}
+		if !devMode.Load() {
+			resp.Message = http.StatusText(httpStatus)
+		}
+	}
+	if devMode.Load() && len(debug) > 0 {
+		resp.Debug = strings.Join(debug, "; ")
+	}
+	return httpStatus, resp
+}
+
+// HTTPStatus gRPC kodiga mos HTTP status
+func HTTPStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return StatusClientClosedRequest
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func newResponse(c *gin.Context, code, message string) ErrorResponse {
+	return ErrorResponse{
+		Code:      code,
+		Message:   message,
+		RequestID: requestid.FromContext(c.Request.Context()),
+	}
+}
+
+// codeName servis reason yubormaganda gRPC kod nomidan code yasaydi: NotFound -> NOT_FOUND
+func codeName(code codes.Code) string {
+	if code == codes.Unknown {
+		return "INTERNAL"
+	}
+	var b strings.Builder
+	for i, r := range code.String() {
+		if i > 0 && unicode.IsUpper(r) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
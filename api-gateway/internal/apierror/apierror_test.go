@@ -0,0 +1,86 @@
+package apierror
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func withDetails(t *testing.T, code codes.Code, message string, details ...*errdetails.ErrorInfo) error {
+	t.Helper()
+	st := status.New(code, message)
+	for _, d := range details {
+		var err error
+		if st, err = st.WithDetails(d); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return st.Err()
+}
+
+func TestConvert(t *testing.T) {
+	validation, err := status.New(codes.InvalidArgument, "either email or phone must be provided").WithDetails(
+		&errdetails.ErrorInfo{Reason: "EMAIL_OR_PHONE_REQUIRED"},
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: "email", Description: "either email or phone must be provided"}}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+		wantMsg    string
+		wantFields int
+	}{
+		{"reason from ErrorInfo", withDetails(t, codes.PermissionDenied, "user has blocked you", &errdetails.ErrorInfo{Reason: "BLOCKED_BY_USER"}),
+			http.StatusForbidden, "BLOCKED_BY_USER", "user has blocked you", 0},
+		{"code name without reason", status.Error(codes.NotFound, "not found"), http.StatusNotFound, "NOT_FOUND", "not found", 0},
+		{"field violations", validation.Err(), http.StatusBadRequest, "EMAIL_OR_PHONE_REQUIRED", "either email or phone must be provided", 1},
+		{"internal message is hidden", status.Error(codes.Internal, "pq: relation does not exist"),
+			http.StatusInternalServerError, "INTERNAL", "Internal Server Error", 0},
+		{"plain error", errors.New("minio: connection refused"), http.StatusInternalServerError, "INTERNAL", "Internal Server Error", 0},
+		{"canceled", status.Error(codes.Canceled, "context canceled"), StatusClientClosedRequest, "CANCELED", "context canceled", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpStatus, resp := Convert(context.Background(), tt.err)
+			if httpStatus != tt.wantStatus || resp.Code != tt.wantCode || resp.Message != tt.wantMsg || len(resp.Fields) != tt.wantFields {
+				t.Errorf("Convert = %d %+v, want %d %s %q with %d fields", httpStatus, resp, tt.wantStatus, tt.wantCode, tt.wantMsg, tt.wantFields)
+			}
+			if resp.Debug != "" {
+				t.Errorf("debug %q leaked outside dev mode", resp.Debug)
+			}
+		})
+	}
+}
+
+func TestConvertDevMode(t *testing.T) {
+	SetDevMode(true)
+	t.Cleanup(func() { SetDevMode(false) })
+
+	_, resp := Convert(context.Background(), status.Error(codes.Internal, "pq: relation does not exist"))
+	if resp.Message != "pq: relation does not exist" || resp.Debug == "" {
+		t.Errorf("dev mode response %+v, want the internal message and debug", resp)
+	}
+}
+
+func TestCodeName(t *testing.T) {
+	for code, want := range map[codes.Code]string{
+		codes.InvalidArgument:   "INVALID_ARGUMENT",
+		codes.ResourceExhausted: "RESOURCE_EXHAUSTED",
+		codes.Unauthenticated:   "UNAUTHENTICATED",
+		codes.Unknown:           "INTERNAL",
+	} {
+		if got := codeName(code); got != want {
+			t.Errorf("codeName(%s) = %s, want %s", code, got, want)
+		}
+	}
+}
@@ -3,20 +3,29 @@ package comment_clients
 import (
 	"api-gateway/config"
 	proto "api-gateway/protos/comment-proto"
-	"log"
+	"pkg/grpcclient"
+	"pkg/logger"
+	"pkg/metrics"
+	"pkg/mtls"
+	"pkg/requestid"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
-func DialCommentGrpc() proto.CommentServiceClient {
+// DialCommentGrpc client va uning ulanishini qaytaradi, ulanish health check uchun kerak
+func DialCommentGrpc() (proto.CommentServiceClient, *grpc.ClientConn) {
 	conf, err := config.LoadConfig()
 	if err != nil {
-		log.Fatal("failed to load config:", err)
+		logger.Fatal("failed to load config", "error", err)
 	}
-	conn, err := grpc.NewClient(conf.COMMENT_SERVER_NAME + ":" + conf.COMMENT_SERVER_PORT, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	creds, err := mtls.ClientCredentials(conf.MTLS(), conf.COMMENT_SERVER_NAME)
 	if err != nil {
-		log.Fatal("failed to dial grpc client Comment:", err)
+		logger.Fatal("failed to load TLS credentials", "error", err)
 	}
-	return proto.NewCommentServiceClient(conn)
+	conn, err := grpcclient.Dial(conf.COMMENT_SERVER_NAME + ":" + conf.COMMENT_SERVER_PORT, creds, grpcclient.DefaultOptions,
+		grpc.WithChainUnaryInterceptor(requestid.UnaryClientInterceptor(), logger.UnaryClientInterceptor(), metrics.UnaryClientInterceptor()))
+	if err != nil {
+		logger.Fatal("failed to dial comment service", "error", err)
+	}
+	return proto.NewCommentServiceClient(conn), conn
 }
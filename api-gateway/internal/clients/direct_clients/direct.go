@@ -3,20 +3,29 @@ package direct_clients
 import (
 	"api-gateway/config"
 	proto "api-gateway/protos/direct-proto"
-	"log"
+	"pkg/grpcclient"
+	"pkg/logger"
+	"pkg/metrics"
+	"pkg/mtls"
+	"pkg/requestid"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
-func DialDirectGrpc() proto.DirectServiceClient {
+// DialDirectGrpc client va uning ulanishini qaytaradi, ulanish health check uchun kerak
+func DialDirectGrpc() (proto.DirectServiceClient, *grpc.ClientConn) {
 	conf, err := config.LoadConfig()
 	if err != nil {
-		log.Fatal("failed to load config:", err)
+		logger.Fatal("failed to load config", "error", err)
 	}
-	conn, err := grpc.NewClient(conf.DIRECT_SERVER_NAME + ":" + conf.DIRECT_SERVER_PORT, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	creds, err := mtls.ClientCredentials(conf.MTLS(), conf.DIRECT_SERVER_NAME)
 	if err != nil {
-		log.Fatal("failed to dial grpc client Direct:", err)
+		logger.Fatal("failed to load TLS credentials", "error", err)
 	}
-	return proto.NewDirectServiceClient(conn)
+	conn, err := grpcclient.Dial(conf.DIRECT_SERVER_NAME + ":" + conf.DIRECT_SERVER_PORT, creds, grpcclient.DefaultOptions,
+		grpc.WithChainUnaryInterceptor(requestid.UnaryClientInterceptor(), logger.UnaryClientInterceptor(), metrics.UnaryClientInterceptor()))
+	if err != nil {
+		logger.Fatal("failed to dial direct service", "error", err)
+	}
+	return proto.NewDirectServiceClient(conn), conn
 }
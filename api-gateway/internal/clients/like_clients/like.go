@@ -3,20 +3,29 @@ package like_clients
 import (
 	"api-gateway/config"
 	proto "api-gateway/protos/like-proto"
-	"log"
+	"pkg/grpcclient"
+	"pkg/logger"
+	"pkg/metrics"
+	"pkg/mtls"
+	"pkg/requestid"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
-func DialLikeGrpc() proto.LikeServiceClient {
+// DialLikeGrpc client va uning ulanishini qaytaradi, ulanish health check uchun kerak
+func DialLikeGrpc() (proto.LikeServiceClient, *grpc.ClientConn) {
 	conf, err := config.LoadConfig()
 	if err != nil {
-		log.Fatal("failed to load config:", err)
+		logger.Fatal("failed to load config", "error", err)
 	}
-	conn, err := grpc.NewClient(conf.LIKE_SERVER_NAME + ":" + conf.LIKE_SERVER_PORT, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	creds, err := mtls.ClientCredentials(conf.MTLS(), conf.LIKE_SERVER_NAME)
 	if err != nil {
-		log.Fatal("failed to dial grpc client Like:", err)
+		logger.Fatal("failed to load TLS credentials", "error", err)
 	}
-	return proto.NewLikeServiceClient(conn)
+	conn, err := grpcclient.Dial(conf.LIKE_SERVER_NAME + ":" + conf.LIKE_SERVER_PORT, creds, grpcclient.DefaultOptions,
+		grpc.WithChainUnaryInterceptor(requestid.UnaryClientInterceptor(), logger.UnaryClientInterceptor(), metrics.UnaryClientInterceptor()))
+	if err != nil {
+		logger.Fatal("failed to dial like service", "error", err)
+	}
+	return proto.NewLikeServiceClient(conn), conn
 }
@@ -0,0 +1,31 @@
+package notification_clients
+
+import (
+	"api-gateway/config"
+	proto "api-gateway/protos/notification-proto"
+	"pkg/grpcclient"
+	"pkg/logger"
+	"pkg/metrics"
+	"pkg/mtls"
+	"pkg/requestid"
+
+	"google.golang.org/grpc"
+)
+
+// DialNotificationGrpc client va uning ulanishini qaytaradi, ulanish health check uchun kerak
+func DialNotificationGrpc() (proto.WebhookServiceClient, *grpc.ClientConn) {
+	conf, err := config.LoadConfig()
+	if err != nil {
+		logger.Fatal("failed to load config", "error", err)
+	}
+	creds, err := mtls.ClientCredentials(conf.MTLS(), conf.NOTIFICATION_SERVER_NAME)
+	if err != nil {
+		logger.Fatal("failed to load TLS credentials", "error", err)
+	}
+	conn, err := grpcclient.Dial(conf.NOTIFICATION_SERVER_NAME + ":" + conf.NOTIFICATION_SERVER_PORT, creds, grpcclient.DefaultOptions,
+		grpc.WithChainUnaryInterceptor(requestid.UnaryClientInterceptor(), logger.UnaryClientInterceptor(), metrics.UnaryClientInterceptor()))
+	if err != nil {
+		logger.Fatal("failed to dial notification service", "error", err)
+	}
+	return proto.NewWebhookServiceClient(conn), conn
+}
@@ -3,20 +3,29 @@ package tweet_clients
 import (
 	"api-gateway/config"
 	proto "api-gateway/protos/tweet-proto"
-	"log"
+	"pkg/grpcclient"
+	"pkg/logger"
+	"pkg/metrics"
+	"pkg/mtls"
+	"pkg/requestid"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
-func DialTweetGrpc() proto.TweetServiceClient {
+// DialTweetGrpc client va uning ulanishini qaytaradi, ulanish health check uchun kerak
+func DialTweetGrpc() (proto.TweetServiceClient, *grpc.ClientConn) {
 	conf, err := config.LoadConfig()
 	if err != nil {
-		log.Fatal("failed to load config:", err)
+		logger.Fatal("failed to load config", "error", err)
 	}
-	conn, err := grpc.NewClient(conf.TWEET_SERVER_NAME + ":" + conf.TWEET_SERVER_PORT, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	creds, err := mtls.ClientCredentials(conf.MTLS(), conf.TWEET_SERVER_NAME)
 	if err != nil {
-		log.Fatal("failed to dial grpc client Tweet:", err)
+		logger.Fatal("failed to load TLS credentials", "error", err)
 	}
-	return proto.NewTweetServiceClient(conn)
+	conn, err := grpcclient.Dial(conf.TWEET_SERVER_NAME + ":" + conf.TWEET_SERVER_PORT, creds, grpcclient.DefaultOptions,
+		grpc.WithChainUnaryInterceptor(requestid.UnaryClientInterceptor(), logger.UnaryClientInterceptor(), metrics.UnaryClientInterceptor()))
+	if err != nil {
+		logger.Fatal("failed to dial tweet service", "error", err)
+	}
+	return proto.NewTweetServiceClient(conn), conn
 }
@@ -3,20 +3,29 @@ package user_clients
 import (
 	"api-gateway/config"
 	proto "api-gateway/protos/user-proto"
-	"log"
+	"pkg/grpcclient"
+	"pkg/logger"
+	"pkg/metrics"
+	"pkg/mtls"
+	"pkg/requestid"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
-func DialUserGrpc() proto.UserServiceClient {
+// DialUserGrpc client va uning ulanishini qaytaradi, ulanish health check uchun kerak
+func DialUserGrpc() (proto.UserServiceClient, *grpc.ClientConn) {
 	conf, err := config.LoadConfig()
 	if err != nil {
-		log.Fatal("failed to load config:", err)
+		logger.Fatal("failed to load config", "error", err)
 	}
-	conn, err := grpc.NewClient(conf.USER_SERVER_NAME + ":" + conf.USER_SERVER_PORT, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	creds, err := mtls.ClientCredentials(conf.MTLS(), conf.USER_SERVER_NAME)
 	if err != nil {
-		log.Fatal("failed to dial grpc client User:", err)
+		logger.Fatal("failed to load TLS credentials", "error", err)
 	}
-	return proto.NewUserServiceClient(conn)
+	conn, err := grpcclient.Dial(conf.USER_SERVER_NAME + ":" + conf.USER_SERVER_PORT, creds, grpcclient.DefaultOptions,
+		grpc.WithChainUnaryInterceptor(requestid.UnaryClientInterceptor(), logger.UnaryClientInterceptor(), metrics.UnaryClientInterceptor()))
+	if err != nil {
+		logger.Fatal("failed to dial user service", "error", err)
+	}
+	return proto.NewUserServiceClient(conn), conn
 }
@@ -0,0 +1,183 @@
+// Package graphql gateway ning /graphql endpointi. Resolverlar REST handlerlar
+// ishlatadigan gRPC clientlarni chaqiradi; har bir so'rov uchun alohida
+// dataloaderlar user va tweet so'rovlarini yig'ib, takrorlarini olib tashlaydi
+// va GetUsersByIds / GetTweetsByIds bilan bitta chaqiruvda oladi. Xatolar REST
+// dagi code va message qoidalari bilan qaytadi.
+package graphql
+
+import (
+	"context"
+	_ "embed"
+	"net/http"
+
+	"api-gateway/internal/apierror"
+	"api-gateway/internal/jwt"
+	commentproto "api-gateway/protos/comment-proto"
+	directproto "api-gateway/protos/direct-proto"
+	likeproto "api-gateway/protos/like-proto"
+	tweetproto "api-gateway/protos/tweet-proto"
+	userproto "api-gateway/protos/user-proto"
+
+	"pkg/grpcerr"
+
+	"github.com/gin-gonic/gin"
+	graphqlgo "github.com/graph-gophers/graphql-go"
+)
+
+//go:embed schema.graphql
+var schema string
+
+const (
+	// maxDepth tweet -> comments -> author -> tweets ... kabi chuqur so'rovlarni cheklaydi
+	maxDepth = 8
+	// maxQueryLength baytlarda
+	maxQueryLength = 8 << 10
+	// maxParallelism bitta so'rovda bir vaqtda ishlaydigan resolverlar
+	maxParallelism = 20
+)
+
+// Clients resolverlar chaqiradigan downstream servislar
+type Clients struct {
+	User    userproto.UserServiceClient
+	Tweet   tweetproto.TweetServiceClient
+	Like    likeproto.LikeServiceClient
+	Comment commentproto.CommentServiceClient
+	Direct  directproto.DirectServiceClient
+}
+
+// Request GraphQL so'rov tanasi
+type Request struct {
+	Query         string                 `json:"query" example:"{ me { id username } }"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// Handler /graphql so'rovlarini bajaradi
+type Handler struct {
+	schema  *graphqlgo.Schema
+	clients Clients
+}
+
+// NewHandler sxemani resolverlar bilan tekshiradi, mos kelmasa panic qiladi
+func NewHandler(clients Clients) *Handler {
+	return &Handler{
+		schema: graphqlgo.MustParseSchema(schema, &Resolver{clients: clients},
+			graphqlgo.MaxDepth(maxDepth),
+			graphqlgo.MaxQueryLength(maxQueryLength),
+			graphqlgo.MaxParallelism(maxParallelism),
+		),
+		clients: clients,
+	}
+}
+
+// Serve - GraphQL so'rovini bajarish
+func (h *Handler) Serve(c *gin.Context) {
+	var req Request
+	if err := c.ShouldBindJSON(&req); err != nil || req.Query == "" {
+		apierror.BadRequest(c, "INVALID_REQUEST", "Invalid GraphQL request")
+		return
+	}
+
+	ctx := h.withScope(c.Request.Context(), jwt.CurrentUser(c))
+	resp := h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+	// resolver xatolari REST dagi kabi so'rov logiga tushadi
+	for _, err := range resp.Errors {
+		if err.ResolverError != nil {
+			_ = c.Error(err.ResolverError)
+		}
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// scope bitta GraphQL so'rovining holati: chaqiruvchi va uning dataloaderlari
+type scope struct {
+	caller jwt.Principal
+	users  *Loader[int32, *userproto.User]
+	tweets *Loader[int32, *tweetproto.Tweet]
+}
+
+type scopeKey struct{}
+
+// Batch javobida yo'q kalitlar uchun: topilmagan yoki chaqiruvchi ko'ra
+// olmaydigan user va tweetlar.
+var (
+	errUserNotFound  = grpcerr.NotFound("USER_NOT_FOUND", "user not found")
+	errTweetNotFound = grpcerr.NotFound("TWEET_NOT_FOUND", "tweet not found")
+)
+
+func (h *Handler) withScope(ctx context.Context, caller jwt.Principal) context.Context {
+	s := &scope{caller: caller}
+	s.users = newLoader(ctx, fetchBatch(func(ctx context.Context, ids []int32) ([]*userproto.User, error) {
+		resp, err := h.clients.User.GetUsersByIds(ctx, &userproto.GetUsersByIdsRequest{UserIds: ids, ViewerId: caller.UserID})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Users, nil
+	}, (*userproto.User).GetId, errUserNotFound))
+	s.tweets = newLoader(ctx, fetchBatch(func(ctx context.Context, ids []int32) ([]*tweetproto.Tweet, error) {
+		resp, err := h.clients.Tweet.GetTweetsByIds(ctx, &tweetproto.GetTweetsByIdsRequest{TweetIds: ids, ViewerId: caller.UserID})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Tweets, nil
+	}, (*tweetproto.Tweet).GetId, errTweetNotFound))
+	return context.WithValue(ctx, scopeKey{}, s)
+}
+
+func scopeFrom(ctx context.Context) *scope {
+	return ctx.Value(scopeKey{}).(*scope)
+}
+
+// resolverError gRPC xatosini REST javobidagi code, status va fields bilan
+// GraphQL xatosining extensions maydoniga o'tkazadi
+type resolverError struct {
+	err    error
+	status int
+	resp   apierror.ErrorResponse
+}
+
+func (e *resolverError) Error() string {
+	return e.resp.Message
+}
+
+func (e *resolverError) Unwrap() error {
+	return e.err
+}
+
+func (e *resolverError) Extensions() map[string]interface{} {
+	ext := map[string]interface{}{
+		"code":   e.resp.Code,
+		"status": e.status,
+	}
+	if len(e.resp.Fields) > 0 {
+		ext["fields"] = e.resp.Fields
+	}
+	if e.resp.Debug != "" {
+		ext["debug"] = e.resp.Debug
+	}
+	return ext
+}
+
+func wrapError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	httpStatus, resp := apierror.Convert(ctx, err)
+	return &resolverError{err: err, status: httpStatus, resp: resp}
+}
+
+// invalidArgument gateway ning o'zi aniqlagan argument xatosi
+func invalidArgument(code, field, message string) error {
+	err := gatewayError(http.StatusBadRequest, code, message)
+	err.resp.Fields = []apierror.FieldError{{Field: field, Description: message}}
+	return err
+}
+
+// gatewayError downstream servisdan kelmagan, gateway ning o'zi qaytaradigan xato
+func gatewayError(httpStatus int, code, message string) *resolverError {
+	return &resolverError{
+		status: httpStatus,
+		resp:   apierror.ErrorResponse{Code: code, Message: message},
+	}
+}
@@ -0,0 +1,175 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"api-gateway/internal/jwt"
+	tweetproto "api-gateway/protos/tweet-proto"
+	userproto "api-gateway/protos/user-proto"
+
+	"google.golang.org/grpc"
+)
+
+// fakeUsers GetUsersByIds ga kelgan batchlarni yozib boradi; bittalab GetUser chaqirilmasligi kerak
+type fakeUsers struct {
+	userproto.UserServiceClient
+	users map[int32]*userproto.User
+
+	mu      sync.Mutex
+	batches [][]int32
+	viewers []int32
+}
+
+func (f *fakeUsers) GetUsersByIds(ctx context.Context, req *userproto.GetUsersByIdsRequest, opts ...grpc.CallOption) (*userproto.GetUsersByIdsResponse, error) {
+	f.mu.Lock()
+	f.batches = append(f.batches, req.UserIds)
+	f.viewers = append(f.viewers, req.ViewerId)
+	f.mu.Unlock()
+	resp := &userproto.GetUsersByIdsResponse{}
+	for _, id := range req.UserIds {
+		if user, ok := f.users[id]; ok {
+			resp.Users = append(resp.Users, user)
+		}
+	}
+	return resp, nil
+}
+
+type fakeTweets struct {
+	tweetproto.TweetServiceClient
+	tweets []*tweetproto.Tweet
+	err    error
+
+	mu      sync.Mutex
+	batches [][]int32
+}
+
+func (f *fakeTweets) GetTweetsByUser(ctx context.Context, req *tweetproto.GetTweetsByUserRequest, opts ...grpc.CallOption) (*tweetproto.GetTweetsByUserResponse, error) {
+	return &tweetproto.GetTweetsByUserResponse{Tweets: f.tweets}, nil
+}
+
+func (f *fakeTweets) GetTweetsByIds(ctx context.Context, req *tweetproto.GetTweetsByIdsRequest, opts ...grpc.CallOption) (*tweetproto.GetTweetsByIdsResponse, error) {
+	f.mu.Lock()
+	f.batches = append(f.batches, req.TweetIds)
+	f.mu.Unlock()
+	if f.err != nil {
+		return nil, f.err
+	}
+	resp := &tweetproto.GetTweetsByIdsResponse{}
+	for _, tweet := range f.tweets {
+		for _, id := range req.TweetIds {
+			if tweet.Id == id {
+				resp.Tweets = append(resp.Tweets, tweet)
+			}
+		}
+	}
+	return resp, nil
+}
+
+func newTestHandler() (*Handler, *fakeUsers, *fakeTweets) {
+	users := &fakeUsers{users: map[int32]*userproto.User{
+		1: {Id: 1, Username: "alice"},
+		2: {Id: 2, Username: "bob"},
+		7: {Id: 7, Username: "carol"},
+	}}
+	tweets := &fakeTweets{tweets: []*tweetproto.Tweet{
+		{Id: 10, UserId: 1, Content: "a"},
+		{Id: 11, UserId: 2, Content: "b"},
+		{Id: 12, UserId: 1, Content: "c"},
+	}}
+	return NewHandler(Clients{User: users, Tweet: tweets}), users, tweets
+}
+
+type gqlError struct {
+	Message    string
+	Path       []interface{}
+	Extensions map[string]interface{}
+}
+
+func exec(t *testing.T, h *Handler, query string) (map[string]interface{}, []gqlError) {
+	t.Helper()
+	ctx := h.withScope(context.Background(), jwt.Principal{UserID: 7})
+	resp := h.schema.Exec(ctx, query, "", nil)
+	raw, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out struct {
+		Data   map[string]interface{}
+		Errors []gqlError
+	}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		t.Fatal(err)
+	}
+	return out.Data, out.Errors
+}
+
+func TestTweetAuthorsAreBatched(t *testing.T) {
+	h, users, _ := newTestHandler()
+
+	data, errs := exec(t, h, `{ tweetsByUser(userId: "1") { nodes { id author { username } } } }`)
+	if len(errs) != 0 {
+		t.Fatalf("errors: %+v", errs)
+	}
+	nodes := data["tweetsByUser"].(map[string]interface{})["nodes"].([]interface{})
+	if len(nodes) != 3 || nodes[1].(map[string]interface{})["author"].(map[string]interface{})["username"] != "bob" {
+		t.Errorf("nodes = %v", nodes)
+	}
+	// uchta tweet, ikkita muallif: bitta GetUsersByIds
+	if len(users.batches) != 1 || len(users.batches[0]) != 2 {
+		t.Errorf("user batches = %v, want one batch of 2", users.batches)
+	}
+	if users.viewers[0] != 7 {
+		t.Errorf("viewer_id = %d, want the caller", users.viewers[0])
+	}
+}
+
+func TestListedTweetsAreNotRefetched(t *testing.T) {
+	h, _, tweets := newTestHandler()
+
+	// ro'yxatdagi tweetlar Prime bilan keshga tushadi
+	_, errs := exec(t, h, `{ tweetsByUser(userId: "1") { nodes { id } } tweet(id: "13") { id } }`)
+	if len(errs) != 0 {
+		t.Fatalf("errors: %+v", errs)
+	}
+	for _, batch := range tweets.batches {
+		for _, id := range batch {
+			if id != 13 {
+				t.Errorf("listed tweet %d fetched again", id)
+			}
+		}
+	}
+}
+
+func TestMissingKeys(t *testing.T) {
+	h, _, _ := newTestHandler()
+
+	// nullable maydon null bo'ladi, xato emas
+	data, errs := exec(t, h, `{ user(id: "99") { id } tweet(id: "99") { id } }`)
+	if len(errs) != 0 || data["user"] != nil || data["tweet"] != nil {
+		t.Errorf("nullable: data = %v, errors = %+v", data, errs)
+	}
+
+	// me non-null: topilmasa REST dagi code bilan xato
+	h.clients.User.(*fakeUsers).users = nil
+	_, errs = exec(t, h, `{ me { id } }`)
+	if len(errs) != 1 || errs[0].Extensions["code"] != "USER_NOT_FOUND" || errs[0].Extensions["status"] != float64(404) {
+		t.Errorf("me: errors = %+v", errs)
+	}
+}
+
+func TestBatchErrorReachesEveryKey(t *testing.T) {
+	h, _, tweets := newTestHandler()
+	tweets.err = errors.New("unavailable")
+
+	data, errs := exec(t, h, `{ a: tweet(id: "10") { id } b: tweet(id: "11") { id } }`)
+	if len(tweets.batches) != 1 {
+		t.Errorf("tweet batches = %v, want 1", tweets.batches)
+	}
+	if len(errs) != 2 || data["a"] != nil || data["b"] != nil {
+		t.Errorf("data = %v, errors = %+v", data, errs)
+	}
+}
@@ -0,0 +1,153 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// loaderWait bitta batch ga kalit yig'ish oynasi. graphql-go ro'yxat
+	// elementlarini parallel resolve qiladi, shuning uchun ular shu oynaga tushadi.
+	loaderWait = 2 * time.Millisecond
+	// loaderMaxBatch ga yetganda batch oyna tugashini kutmasdan yuboriladi.
+	// GetUsersByIds va GetTweetsByIds bundan katta so'rovni qabul qilmaydi.
+	loaderMaxBatch = 100
+)
+
+// fetchFunc keys uchun qiymatlarni qaytaradi. values va errs keys bilan bir xil
+// tartib va uzunlikda bo'lishi kerak.
+type fetchFunc[K comparable, V any] func(ctx context.Context, keys []K) ([]V, []error)
+
+// Loader bitta GraphQL so'rovi davomida Load chaqiruvlarini yig'adi, takroriy
+// kalitlarni olib tashlaydi va ularni bitta batch RPC bilan oladi. Natijalar so'rov
+// tugaguncha keshda turadi, shuning uchun bir xil user yoki tweet ikki marta so'ralmaydi.
+type Loader[K comparable, V any] struct {
+	ctx   context.Context
+	fetch fetchFunc[K, V]
+
+	mu      sync.Mutex
+	cache   map[K]*loaderResult[V]
+	pending *loaderBatch[K, V]
+}
+
+type loaderResult[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+type loaderBatch[K comparable, V any] struct {
+	keys    []K
+	results []*loaderResult[V]
+	sent    bool
+}
+
+// newLoader ctx so'rov konteksti: fetch undagi principal metadata si bilan chaqiriladi
+func newLoader[K comparable, V any](ctx context.Context, fetch fetchFunc[K, V]) *Loader[K, V] {
+	return &Loader[K, V]{
+		ctx:   ctx,
+		fetch: fetch,
+		cache: make(map[K]*loaderResult[V]),
+	}
+}
+
+// Load key qiymatini qaytaradi. Birinchi chaqiruv navbatdagi batch ga qo'shiladi,
+// keyingilari shu natijani kutadi.
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	result, ok := l.cache[key]
+	if !ok {
+		result = &loaderResult[V]{done: make(chan struct{})}
+		l.cache[key] = result
+		l.enqueue(key, result)
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-result.done:
+		return result.value, result.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// Prime ro'yxat javobidan kelgan qiymatni keshga yozadi. Kalit allaqachon
+// so'ralgan bo'lsa hech narsa qilmaydi.
+func (l *Loader[K, V]) Prime(key K, value V) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.cache[key]; ok {
+		return
+	}
+	result := &loaderResult[V]{done: make(chan struct{}), value: value}
+	close(result.done)
+	l.cache[key] = result
+}
+
+// enqueue l.mu ushlab turilganda chaqiriladi
+func (l *Loader[K, V]) enqueue(key K, result *loaderResult[V]) {
+	batch := l.pending
+	if batch == nil {
+		batch = &loaderBatch[K, V]{}
+		l.pending = batch
+		time.AfterFunc(loaderWait, func() { l.dispatch(batch) })
+	}
+	batch.keys = append(batch.keys, key)
+	batch.results = append(batch.results, result)
+	if len(batch.keys) >= loaderMaxBatch {
+		// keyingi kalitlar yangi batch ga tushadi, bu batch cheklovdan oshmaydi
+		l.pending = nil
+		go l.dispatch(batch)
+	}
+}
+
+func (l *Loader[K, V]) dispatch(batch *loaderBatch[K, V]) {
+	l.mu.Lock()
+	if batch.sent {
+		l.mu.Unlock()
+		return
+	}
+	batch.sent = true
+	if l.pending == batch {
+		l.pending = nil
+	}
+	l.mu.Unlock()
+
+	values, errs := l.fetch(l.ctx, batch.keys)
+	for i, result := range batch.results {
+		result.value, result.err = values[i], errs[i]
+		close(result.done)
+	}
+}
+
+// fetchBatch bitta batch RPC javobini keys tartibiga yoyadi. get keys ni
+// bir chaqiruvda oladi; javobda yo'q kalitlar (topilmagan yoki chaqiruvchi ko'ra
+// olmaydigan) notFound xatosini, RPC xatosi esa barcha kalitlarga tushadi.
+func fetchBatch[K comparable, V any](get func(ctx context.Context, keys []K) ([]V, error), keyOf func(V) K, notFound error) fetchFunc[K, V] {
+	return func(ctx context.Context, keys []K) ([]V, []error) {
+		values := make([]V, len(keys))
+		errs := make([]error, len(keys))
+		found, err := get(ctx, keys)
+		if err != nil {
+			for i := range errs {
+				errs[i] = err
+			}
+			return values, errs
+		}
+		byKey := make(map[K]V, len(found))
+		for _, value := range found {
+			byKey[keyOf(value)] = value
+		}
+		for i, key := range keys {
+			value, ok := byKey[key]
+			if !ok {
+				errs[i] = notFound
+				continue
+			}
+			values[i] = value
+		}
+		return values, errs
+	}
+}
@@ -0,0 +1,131 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+// recordingFetch fetch chaqiruvlarini yozib boradi; har bir kalit uchun key*10 qaytaradi
+type recordingFetch struct {
+	mu      sync.Mutex
+	batches [][]int32
+}
+
+func (f *recordingFetch) fetch(ctx context.Context, keys []int32) ([]int32, []error) {
+	f.mu.Lock()
+	f.batches = append(f.batches, append([]int32(nil), keys...))
+	f.mu.Unlock()
+	values := make([]int32, len(keys))
+	for i, key := range keys {
+		values[i] = key * 10
+	}
+	return values, make([]error, len(keys))
+}
+
+// loadAll kalitlarni parallel yuklaydi, graphql-go ro'yxat elementlarini shunday resolve qiladi
+func loadAll(t *testing.T, loader *Loader[int32, int32], keys []int32) {
+	t.Helper()
+	var wg sync.WaitGroup
+	for _, key := range keys {
+		wg.Add(1)
+		go func(key int32) {
+			defer wg.Done()
+			value, err := loader.Load(context.Background(), key)
+			if err != nil || value != key*10 {
+				t.Errorf("Load(%d) = %d, %v", key, value, err)
+			}
+		}(key)
+	}
+	wg.Wait()
+}
+
+func TestLoaderBatchesAndDedupes(t *testing.T) {
+	f := &recordingFetch{}
+	loader := newLoader(context.Background(), f.fetch)
+
+	loadAll(t, loader, []int32{1, 2, 1, 3, 2, 1})
+	if len(f.batches) != 1 || len(f.batches[0]) != 3 {
+		t.Fatalf("batches = %v, want one batch of 3 keys", f.batches)
+	}
+
+	// natija so'rov tugaguncha keshda
+	loadAll(t, loader, []int32{1, 3})
+	if len(f.batches) != 1 {
+		t.Errorf("cached keys fetched again: %v", f.batches)
+	}
+}
+
+func TestLoaderSplitsLargeBatches(t *testing.T) {
+	f := &recordingFetch{}
+	loader := newLoader(context.Background(), f.fetch)
+
+	keys := make([]int32, 2*loaderMaxBatch+50)
+	for i := range keys {
+		keys[i] = int32(i + 1)
+	}
+	loadAll(t, loader, keys)
+
+	total := 0
+	for _, batch := range f.batches {
+		if len(batch) > loaderMaxBatch {
+			t.Errorf("batch of %d keys, max %d", len(batch), loaderMaxBatch)
+		}
+		total += len(batch)
+	}
+	if total != len(keys) {
+		t.Errorf("fetched %d keys, want %d", total, len(keys))
+	}
+}
+
+func TestLoaderPrime(t *testing.T) {
+	f := &recordingFetch{}
+	loader := newLoader(context.Background(), f.fetch)
+
+	loader.Prime(4, 40)
+	loadAll(t, loader, []int32{4})
+	if len(f.batches) != 0 {
+		t.Errorf("primed key fetched: %v", f.batches)
+	}
+
+	// allaqachon yuklangan qiymat almashtirilmaydi
+	loadAll(t, loader, []int32{5})
+	loader.Prime(5, 99)
+	if value, _ := loader.Load(context.Background(), 5); value != 50 {
+		t.Errorf("Load(5) after Prime = %d, want 50", value)
+	}
+}
+
+func TestFetchBatch(t *testing.T) {
+	notFound := errors.New("not found")
+	var calls int
+	var rpcErr error
+	fetch := fetchBatch(func(ctx context.Context, ids []int32) ([]int32, error) {
+		calls++
+		if rpcErr != nil {
+			return nil, rpcErr
+		}
+		// servis topilmagan 2 ni tashlab, qolganlarini boshqa tartibda qaytaradi
+		return []int32{30, 10}, nil
+	}, func(v int32) int32 { return v / 10 }, notFound)
+
+	values, errs := fetch(context.Background(), []int32{1, 2, 3})
+	if calls != 1 {
+		t.Errorf("%d calls, want 1", calls)
+	}
+	if values[0] != 10 || values[2] != 30 || errs[0] != nil || errs[2] != nil {
+		t.Errorf("values = %v, errs = %v", values, errs)
+	}
+	if !errors.Is(errs[1], notFound) {
+		t.Errorf("missing key: err = %v, want %v", errs[1], notFound)
+	}
+
+	rpcErr = errors.New("unavailable")
+	_, errs = fetch(context.Background(), []int32{1, 2})
+	for i, err := range errs {
+		if !errors.Is(err, rpcErr) {
+			t.Errorf("errs[%d] = %v, want %v", i, err, rpcErr)
+		}
+	}
+}
@@ -0,0 +1,605 @@
+package graphql
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"api-gateway/internal/pagination"
+	commentproto "api-gateway/protos/comment-proto"
+	directproto "api-gateway/protos/direct-proto"
+	likeproto "api-gateway/protos/like-proto"
+	tweetproto "api-gateway/protos/tweet-proto"
+	userproto "api-gateway/protos/user-proto"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Resolver Query tipining ildizi
+type Resolver struct {
+	clients Clients
+}
+
+type idArgs struct {
+	ID graphqlgo.ID
+}
+
+type pageArgs struct {
+	First *int32
+	After *string
+}
+
+type userPageArgs struct {
+	UserID graphqlgo.ID
+	First  *int32
+	After  *string
+}
+
+type tweetPageArgs struct {
+	TweetID graphqlgo.ID
+	First   *int32
+	After   *string
+}
+
+type directPageArgs struct {
+	WithUserID graphqlgo.ID
+	First      *int32
+	After      *string
+}
+
+func (r *Resolver) Me(ctx context.Context) (*userResolver, error) {
+	return r.mustLoadUser(ctx, scopeFrom(ctx).caller.UserID)
+}
+
+func (r *Resolver) User(ctx context.Context, args idArgs) (*userResolver, error) {
+	id, err := parseID(args.ID, "id")
+	if err != nil {
+		return nil, err
+	}
+	return r.loadUser(ctx, int32(id))
+}
+
+func (r *Resolver) Tweet(ctx context.Context, args idArgs) (*tweetResolver, error) {
+	id, err := parseID(args.ID, "id")
+	if err != nil {
+		return nil, err
+	}
+	return r.loadTweet(ctx, int32(id))
+}
+
+func (r *Resolver) TweetsByUser(ctx context.Context, args userPageArgs) (*tweetConnection, error) {
+	userID, err := parseID(args.UserID, "userId")
+	if err != nil {
+		return nil, err
+	}
+	return r.tweetsByUser(ctx, int32(userID), args.First, args.After)
+}
+
+func (r *Resolver) SavedTweets(ctx context.Context, args pageArgs) (*tweetConnection, error) {
+	size, token, err := page(args.First, args.After)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.clients.Tweet.GetSavedTweets(ctx, &tweetproto.GetSavedTweetsRequest{
+		UserId: scopeFrom(ctx).caller.UserID,
+		Limit:  size,
+		Cursor: token,
+	})
+	if err != nil {
+		return nil, wrapError(ctx, err)
+	}
+	return r.newTweetConnection(ctx, resp.Tweets, resp.NextPageToken), nil
+}
+
+func (r *Resolver) LikedTweets(ctx context.Context, args userPageArgs) (*tweetConnection, error) {
+	userID, err := parseID(args.UserID, "userId")
+	if err != nil {
+		return nil, err
+	}
+	size, token, err := page(args.First, args.After)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.clients.Tweet.GetLikedTweets(ctx, &tweetproto.GetLikedTweetsRequest{
+		UserId: int32(userID),
+		Limit:  size,
+		Cursor: token,
+	})
+	if err != nil {
+		return nil, wrapError(ctx, err)
+	}
+	return r.newTweetConnection(ctx, resp.Tweets, resp.NextPageToken), nil
+}
+
+func (r *Resolver) Comment(ctx context.Context, args idArgs) (*commentResolver, error) {
+	id, err := parseID(args.ID, "id")
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.clients.Comment.GetComment(ctx, &commentproto.GetCommentRequest{
+		Id:     id,
+		UserId: int64(scopeFrom(ctx).caller.UserID),
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, wrapError(ctx, err)
+	}
+	return &commentResolver{r: r, comment: resp.Comment}, nil
+}
+
+func (r *Resolver) Comments(ctx context.Context, args tweetPageArgs) (*commentConnection, error) {
+	tweetID, err := parseID(args.TweetID, "tweetId")
+	if err != nil {
+		return nil, err
+	}
+	return r.comments(ctx, tweetID, args.First, args.After)
+}
+
+func (r *Resolver) DirectMessages(ctx context.Context, args directPageArgs) (*directMessageConnection, error) {
+	withUserID, err := parseID(args.WithUserID, "withUserId")
+	if err != nil {
+		return nil, err
+	}
+	size, token, err := page(args.First, args.After)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.clients.Direct.GetDirectMessages(ctx, &directproto.GetDirectMessagesRequest{
+		SenderId:   int64(scopeFrom(ctx).caller.UserID),
+		ReceiverId: withUserID,
+		Limit:      size,
+		Cursor:     token,
+	})
+	if err != nil {
+		return nil, wrapError(ctx, err)
+	}
+	conn := &directMessageConnection{next: resp.NextPageToken}
+	for _, message := range resp.DirectMessages {
+		conn.nodes = append(conn.nodes, &directMessageResolver{r: r, message: message})
+	}
+	return conn, nil
+}
+
+func (r *Resolver) DirectMessage(ctx context.Context, args idArgs) (*directMessageResolver, error) {
+	id, err := parseID(args.ID, "id")
+	if err != nil {
+		return nil, err
+	}
+	// faqat yozishma ishtirokchilari ko'ra oladi, tekshiruv direct-service da
+	resp, err := r.clients.Direct.GetDirectMessageByID(ctx, &directproto.GetDirectMessageByIDRequest{Id: id, UserId: int64(scopeFrom(ctx).caller.UserID)})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, wrapError(ctx, err)
+	}
+	return &directMessageResolver{r: r, message: resp.DirectMessage}, nil
+}
+
+// loadUser topilmagan foydalanuvchi uchun null qaytaradi
+func (r *Resolver) loadUser(ctx context.Context, id int32) (*userResolver, error) {
+	user, err := scopeFrom(ctx).users.Load(ctx, id)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, wrapError(ctx, err)
+	}
+	return &userResolver{r: r, user: user}, nil
+}
+
+// loadTweet topilmagan tweet uchun null qaytaradi
+func (r *Resolver) loadTweet(ctx context.Context, id int32) (*tweetResolver, error) {
+	tweet, err := scopeFrom(ctx).tweets.Load(ctx, id)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, wrapError(ctx, err)
+	}
+	return &tweetResolver{r: r, tweet: tweet}, nil
+}
+
+// mustLoadUser non-null maydonlar uchun: topilmasa ham xato qaytaradi
+func (r *Resolver) mustLoadUser(ctx context.Context, id int32) (*userResolver, error) {
+	user, err := scopeFrom(ctx).users.Load(ctx, id)
+	if err != nil {
+		return nil, wrapError(ctx, err)
+	}
+	return &userResolver{r: r, user: user}, nil
+}
+
+func (r *Resolver) tweetsByUser(ctx context.Context, userID int32, first *int32, after *string) (*tweetConnection, error) {
+	size, token, err := page(first, after)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.clients.Tweet.GetTweetsByUser(ctx, &tweetproto.GetTweetsByUserRequest{
+		UserId:   userID,
+		ViewerId: scopeFrom(ctx).caller.UserID,
+		Limit:    size,
+		Cursor:   token,
+	})
+	if err != nil {
+		return nil, wrapError(ctx, err)
+	}
+	return r.newTweetConnection(ctx, resp.Tweets, resp.NextPageToken), nil
+}
+
+func (r *Resolver) comments(ctx context.Context, tweetID int64, first *int32, after *string) (*commentConnection, error) {
+	size, token, err := page(first, after)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.clients.Comment.GetCommentsByTweetID(ctx, &commentproto.GetCommentsByTweetIDRequest{
+		TweetId: tweetID,
+		Limit:   size,
+		Cursor:  token,
+	})
+	if err != nil {
+		return nil, wrapError(ctx, err)
+	}
+	conn := &commentConnection{next: resp.NextPageToken}
+	for _, comment := range resp.Comments {
+		conn.nodes = append(conn.nodes, &commentResolver{r: r, comment: comment})
+	}
+	return conn, nil
+}
+
+// newTweetConnection ro'yxatdagi tweetlarni loader keshiga ham yozadi, shunda
+// comment.tweet kabi maydonlar ularni qayta so'ramaydi
+func (r *Resolver) newTweetConnection(ctx context.Context, tweets []*tweetproto.Tweet, next string) *tweetConnection {
+	loader := scopeFrom(ctx).tweets
+	conn := &tweetConnection{next: next}
+	for _, tweet := range tweets {
+		loader.Prime(tweet.Id, tweet)
+		conn.nodes = append(conn.nodes, &tweetResolver{r: r, tweet: tweet})
+	}
+	return conn
+}
+
+func (r *Resolver) likes(resp interface {
+	GetLikes() []*likeproto.Like
+	GetNextPageToken() string
+}) *likeConnection {
+	conn := &likeConnection{next: resp.GetNextPageToken()}
+	for _, like := range resp.GetLikes() {
+		conn.nodes = append(conn.nodes, &likeResolver{r: r, like: like})
+	}
+	return conn
+}
+
+type userResolver struct {
+	r    *Resolver
+	user *userproto.User
+}
+
+func (u *userResolver) ID() graphqlgo.ID {
+	return formatID(int64(u.user.Id))
+}
+
+func (u *userResolver) Name() string {
+	return u.user.Name
+}
+
+func (u *userResolver) Username() string {
+	return u.user.Username
+}
+
+func (u *userResolver) Bio() string {
+	return u.user.Bio
+}
+
+func (u *userResolver) AvatarUrl() string {
+	return u.user.AvatarUrl
+}
+
+func (u *userResolver) IsPrivate() bool {
+	return u.user.IsPrivate
+}
+
+func (u *userResolver) Role() string {
+	return u.user.Role
+}
+
+func (u *userResolver) TweetCount() int32 {
+	return u.user.TweetCount
+}
+
+func (u *userResolver) FollowerCount() int32 {
+	return u.user.FollowerCount
+}
+
+func (u *userResolver) FollowingCount() int32 {
+	return u.user.FollowingCount
+}
+
+func (u *userResolver) CreatedAt() *graphqlgo.Time {
+	if u.user.CreatedAt == nil {
+		return nil
+	}
+	return &graphqlgo.Time{Time: u.user.CreatedAt.AsTime()}
+}
+
+func (u *userResolver) Tweets(ctx context.Context, args pageArgs) (*tweetConnection, error) {
+	return u.r.tweetsByUser(ctx, u.user.Id, args.First, args.After)
+}
+
+func (u *userResolver) Likes(ctx context.Context, args pageArgs) (*likeConnection, error) {
+	size, token, err := page(args.First, args.After)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := u.r.clients.Like.GetLikeTweetByUser(ctx, &likeproto.GetLikeTweetByUserRequest{
+		UserId: u.user.Id,
+		Limit:  size,
+		Cursor: token,
+	})
+	if err != nil {
+		return nil, wrapError(ctx, err)
+	}
+	return u.r.likes(resp), nil
+}
+
+type tweetResolver struct {
+	r     *Resolver
+	tweet *tweetproto.Tweet
+}
+
+func (t *tweetResolver) ID() graphqlgo.ID {
+	return formatID(int64(t.tweet.Id))
+}
+
+func (t *tweetResolver) Content() string {
+	return t.tweet.Content
+}
+
+func (t *tweetResolver) Author(ctx context.Context) (*userResolver, error) {
+	return t.r.mustLoadUser(ctx, t.tweet.UserId)
+}
+
+func (t *tweetResolver) CreatedAt() *graphqlgo.Time {
+	if t.tweet.CreatedAt == nil {
+		return nil
+	}
+	return &graphqlgo.Time{Time: t.tweet.CreatedAt.AsTime()}
+}
+
+func (t *tweetResolver) Media() []string {
+	if t.tweet.Media == nil {
+		return []string{}
+	}
+	return t.tweet.Media
+}
+
+// Hisoblagichlar servis count maydonini to'ldirmagan bo'lsa ro'yxat uzunligidan olinadi
+func (t *tweetResolver) LikeCount() int32 {
+	return max(t.tweet.LikeCount, int32(len(t.tweet.Likes)))
+}
+
+func (t *tweetResolver) CommentCount() int32 {
+	return max(t.tweet.CommentCount, int32(len(t.tweet.Comments)))
+}
+
+func (t *tweetResolver) ShareCount() int32 {
+	return max(t.tweet.ShareCount, int32(len(t.tweet.Shares)))
+}
+
+func (t *tweetResolver) SaveCount() int32 {
+	return max(t.tweet.SaveCount, int32(len(t.tweet.Saves)))
+}
+
+func (t *tweetResolver) Comments(ctx context.Context, args pageArgs) (*commentConnection, error) {
+	return t.r.comments(ctx, int64(t.tweet.Id), args.First, args.After)
+}
+
+func (t *tweetResolver) Likes(ctx context.Context, args pageArgs) (*likeConnection, error) {
+	size, token, err := page(args.First, args.After)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.r.clients.Like.GetLikesTweet(ctx, &likeproto.GetLikesTweetRequest{
+		TweetId:  t.tweet.Id,
+		ViewerId: scopeFrom(ctx).caller.UserID,
+		Limit:    size,
+		Cursor:   token,
+	})
+	if err != nil {
+		return nil, wrapError(ctx, err)
+	}
+	return t.r.likes(resp), nil
+}
+
+type commentResolver struct {
+	r       *Resolver
+	comment *commentproto.Comment
+}
+
+func (c *commentResolver) ID() graphqlgo.ID {
+	return formatID(c.comment.Id)
+}
+
+func (c *commentResolver) Content() string {
+	return c.comment.Content
+}
+
+func (c *commentResolver) CreatedAt() *graphqlgo.Time {
+	return parseTime(c.comment.CreatedAt)
+}
+
+func (c *commentResolver) LikesCount() int32 {
+	return c.comment.LikesCount
+}
+
+func (c *commentResolver) Author(ctx context.Context) (*userResolver, error) {
+	return c.r.mustLoadUser(ctx, int32(c.comment.UserId))
+}
+
+func (c *commentResolver) Tweet(ctx context.Context) (*tweetResolver, error) {
+	return c.r.loadTweet(ctx, int32(c.comment.TweetId))
+}
+
+type likeResolver struct {
+	r    *Resolver
+	like *likeproto.Like
+}
+
+func (l *likeResolver) ID() graphqlgo.ID {
+	return formatID(int64(l.like.Id))
+}
+
+func (l *likeResolver) CreatedAt() *graphqlgo.Time {
+	return parseTime(l.like.CreatedAt)
+}
+
+func (l *likeResolver) User(ctx context.Context) (*userResolver, error) {
+	return l.r.mustLoadUser(ctx, l.like.UserId)
+}
+
+// Tweet comment like lari uchun null
+func (l *likeResolver) Tweet(ctx context.Context) (*tweetResolver, error) {
+	if l.like.LikeIdentifier != "tweet" {
+		return nil, nil
+	}
+	return l.r.loadTweet(ctx, l.like.LikedId)
+}
+
+type directMessageResolver struct {
+	r       *Resolver
+	message *directproto.DirectMessage
+}
+
+func (d *directMessageResolver) ID() graphqlgo.ID {
+	return formatID(d.message.Id)
+}
+
+func (d *directMessageResolver) Text() string {
+	return d.message.Text
+}
+
+func (d *directMessageResolver) Media() []string {
+	if d.message.Media == nil {
+		return []string{}
+	}
+	return d.message.Media
+}
+
+func (d *directMessageResolver) CreatedAt() *graphqlgo.Time {
+	return parseTime(d.message.CreatedAt)
+}
+
+func (d *directMessageResolver) Sender(ctx context.Context) (*userResolver, error) {
+	return d.r.mustLoadUser(ctx, int32(d.message.SenderId))
+}
+
+func (d *directMessageResolver) Receiver(ctx context.Context) (*userResolver, error) {
+	return d.r.mustLoadUser(ctx, int32(d.message.ReceiverId))
+}
+
+// Tweet xabarda ulashilgan tweet, bo'lmasa null
+func (d *directMessageResolver) Tweet(ctx context.Context) (*tweetResolver, error) {
+	if d.message.TweetId == 0 {
+		return nil, nil
+	}
+	return d.r.loadTweet(ctx, int32(d.message.TweetId))
+}
+
+type tweetConnection struct {
+	nodes []*tweetResolver
+	next  string
+}
+
+func (c *tweetConnection) Nodes() []*tweetResolver {
+	return c.nodes
+}
+
+func (c *tweetConnection) NextCursor() *string {
+	return nextCursor(c.next)
+}
+
+type commentConnection struct {
+	nodes []*commentResolver
+	next  string
+}
+
+func (c *commentConnection) Nodes() []*commentResolver {
+	return c.nodes
+}
+
+func (c *commentConnection) NextCursor() *string {
+	return nextCursor(c.next)
+}
+
+type likeConnection struct {
+	nodes []*likeResolver
+	next  string
+}
+
+func (c *likeConnection) Nodes() []*likeResolver {
+	return c.nodes
+}
+
+func (c *likeConnection) NextCursor() *string {
+	return nextCursor(c.next)
+}
+
+type directMessageConnection struct {
+	nodes []*directMessageResolver
+	next  string
+}
+
+func (c *directMessageConnection) Nodes() []*directMessageResolver {
+	return c.nodes
+}
+
+func (c *directMessageConnection) NextCursor() *string {
+	return nextCursor(c.next)
+}
+
+// page first/after argumentlarini REST dagi ?limit=&cursor= qoidalari bilan tekshiradi
+func page(first *int32, after *string) (int32, string, error) {
+	var size int32
+	if first != nil {
+		if *first < 1 || *first > pagination.MaxLimit {
+			return 0, "", invalidArgument("INVALID_LIMIT", "first", "first must be between 1 and "+strconv.Itoa(pagination.MaxLimit))
+		}
+		size = *first
+	}
+	var token string
+	if after != nil {
+		token = *after
+	}
+	return size, token, nil
+}
+
+func nextCursor(token string) *string {
+	if token == "" {
+		return nil
+	}
+	return &token
+}
+
+func parseID(id graphqlgo.ID, field string) (int64, error) {
+	value, err := strconv.ParseInt(string(id), 10, 32)
+	if err != nil || value < 1 {
+		return 0, invalidArgument("INVALID_ID", field, "Invalid "+field)
+	}
+	return value, nil
+}
+
+func formatID(id int64) graphqlgo.ID {
+	return graphqlgo.ID(strconv.FormatInt(id, 10))
+}
+
+// parseTime servislar created_at ni "2006-01-02 15:04:05" ko'rinishida yuboradi
+func parseTime(value string) *graphqlgo.Time {
+	t, err := time.Parse(time.DateTime, value)
+	if err != nil {
+		return nil
+	}
+	return &graphqlgo.Time{Time: t}
+}
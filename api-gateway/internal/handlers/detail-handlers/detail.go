@@ -0,0 +1,300 @@
+// Package detail_handlers tweet sahifasi uchun yig'ma endpoint. Client tweet,
+// muallif, commentlar va like bosganlarni ketma-ket so'rash o'rniga bitta
+// so'rovda oladi; gateway servislarni umumiy deadline bilan parallel chaqiradi.
+package detail_handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"api-gateway/internal/apierror"
+	"api-gateway/internal/jwt"
+	commentproto "api-gateway/protos/comment-proto"
+	likeproto "api-gateway/protos/like-proto"
+	tweetproto "api-gateway/protos/tweet-proto"
+	userproto "api-gateway/protos/user-proto"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// detailTimeout barcha downstream chaqiruvlar uchun umumiy deadline
+	detailTimeout = 2 * time.Second
+	// detailComments birinchi sahifadagi commentlar soni
+	detailComments = 20
+	// detailLikers javobga qo'shiladigan like bosganlar soni
+	detailLikers = 5
+)
+
+// DetailHandler tweet, user, comment va like servislaridan javob yig'adi
+type DetailHandler struct {
+	UserService    userproto.UserServiceClient
+	TweetService   tweetproto.TweetServiceClient
+	CommentService commentproto.CommentServiceClient
+	LikeService    likeproto.LikeServiceClient
+}
+
+// NewDetailHandler yangi DetailHandler yaratadi
+func NewDetailHandler(userService userproto.UserServiceClient, tweetService tweetproto.TweetServiceClient, commentService commentproto.CommentServiceClient, likeService likeproto.LikeServiceClient) *DetailHandler {
+	return &DetailHandler{
+		UserService:    userService,
+		TweetService:   tweetService,
+		CommentService: commentService,
+		LikeService:    likeService,
+	}
+}
+
+// TweetDetail tweet sahifasi. Tweet majburiy: u olinmasa butun so'rov xato bilan
+// tugaydi. Qolgan bo'limlar ixtiyoriy, xato bo'lsa bo'limning error maydoni to'ladi.
+type TweetDetail struct {
+	Tweet    *tweetproto.Tweet `json:"tweet"`
+	Viewer   Viewer            `json:"viewer"`
+	Author   AuthorSection     `json:"author"`
+	Comments CommentsSection   `json:"comments"`
+	Likers   LikersSection     `json:"likers"`
+}
+
+// Viewer so'rov yuborgan foydalanuvchining tweetga munosabati
+type Viewer struct {
+	Liked bool `json:"liked"`
+	Saved bool `json:"saved"`
+}
+
+// Profile foydalanuvchining ochiq ma'lumotlari
+type Profile struct {
+	ID             int32  `json:"id"`
+	Name           string `json:"name"`
+	Username       string `json:"username"`
+	Bio            string `json:"bio"`
+	AvatarURL      string `json:"avatar_url"`
+	IsPrivate      bool   `json:"is_private"`
+	FollowerCount  int32  `json:"follower_count"`
+	FollowingCount int32  `json:"following_count"`
+}
+
+type AuthorSection struct {
+	User  *Profile                `json:"user,omitempty"`
+	Error *apierror.ErrorResponse `json:"error,omitempty"`
+	err   error
+}
+
+type CommentsSection struct {
+	Items []CommentItem `json:"items"`
+	// NextCursor GET /comments/tweet/{tweet_id} ning cursor parametri uchun
+	NextCursor string                  `json:"next_cursor,omitempty"`
+	Error      *apierror.ErrorResponse `json:"error,omitempty"`
+	err        error
+}
+
+// CommentItem comment va uning muallifi. Muallif olinmasa author bo'sh qoladi.
+type CommentItem struct {
+	Comment *commentproto.Comment `json:"comment"`
+	Author  *Profile              `json:"author,omitempty"`
+}
+
+type LikersSection struct {
+	Users []*Profile              `json:"users"`
+	Error *apierror.ErrorResponse `json:"error,omitempty"`
+	err   error
+}
+
+// GetTweetDetail - tweet sahifasi uchun barcha ma'lumotlarni bitta so'rovda olish
+func (h *DetailHandler) GetTweetDetail(c *gin.Context) {
+	tweetID, err := strconv.ParseInt(c.Param("tweet_id"), 10, 32)
+	if err != nil {
+		apierror.BadRequest(c, "INVALID_ID", "Invalid tweet ID")
+		return
+	}
+	caller := jwt.CurrentUser(c).UserID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), detailTimeout)
+	defer cancel()
+	users := &profiles{client: h.UserService, caller: caller, calls: make(map[int32]*profileCall)}
+
+	var (
+		wg       sync.WaitGroup
+		tweet    *tweetproto.Tweet
+		tweetErr error
+		detail   TweetDetail
+	)
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		resp, err := h.TweetService.GetTweetByID(ctx, &tweetproto.GetTweetByIDRequest{TweetId: int32(tweetID), ViewerId: caller})
+		if err != nil {
+			tweetErr = err
+			// tweet ko'rinmasa boshqa bo'limlar javobga qo'shilmaydi
+			cancel()
+			return
+		}
+		tweet = resp.Tweet
+		author, err := users.get(ctx, tweet.UserId)
+		if err != nil {
+			detail.Author = AuthorSection{Error: sectionError(ctx, err), err: err}
+			return
+		}
+		detail.Author.User = author
+	}()
+	go func() {
+		defer wg.Done()
+		detail.Comments = h.comments(ctx, users, tweetID)
+	}()
+	go func() {
+		defer wg.Done()
+		detail.Likers = h.likers(ctx, users, int32(tweetID), caller)
+	}()
+	wg.Wait()
+
+	if tweetErr != nil {
+		apierror.FromError(c, tweetErr)
+		return
+	}
+	// ixtiyoriy bo'limlar xatolari REST dagi kabi so'rov logiga tushadi
+	for _, err := range []error{detail.Author.err, detail.Comments.err, detail.Likers.err} {
+		if err != nil {
+			_ = c.Error(err)
+		}
+	}
+
+	detail.Tweet = tweet
+	detail.Viewer = Viewer{
+		Liked: contains(tweet.Likes, caller),
+		Saved: contains(tweet.Saves, caller),
+	}
+	// kim saqlagani faqat tweet egasiga ko'rinadi
+	if tweet.UserId != caller {
+		tweet.Saves = nil
+	}
+
+	c.JSON(http.StatusOK, detail)
+}
+
+func (h *DetailHandler) comments(ctx context.Context, users *profiles, tweetID int64) CommentsSection {
+	resp, err := h.CommentService.GetCommentsByTweetID(ctx, &commentproto.GetCommentsByTweetIDRequest{TweetId: tweetID, Limit: detailComments})
+	if err != nil {
+		return CommentsSection{Items: []CommentItem{}, Error: sectionError(ctx, err), err: err}
+	}
+
+	ids := make([]int32, len(resp.Comments))
+	for i, comment := range resp.Comments {
+		ids[i] = int32(comment.UserId)
+	}
+	authors := users.getAll(ctx, ids)
+
+	items := make([]CommentItem, len(resp.Comments))
+	for i, comment := range resp.Comments {
+		items[i] = CommentItem{Comment: comment, Author: authors[i]}
+	}
+	return CommentsSection{Items: items, NextCursor: resp.NextPageToken}
+}
+
+func (h *DetailHandler) likers(ctx context.Context, users *profiles, tweetID, caller int32) LikersSection {
+	resp, err := h.LikeService.GetLikesTweet(ctx, &likeproto.GetLikesTweetRequest{TweetId: tweetID, ViewerId: caller, Limit: detailLikers})
+	if err != nil {
+		return LikersSection{Users: []*Profile{}, Error: sectionError(ctx, err), err: err}
+	}
+
+	ids := make([]int32, len(resp.Likes))
+	for i, like := range resp.Likes {
+		ids[i] = like.UserId
+	}
+	likers := []*Profile{}
+	// o'chirilgan yoki olinmagan foydalanuvchilar ro'yxatga kirmaydi
+	for _, profile := range users.getAll(ctx, ids) {
+		if profile != nil {
+			likers = append(likers, profile)
+		}
+	}
+	return LikersSection{Users: likers}
+}
+
+// sectionError bo'lim xatosini REST dagi shaklga o'tkazadi
+func sectionError(ctx context.Context, err error) *apierror.ErrorResponse {
+	_, resp := apierror.Convert(ctx, err)
+	return &resp
+}
+
+// profiles bitta so'rov ichida foydalanuvchilarni bir martadan oladi: muallif
+// comment yozgan yoki like bosgan bo'lsa GetUser qayta chaqirilmaydi
+type profiles struct {
+	client userproto.UserServiceClient
+	caller int32
+
+	mu    sync.Mutex
+	calls map[int32]*profileCall
+}
+
+type profileCall struct {
+	done    chan struct{}
+	profile *Profile
+	err     error
+}
+
+func (p *profiles) get(ctx context.Context, id int32) (*Profile, error) {
+	p.mu.Lock()
+	call, ok := p.calls[id]
+	if ok {
+		p.mu.Unlock()
+		select {
+		case <-call.done:
+			return call.profile, call.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	call = &profileCall{done: make(chan struct{})}
+	p.calls[id] = call
+	p.mu.Unlock()
+
+	resp, err := p.client.GetUser(ctx, &userproto.GetUserRequest{UserId: id, ViewerId: p.caller})
+	if err != nil {
+		call.err = err
+	} else {
+		call.profile = newProfile(resp.User)
+	}
+	close(call.done)
+	return call.profile, call.err
+}
+
+// getAll ids tartibida profillarni qaytaradi, olinmaganlari nil
+func (p *profiles) getAll(ctx context.Context, ids []int32) []*Profile {
+	result := make([]*Profile, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func(i int, id int32) {
+			defer wg.Done()
+			result[i], _ = p.get(ctx, id)
+		}(i, id)
+	}
+	wg.Wait()
+	return result
+}
+
+func newProfile(user *userproto.User) *Profile {
+	if user == nil {
+		return nil
+	}
+	return &Profile{
+		ID:             user.Id,
+		Name:           user.Name,
+		Username:       user.Username,
+		Bio:            user.Bio,
+		AvatarURL:      user.AvatarUrl,
+		IsPrivate:      user.IsPrivate,
+		FollowerCount:  user.FollowerCount,
+		FollowingCount: user.FollowingCount,
+	}
+}
+
+func contains(ids []int32, id int32) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,180 @@
+package detail_handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"api-gateway/internal/jwt"
+	commentproto "api-gateway/protos/comment-proto"
+	likeproto "api-gateway/protos/like-proto"
+	tweetproto "api-gateway/protos/tweet-proto"
+	userproto "api-gateway/protos/user-proto"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// viewer so'rov yuboruvchi, 1 tweet muallifi
+const viewer = 7
+
+// fakeUsers user-service kabi: viewer ni bloklagan foydalanuvchi topilmaydi
+type fakeUsers struct {
+	userproto.UserServiceClient
+	blocked map[int32]bool
+
+	mu    sync.Mutex
+	calls map[int32]int
+}
+
+func (f *fakeUsers) GetUser(ctx context.Context, req *userproto.GetUserRequest, opts ...grpc.CallOption) (*userproto.GetUserResponse, error) {
+	f.mu.Lock()
+	f.calls[req.UserId]++
+	f.mu.Unlock()
+	if req.ViewerId != viewer {
+		return nil, status.Error(codes.InvalidArgument, "viewer_id is not the caller")
+	}
+	if f.blocked[req.UserId] {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	return &userproto.GetUserResponse{User: &userproto.User{Id: req.UserId, Username: "user"}}, nil
+}
+
+type fakeTweets struct {
+	tweetproto.TweetServiceClient
+	err error
+}
+
+func (f *fakeTweets) GetTweetByID(ctx context.Context, req *tweetproto.GetTweetByIDRequest, opts ...grpc.CallOption) (*tweetproto.GetTweetByIDResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if req.ViewerId != viewer {
+		return nil, status.Error(codes.InvalidArgument, "viewer_id is not the caller")
+	}
+	return &tweetproto.GetTweetByIDResponse{Tweet: &tweetproto.Tweet{
+		Id:     req.TweetId,
+		UserId: 1,
+		Likes:  []int32{2, viewer},
+		Saves:  []int32{3},
+	}}, nil
+}
+
+type fakeComments struct {
+	commentproto.CommentServiceClient
+	err error
+}
+
+func (f *fakeComments) GetCommentsByTweetID(ctx context.Context, req *commentproto.GetCommentsByTweetIDRequest, opts ...grpc.CallOption) (*commentproto.GetCommentsByTweetIDResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	// muallif o'z tweetiga comment yozgan, 4 viewer ni bloklagan
+	return &commentproto.GetCommentsByTweetIDResponse{
+		Comments:      []*commentproto.Comment{{Id: 1, UserId: 1}, {Id: 2, UserId: 4}},
+		NextPageToken: "next",
+	}, nil
+}
+
+type fakeLikes struct {
+	likeproto.LikeServiceClient
+}
+
+func (f *fakeLikes) GetLikesTweet(ctx context.Context, req *likeproto.GetLikesTweetRequest, opts ...grpc.CallOption) (*likeproto.GetLikesTweetResponse, error) {
+	return &likeproto.GetLikesTweetResponse{Likes: []*likeproto.Like{{UserId: 2}, {UserId: 4}, {UserId: 1}}}, nil
+}
+
+func serve(h *DetailHandler, path string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/tweets/:tweet_id/detail", func(c *gin.Context) {
+		c.Set(jwt.PrincipalKey, jwt.Principal{UserID: viewer})
+	}, h.GetTweetDetail)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec
+}
+
+func newTestHandler() (*DetailHandler, *fakeUsers, *fakeTweets, *fakeComments) {
+	users := &fakeUsers{blocked: map[int32]bool{4: true}, calls: make(map[int32]int)}
+	tweets := &fakeTweets{}
+	comments := &fakeComments{}
+	return NewDetailHandler(users, tweets, comments, &fakeLikes{}), users, tweets, comments
+}
+
+func TestGetTweetDetail(t *testing.T) {
+	h, users, _, _ := newTestHandler()
+
+	rec := serve(h, "/tweets/10/detail")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", rec.Code, rec.Body)
+	}
+	var detail TweetDetail
+	if err := json.Unmarshal(rec.Body.Bytes(), &detail); err != nil {
+		t.Fatal(err)
+	}
+
+	if detail.Tweet.Id != 10 || detail.Tweet.Saves != nil {
+		t.Errorf("tweet = %+v; saves must be hidden from other users", detail.Tweet)
+	}
+	if !detail.Viewer.Liked || detail.Viewer.Saved {
+		t.Errorf("viewer = %+v", detail.Viewer)
+	}
+	if detail.Author.Error != nil || detail.Author.User == nil || detail.Author.User.ID != 1 {
+		t.Errorf("author = %+v, error %+v", detail.Author.User, detail.Author.Error)
+	}
+	if len(detail.Comments.Items) != 2 || detail.Comments.NextCursor != "next" || detail.Comments.Error != nil {
+		t.Fatalf("comments = %+v", detail.Comments)
+	}
+	// bloklagan foydalanuvchining commenti muallifsiz qoladi
+	if detail.Comments.Items[0].Author == nil || detail.Comments.Items[1].Author != nil {
+		t.Errorf("comment authors = %+v, %+v", detail.Comments.Items[0].Author, detail.Comments.Items[1].Author)
+	}
+	// bloklagan foydalanuvchi like bosganlar ro'yxatiga kirmaydi
+	if len(detail.Likers.Users) != 2 || detail.Likers.Users[0].ID != 2 || detail.Likers.Users[1].ID != 1 {
+		t.Errorf("likers = %+v", detail.Likers.Users)
+	}
+	// muallif uch bo'limda ham bor, lekin bir marta so'raladi
+	if users.calls[1] != 1 || users.calls[4] != 1 {
+		t.Errorf("GetUser calls = %v, want one per user", users.calls)
+	}
+}
+
+func TestGetTweetDetailTweetError(t *testing.T) {
+	h, _, tweets, _ := newTestHandler()
+	tweets.err = status.Error(codes.PermissionDenied, "user has blocked you")
+
+	rec := serve(h, "/tweets/10/detail")
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("status %d: %s", rec.Code, rec.Body)
+	}
+	if rec := serve(h, "/tweets/abc/detail"); rec.Code != http.StatusBadRequest {
+		t.Errorf("invalid id: status %d", rec.Code)
+	}
+}
+
+func TestGetTweetDetailSectionError(t *testing.T) {
+	h, _, _, comments := newTestHandler()
+	comments.err = errors.New("unavailable")
+
+	rec := serve(h, "/tweets/10/detail")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", rec.Code, rec.Body)
+	}
+	var detail TweetDetail
+	if err := json.Unmarshal(rec.Body.Bytes(), &detail); err != nil {
+		t.Fatal(err)
+	}
+	if detail.Comments.Error == nil || len(detail.Comments.Items) != 0 {
+		t.Errorf("comments = %+v", detail.Comments)
+	}
+	if detail.Author.User == nil || len(detail.Likers.Users) != 2 {
+		t.Errorf("other sections failed: author %+v, likers %+v", detail.Author.User, detail.Likers.Users)
+	}
+}
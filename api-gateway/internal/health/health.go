@@ -0,0 +1,112 @@
+// Package health gateway uchun /healthz (liveness) va /readyz (readiness) handlerlari.
+package health
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const checkTimeout = 2 * time.Second
+
+type Readiness struct {
+	redis *redis.Client
+
+	mu       sync.Mutex
+	names    []string
+	services []healthpb.HealthClient
+
+	draining atomic.Bool
+}
+
+func NewReadiness(redisClient *redis.Client) *Readiness {
+	return &Readiness{redis: redisClient}
+}
+
+// AddService downstream gRPC servisni readiness tekshiruviga qo'shadi
+func (r *Readiness) AddService(name string, conn grpc.ClientConnInterface) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.names = append(r.names, name)
+	r.services = append(r.services, healthpb.NewHealthClient(conn))
+}
+
+// SetDraining gateway ni to'xtash jarayonida deb belgilaydi, /readyz shundan keyin 503 qaytaradi
+func (r *Readiness) SetDraining() {
+	r.draining.Store(true)
+}
+
+// Liveness - jarayon tirikligini tekshirish
+// @Summary Liveness probe
+// @Description Returns 200 while the gateway process is running
+// @Tags health
+// @Produce json
+// @Success 200 {object} map[string]string
+// @Router /healthz [get]
+func (r *Readiness) Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// Readiness - gateway so'rov qabul qilishga tayyorligini tekshirish
+// @Summary Readiness probe
+// @Description Reports the health of Redis and every downstream gRPC service. Returns 503 if any of them is not serving or the gateway is shutting down
+// @Tags health
+// @Produce json
+// @Success 200 {object} map[string]interface{}
+// @Failure 503 {object} map[string]interface{}
+// @Router /readyz [get]
+func (r *Readiness) Readiness(c *gin.Context) {
+	if r.draining.Load() {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"status": "draining"})
+		return
+	}
+
+	r.mu.Lock()
+	names, services := r.names, r.services
+	r.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), checkTimeout)
+	defer cancel()
+
+	statuses := make(map[string]string, len(names)+1)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i, client := range services {
+		wg.Add(1)
+		go func(name string, client healthpb.HealthClient) {
+			defer wg.Done()
+			status := "SERVING"
+			resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+			if err != nil {
+				status = err.Error()
+			} else if resp.Status != healthpb.HealthCheckResponse_SERVING {
+				status = resp.Status.String()
+			}
+			mu.Lock()
+			statuses[name] = status
+			mu.Unlock()
+		}(names[i], client)
+	}
+	redisStatus := "SERVING"
+	if err := r.redis.Ping(ctx).Err(); err != nil {
+		redisStatus = err.Error()
+	}
+	wg.Wait()
+	statuses["redis"] = redisStatus
+
+	code, overall := http.StatusOK, "ready"
+	for _, status := range statuses {
+		if status != "SERVING" {
+			code, overall = http.StatusServiceUnavailable, "not ready"
+			break
+		}
+	}
+	c.JSON(code, gin.H{"status": overall, "checks": statuses})
+}
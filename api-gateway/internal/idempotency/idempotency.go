@@ -0,0 +1,423 @@
+// Package idempotency mutatsiya qiluvchi so'rovlar uchun Idempotency-Key headerini
+// qo'llab-quvvatlaydi. Birinchi javob Redisda foydalanuvchi va kalit bo'yicha
+// saqlanadi, TTL ichida kelgan takroriy so'rov handler ga yetib bormay shu javobni
+// oladi. Mobil client timeout dan keyin qayta yuborganda tweet, comment yoki DM
+// ikki marta yaratilmaydi.
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"hash"
+	"io"
+	"log/slog"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"api-gateway/internal/apierror"
+	"api-gateway/internal/jwt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// Header client yuboradigan kalit
+	Header = "Idempotency-Key"
+	// ReplayedHeader saqlangan javob qayta berilganda qo'yiladi
+	ReplayedHeader = "Idempotent-Replayed"
+
+	// TTL saqlangan javob shu muddat ichida qayta beriladi
+	TTL = 24 * time.Hour
+
+	maxKeyLength = 255
+	// maxBodyHash dan katta so'rov tanasi (media upload) to'liq hash qilinmaydi:
+	// Content-Length ma'lum bo'lsa u, chunked tanada esa birinchi maxBodyHash bayt
+	// ishlatiladi. Xotirada bundan ortiq saqlanmaydi.
+	maxBodyHash = 8 << 20
+	// maxSpool Idempotency-Key li multipart so'rov tanasi chegarasi: fingerprint
+	// uchun tana handler dan oldin to'liq o'qilib vaqtinchalik faylga yoziladi
+	maxSpool = 1 << 30
+	// maxStoredBody dan katta javob saqlanmaydi
+	maxStoredBody = 1 << 20
+
+	stateProcessing = "processing"
+	stateCompleted  = "completed"
+)
+
+var errTooLarge = errors.New("request body is too large")
+
+// lockTTL so'rov bajarilayotganda kalit band turadigan muddat. Handler ishlab
+// turganda lock har lockTTL/3 da uzaytiriladi, shuning uchun sekin upload
+// o'rtasida kalit bo'shab qolmaydi; gateway o'chib qolsa lock shundan keyin
+// bo'shaydi. Testlarda qisqartiriladi.
+var lockTTL = time.Minute
+
+// extendLock lock hali shu so'rovniki bo'lsa uning TTL ini yangilaydi
+var extendLock = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// record Redisda saqlanadigan yozuv
+type record struct {
+	State       string `json:"state"`
+	Fingerprint string `json:"fingerprint"`
+	// Token lock egasini ajratadi, bir xil so'rovlar lockini boshqasi uzaytirmaydi
+	Token       string `json:"token,omitempty"`
+	Status      int    `json:"status,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
+
+// Store idempotent javoblarni Redisda saqlaydi
+type Store struct {
+	client *redis.Client
+}
+
+func NewStore(client *redis.Client) *Store {
+	return &Store{client: client}
+}
+
+// Middleware POST, PUT, PATCH va DELETE so'rovlarida Idempotency-Key bo'lsa ishlaydi.
+// jwt.Identify dan keyin ulanishi kerak; tokensiz so'rovlar kalitsiz o'tkaziladi,
+// chunki kalit foydalanuvchiga bog'lanadi.
+func (s *Store) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(Header)
+		if key == "" || !mutating(c.Request.Method) {
+			c.Next()
+			return
+		}
+		if len(key) > maxKeyLength || !printable(key) {
+			apierror.BadRequest(c, "INVALID_IDEMPOTENCY_KEY", "Idempotency-Key must be 1-255 printable ASCII characters")
+			return
+		}
+		principal, ok := jwt.GetPrincipal(c)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		ctx := c.Request.Context()
+		redisKey := "idempotency:user:" + strconv.Itoa(int(principal.UserID)) + ":" + key
+		fingerprint, cleanup, err := requestFingerprint(c)
+		if errors.Is(err, errTooLarge) {
+			apierror.Abort(c, http.StatusRequestEntityTooLarge, "REQUEST_TOO_LARGE", "Request body is too large for an Idempotency-Key")
+			return
+		}
+		if err != nil {
+			apierror.BadRequest(c, "INVALID_REQUEST", "Failed to read request body")
+			return
+		}
+		defer cleanup()
+
+		lock, _ := json.Marshal(record{State: stateProcessing, Fingerprint: fingerprint, Token: newToken()})
+		acquired, err := s.client.SetNX(ctx, redisKey, lock, lockTTL).Result()
+		if err != nil {
+			// Redis ishlamasa so'rovlarni to'xtatmaymiz
+			slog.ErrorContext(ctx, "idempotency store failed", "error", err)
+			c.Next()
+			return
+		}
+		if !acquired {
+			s.replay(c, redisKey, fingerprint)
+			return
+		}
+
+		stop := s.keepLocked(ctx, redisKey, lock)
+		writer := &captureWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		c.Next()
+		stop()
+
+		// client uzilgan bo'lsa ham natija yozilishi yoki kalit bo'shatilishi kerak
+		ctx = context.WithoutCancel(ctx)
+
+		// 5xx yoki juda katta javob saqlanmaydi, client qayta urinishi mumkin
+		status := writer.Status()
+		if status >= http.StatusInternalServerError || writer.overflow {
+			if err := s.client.Del(ctx, redisKey).Err(); err != nil {
+				slog.ErrorContext(ctx, "failed to release idempotency key", "error", err)
+			}
+			return
+		}
+		done, _ := json.Marshal(record{
+			State:       stateCompleted,
+			Fingerprint: fingerprint,
+			Status:      status,
+			ContentType: writer.Header().Get("Content-Type"),
+			Body:        writer.body.Bytes(),
+		})
+		if err := s.client.Set(ctx, redisKey, done, TTL).Err(); err != nil {
+			slog.ErrorContext(ctx, "failed to store idempotent response", "error", err)
+		}
+	}
+}
+
+// keepLocked handler ishlayotganda lockni uzaytirib turadi. Qaytgan funksiya
+// uzaytirishni to'xtatadi va u tugaguncha kutadi.
+func (s *Store) keepLocked(ctx context.Context, redisKey string, lock []byte) func() {
+	ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(lockTTL / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := extendLock.Run(ctx, s.client, []string{redisKey}, lock, lockTTL.Milliseconds()).Err(); err != nil && ctx.Err() == nil {
+					slog.WarnContext(ctx, "failed to extend idempotency lock", "error", err)
+				}
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// replay kalit band bo'lganda: saqlangan javobni qaytaradi yoki conflict beradi
+func (s *Store) replay(c *gin.Context, redisKey, fingerprint string) {
+	raw, err := s.client.Get(c.Request.Context(), redisKey).Bytes()
+	if err == redis.Nil {
+		// yozuv SETNX va GET orasida o'chgan: asl so'rov 5xx bilan tugagan
+		c.Header("Retry-After", "1")
+		apierror.Abort(c, http.StatusConflict, "IDEMPOTENCY_KEY_IN_USE", "A request with this Idempotency-Key is still in progress")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(c.Request.Context(), "idempotency store failed", "error", err)
+		apierror.Abort(c, http.StatusServiceUnavailable, "UNAVAILABLE", "Idempotency store is unavailable")
+		return
+	}
+
+	var rec record
+	if err := json.Unmarshal(raw, &rec); err != nil {
+		slog.ErrorContext(c.Request.Context(), "invalid idempotency record", "error", err)
+		apierror.Abort(c, http.StatusInternalServerError, "INTERNAL", http.StatusText(http.StatusInternalServerError))
+		return
+	}
+	if rec.Fingerprint != fingerprint {
+		apierror.Abort(c, http.StatusUnprocessableEntity, "IDEMPOTENCY_KEY_REUSED", "Idempotency-Key was already used for a different request")
+		return
+	}
+	if rec.State == stateProcessing {
+		c.Header("Retry-After", "1")
+		apierror.Abort(c, http.StatusConflict, "IDEMPOTENCY_KEY_IN_USE", "A request with this Idempotency-Key is still in progress")
+		return
+	}
+
+	c.Header(ReplayedHeader, "true")
+	c.Data(rec.Status, rec.ContentType, rec.Body)
+	c.Abort()
+}
+
+// requestFingerprint kalit boshqa so'rov uchun qayta ishlatilganini aniqlash uchun.
+// O'qilgan tana handler uchun qayta tiklanadi, qaytgan funksiya handler dan keyin
+// chaqiriladi.
+func requestFingerprint(c *gin.Context) (string, func(), error) {
+	h := sha256.New()
+	io.WriteString(h, c.Request.Method+" "+c.Request.URL.Path+"\n")
+	contentType := c.GetHeader("Content-Type")
+	if mediaType, params, err := mime.ParseMediaType(contentType); err == nil && strings.HasPrefix(mediaType, "multipart/") {
+		io.WriteString(h, mediaType+"\n")
+		return multipartFingerprint(c, h, params["boundary"])
+	}
+	if c.Request.ContentLength > maxBodyHash {
+		io.WriteString(h, contentType+"\n"+strconv.FormatInt(c.Request.ContentLength, 10))
+		return hex.EncodeToString(h.Sum(nil)), func() {}, nil
+	}
+	if c.Request.Body == nil || c.Request.Body == http.NoBody {
+		return hex.EncodeToString(h.Sum(nil)), func() {}, nil
+	}
+	// chunked tana (ContentLength -1) ham maxBodyHash baytdan ortiq o'qilmaydi,
+	// qolgani handler ga oqim bo'lib o'tadi
+	head, err := io.ReadAll(io.LimitReader(c.Request.Body, maxBodyHash+1))
+	if err != nil {
+		return "", nil, err
+	}
+	if len(head) > maxBodyHash {
+		io.WriteString(h, contentType+"\nchunked\n")
+		h.Write(head[:maxBodyHash])
+		c.Request.Body = readCloser{io.MultiReader(bytes.NewReader(head), c.Request.Body), c.Request.Body}
+		return hex.EncodeToString(h.Sum(nil)), func() {}, nil
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(head))
+	h.Write(head)
+	return hex.EncodeToString(h.Sum(nil)), func() {}, nil
+}
+
+// multipartFingerprint formani bir marta o'qiydi. Boundary har urinishda yangi
+// bo'ladi, shuning uchun u hisobga olinmaydi: oddiy maydonlar nomi bo'yicha
+// saralanib, fayllar esa kelish tartibida (media tartibi shu) SHA-256 si bilan
+// hash qilinadi. O'qilgan tana spool ga yoziladi va handler uni qayta o'qiydi.
+func multipartFingerprint(c *gin.Context, h hash.Hash, boundary string) (string, func(), error) {
+	if boundary == "" {
+		return "", nil, errors.New("multipart boundary is missing")
+	}
+	body := &spool{}
+	limited := &io.LimitedReader{R: c.Request.Body, N: maxSpool + 1}
+	reader := multipart.NewReader(io.TeeReader(limited, body), boundary)
+	var fields, files []string
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err == nil {
+			partHash := sha256.New()
+			_, err = io.Copy(partHash, part)
+			entry := part.FormName() + "=" + hex.EncodeToString(partHash.Sum(nil))
+			if part.FileName() == "" {
+				fields = append(fields, entry)
+			} else {
+				files = append(files, entry)
+			}
+		}
+		if err != nil {
+			body.Close()
+			if limited.N <= 0 {
+				return "", nil, errTooLarge
+			}
+			return "", nil, err
+		}
+	}
+	// epilog ham handler ga yetib borishi kerak
+	if _, err := io.Copy(body, limited); err != nil || limited.N <= 0 {
+		body.Close()
+		if err == nil {
+			err = errTooLarge
+		}
+		return "", nil, err
+	}
+	sort.Strings(fields)
+	io.WriteString(h, strings.Join(fields, "\n")+"\nfiles\n"+strings.Join(files, "\n"))
+
+	rest, err := body.reader()
+	if err != nil {
+		body.Close()
+		return "", nil, err
+	}
+	c.Request.Body = readCloser{rest, c.Request.Body}
+	return hex.EncodeToString(h.Sum(nil)), func() { body.Close() }, nil
+}
+
+// spool o'qilgan multipart tanani saqlaydi: maxBodyHash gacha xotirada, undan
+// kattasi vaqtinchalik faylda
+type spool struct {
+	buf  bytes.Buffer
+	file *os.File
+}
+
+func (s *spool) Write(p []byte) (int, error) {
+	if s.file == nil && s.buf.Len()+len(p) <= maxBodyHash {
+		return s.buf.Write(p)
+	}
+	if s.file == nil {
+		file, err := os.CreateTemp("", "idempotency-*")
+		if err != nil {
+			return 0, err
+		}
+		s.file = file
+		if _, err := file.Write(s.buf.Bytes()); err != nil {
+			return 0, err
+		}
+		s.buf.Reset()
+	}
+	return s.file.Write(p)
+}
+
+func (s *spool) reader() (io.Reader, error) {
+	if s.file == nil {
+		return bytes.NewReader(s.buf.Bytes()), nil
+	}
+	if _, err := s.file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return s.file, nil
+}
+
+// Close vaqtinchalik faylni o'chiradi
+func (s *spool) Close() error {
+	if s.file == nil {
+		return nil
+	}
+	s.file.Close()
+	return os.Remove(s.file.Name())
+}
+
+// readCloser o'qilgan boshni qolgan tana bilan birlashtiradi va asl tanani yopadi
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func newToken() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// captureWriter handler javobini client ga yozish bilan birga saqlab qoladi
+type captureWriter struct {
+	gin.ResponseWriter
+	body     bytes.Buffer
+	overflow bool
+}
+
+func (w *captureWriter) Write(b []byte) (int, error) {
+	w.capture(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *captureWriter) WriteString(s string) (int, error) {
+	w.capture([]byte(s))
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *captureWriter) capture(b []byte) {
+	if w.overflow {
+		return
+	}
+	if w.body.Len()+len(b) > maxStoredBody {
+		w.overflow = true
+		w.body.Reset()
+		return
+	}
+	w.body.Write(b)
+}
+
+func mutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func printable(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 0x20 || s[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
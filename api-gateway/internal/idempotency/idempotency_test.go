@@ -0,0 +1,280 @@
+package idempotency
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"api-gateway/internal/jwt"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+)
+
+// testHandler POST /tweets ni sanaydi va o'qigan tanasining uzunligini qaytaradi
+type testHandler struct {
+	calls   atomic.Int32
+	status  int
+	release chan struct{}
+	started chan struct{}
+}
+
+func newTestRouter(t *testing.T, h *testHandler) (*gin.Engine, *miniredis.Miniredis) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	router := gin.New()
+	// Identify o'rnida
+	router.Use(func(c *gin.Context) {
+		c.Set(jwt.PrincipalKey, jwt.Principal{UserID: 7})
+	})
+	router.Use(NewStore(client).Middleware())
+	router.POST("/tweets", func(c *gin.Context) {
+		n := h.calls.Add(1)
+		if h.started != nil {
+			h.started <- struct{}{}
+		}
+		if h.release != nil {
+			<-h.release
+		}
+		body, _ := io.ReadAll(c.Request.Body)
+		status := h.status
+		if status == 0 {
+			status = http.StatusCreated
+		}
+		c.JSON(status, gin.H{"call": n, "length": len(body)})
+	})
+	return router, server
+}
+
+func post(router *gin.Engine, key, contentType string, body io.Reader) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/tweets", body)
+	req.Header.Set(Header, key)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestReplay(t *testing.T) {
+	h := &testHandler{}
+	router, _ := newTestRouter(t, h)
+
+	first := post(router, "k1", "application/json", strings.NewReader(`{"content":"hi"}`))
+	if first.Code != http.StatusCreated {
+		t.Fatalf("first: status %d", first.Code)
+	}
+	again := post(router, "k1", "application/json", strings.NewReader(`{"content":"hi"}`))
+	if again.Code != http.StatusCreated || again.Body.String() != first.Body.String() || again.Header().Get(ReplayedHeader) != "true" {
+		t.Errorf("retry: status %d, body %s, replayed %q", again.Code, again.Body, again.Header().Get(ReplayedHeader))
+	}
+	if h.calls.Load() != 1 {
+		t.Errorf("handler ran %d times, want 1", h.calls.Load())
+	}
+
+	// kalitsiz so'rov har safar bajariladi
+	post(router, "", "application/json", strings.NewReader(`{"content":"hi"}`))
+	if h.calls.Load() != 2 {
+		t.Errorf("request without a key was not executed")
+	}
+}
+
+func TestKeyReusedForDifferentBody(t *testing.T) {
+	h := &testHandler{}
+	router, _ := newTestRouter(t, h)
+
+	post(router, "k1", "application/json", strings.NewReader(`{"content":"hi"}`))
+	rec := post(router, "k1", "application/json", strings.NewReader(`{"content":"bye"}`))
+	if rec.Code != http.StatusUnprocessableEntity || !strings.Contains(rec.Body.String(), "IDEMPOTENCY_KEY_REUSED") {
+		t.Errorf("status %d: %s", rec.Code, rec.Body)
+	}
+	if h.calls.Load() != 1 {
+		t.Errorf("handler ran %d times, want 1", h.calls.Load())
+	}
+}
+
+func TestServerErrorReleasesKey(t *testing.T) {
+	h := &testHandler{status: http.StatusBadGateway}
+	router, _ := newTestRouter(t, h)
+
+	post(router, "k1", "application/json", strings.NewReader(`{}`))
+	h.status = 0
+	if rec := post(router, "k1", "application/json", strings.NewReader(`{}`)); rec.Code != http.StatusCreated || h.calls.Load() != 2 {
+		t.Errorf("retry after 5xx: status %d, %d calls", rec.Code, h.calls.Load())
+	}
+}
+
+func TestConcurrentRequestIsRejected(t *testing.T) {
+	h := &testHandler{release: make(chan struct{}), started: make(chan struct{}, 1)}
+	router, _ := newTestRouter(t, h)
+
+	done := make(chan *httptest.ResponseRecorder)
+	go func() { done <- post(router, "k1", "application/json", strings.NewReader(`{}`)) }()
+	<-h.started
+
+	rec := post(router, "k1", "application/json", strings.NewReader(`{}`))
+	if rec.Code != http.StatusConflict || rec.Header().Get("Retry-After") == "" {
+		t.Errorf("in-flight duplicate: status %d, Retry-After %q", rec.Code, rec.Header().Get("Retry-After"))
+	}
+	close(h.release)
+	if first := <-done; first.Code != http.StatusCreated {
+		t.Errorf("first: status %d", first.Code)
+	}
+	if h.calls.Load() != 1 {
+		t.Errorf("handler ran %d times, want 1", h.calls.Load())
+	}
+}
+
+func TestLockIsExtendedWhileRunning(t *testing.T) {
+	defer func(ttl time.Duration) { lockTTL = ttl }(lockTTL)
+	lockTTL = 300 * time.Millisecond
+
+	h := &testHandler{release: make(chan struct{}), started: make(chan struct{}, 1)}
+	router, server := newTestRouter(t, h)
+	done := make(chan struct{})
+	go func() {
+		post(router, "k1", "application/json", strings.NewReader(`{}`))
+		close(done)
+	}()
+	<-h.started
+
+	// sekin so'rov: lockTTL dan ancha uzoq ishlaydi, lekin kalit bo'shamaydi
+	for i := 0; i < 4; i++ {
+		time.Sleep(lockTTL / 2)
+		server.FastForward(lockTTL * 3 / 4)
+	}
+	if rec := post(router, "k1", "application/json", strings.NewReader(`{}`)); rec.Code != http.StatusConflict {
+		t.Errorf("duplicate during a slow request: status %d, want 409", rec.Code)
+	}
+	close(h.release)
+	<-done
+	if h.calls.Load() != 1 {
+		t.Errorf("handler ran %d times, want 1", h.calls.Load())
+	}
+}
+
+func multipartBody(t *testing.T, content string, files ...string) (string, *bytes.Buffer) {
+	t.Helper()
+	body := &bytes.Buffer{}
+	form := multipart.NewWriter(body)
+	form.WriteField("content", content)
+	for _, file := range files {
+		part, err := form.CreateFormFile("media", "photo.jpg")
+		if err != nil {
+			t.Fatal(err)
+		}
+		part.Write([]byte(file))
+	}
+	if err := form.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return form.FormDataContentType(), body
+}
+
+func TestMultipartRetryWithNewBoundary(t *testing.T) {
+	h := &testHandler{}
+	router, _ := newTestRouter(t, h)
+
+	contentType, body := multipartBody(t, "hi")
+	post(router, "k1", contentType, body)
+	// qayta kodlangan forma boshqa boundary bilan keladi
+	contentType, body = multipartBody(t, "hi")
+	rec := post(router, "k1", contentType, body)
+	if rec.Code != http.StatusCreated || rec.Header().Get(ReplayedHeader) != "true" {
+		t.Errorf("multipart retry: status %d, replayed %q", rec.Code, rec.Header().Get(ReplayedHeader))
+	}
+}
+
+func TestMultipartKeyReusedForDifferentForm(t *testing.T) {
+	h := &testHandler{}
+	router, _ := newTestRouter(t, h)
+
+	contentType, body := multipartBody(t, "hi", "photo-1")
+	length := body.Len()
+	rec := post(router, "k1", contentType, body)
+	// handler spool dan butun tanani oladi
+	if rec.Code != http.StatusCreated || !strings.Contains(rec.Body.String(), `"length":`+strconv.Itoa(length)) {
+		t.Fatalf("first request: status %d: %s", rec.Code, rec.Body)
+	}
+
+	contentType, body = multipartBody(t, "hi", "photo-1")
+	if rec := post(router, "k1", contentType, body); rec.Code != http.StatusCreated || rec.Header().Get(ReplayedHeader) != "true" {
+		t.Errorf("same form: status %d, replayed %q", rec.Code, rec.Header().Get(ReplayedHeader))
+	}
+	for name, files := range map[string][]string{
+		"different file": {"photo-2"},
+		"extra file":     {"photo-1", "photo-2"},
+		"no file":        nil,
+	} {
+		contentType, body := multipartBody(t, "hi", files...)
+		if rec := post(router, "k1", contentType, body); rec.Code != http.StatusUnprocessableEntity {
+			t.Errorf("%s: status %d, want 422", name, rec.Code)
+		}
+	}
+	contentType, body = multipartBody(t, "bye", "photo-1")
+	if rec := post(router, "k1", contentType, body); rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("different text: status %d, want 422", rec.Code)
+	}
+	if h.calls.Load() != 1 {
+		t.Errorf("handler ran %d times, want 1", h.calls.Load())
+	}
+}
+
+func TestLargeMultipartIsSpooled(t *testing.T) {
+	h := &testHandler{}
+	router, _ := newTestRouter(t, h)
+
+	contentType, body := multipartBody(t, "hi", strings.Repeat("a", maxBodyHash+1))
+	length := body.Len()
+	if rec := post(router, "k1", contentType, body); rec.Code != http.StatusCreated || !strings.Contains(rec.Body.String(), `"length":`+strconv.Itoa(length)) {
+		t.Errorf("status %d: %s", rec.Code, rec.Body)
+	}
+}
+
+// chunkedBody Content-Length siz (chunked) keladigan tana
+type chunkedBody struct {
+	remaining int
+}
+
+func (b *chunkedBody) Read(p []byte) (int, error) {
+	if b.remaining == 0 {
+		return 0, io.EOF
+	}
+	n := min(len(p), b.remaining)
+	for i := range p[:n] {
+		p[i] = 'a'
+	}
+	b.remaining -= n
+	return n, nil
+}
+
+func TestChunkedBodyIsStreamed(t *testing.T) {
+	h := &testHandler{}
+	router, _ := newTestRouter(t, h)
+
+	size := maxBodyHash + 1<<20
+	req := httptest.NewRequest(http.MethodPost, "/tweets", &chunkedBody{remaining: size})
+	req.ContentLength = -1
+	req.Header.Set(Header, "k1")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	// handler butun tanani oladi, fingerprint faqat boshidan olinadi
+	if rec.Code != http.StatusCreated || !strings.Contains(rec.Body.String(), `"length":`+strconv.Itoa(size)) {
+		t.Errorf("status %d: %s", rec.Code, rec.Body)
+	}
+}
@@ -0,0 +1,107 @@
+package jwt
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	proto "api-gateway/protos/user-proto"
+)
+
+const (
+	// keysTTL is how long a fetched key set is trusted before it is fetched again.
+	keysTTL = 5 * time.Minute
+	// keysMinRefresh limits refetches triggered by tokens with an unknown kid.
+	keysMinRefresh = 30 * time.Second
+)
+
+// KeySet caches the public keys user-service publishes through GetJWKS.
+type KeySet struct {
+	client proto.UserServiceClient
+
+	mu        sync.RWMutex
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+}
+
+func NewKeySet(client proto.UserServiceClient) *KeySet {
+	return &KeySet{client: client, keys: make(map[string]*rsa.PublicKey)}
+}
+
+// Key returns the public key for kid, refreshing the cache when it is stale
+// or when kid is unknown (user-service may have rotated to a new key).
+func (k *KeySet) Key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	k.mu.RLock()
+	key, ok := k.keys[kid]
+	age := time.Since(k.fetchedAt)
+	k.mu.RUnlock()
+
+	if ok && age < keysTTL {
+		return key, nil
+	}
+	if ok || age >= keysMinRefresh {
+		if err := k.refresh(ctx); err != nil {
+			if ok {
+				// user-service vaqtincha ishlamasa eski kalit bilan davom etamiz
+				return key, nil
+			}
+			return nil, err
+		}
+	}
+
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	key, ok = k.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+func (k *KeySet) refresh(ctx context.Context) error {
+	resp, err := k.client.GetJWKS(ctx, &proto.GetJWKSRequest{})
+	if err != nil {
+		return fmt.Errorf("error fetching jwks: %v", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(resp.Keys))
+	for _, jwk := range resp.Keys {
+		if jwk.Kty != "RSA" {
+			continue
+		}
+		key, err := parseJWK(jwk)
+		if err != nil {
+			return fmt.Errorf("error parsing key %q: %v", jwk.Kid, err)
+		}
+		keys[jwk.Kid] = key
+	}
+
+	k.mu.Lock()
+	k.keys = keys
+	k.fetchedAt = time.Now()
+	k.mu.Unlock()
+	return nil
+}
+
+func parseJWK(jwk *proto.JWK) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+	if err != nil {
+		return nil, err
+	}
+	if len(n) == 0 || len(e) == 0 {
+		return nil, errors.New("empty modulus or exponent")
+	}
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}, nil
+}
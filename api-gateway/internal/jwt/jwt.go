@@ -1,59 +1,222 @@
 package jwt
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
+	"api-gateway/internal/apierror"
+	"pkg/logger"
+
 	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
 	"github.com/golang-jwt/jwt"
+	"google.golang.org/grpc/metadata"
 )
 
 var (
-	secretKey = []byte("akramovic")
+	// keys holds the user-service public keys tokens are verified with.
+	keys *KeySet
+
+	// sessions is the Redis client user-service stores login sessions in.
+	sessions *redis.Client
 )
 
+const (
+	// PrincipalKey is the gin context key holding the authenticated Principal.
+	PrincipalKey = "principal"
+
+	// MetadataUserID and MetadataUsername are the gRPC metadata keys used to
+	// forward the principal to the backend services.
+	MetadataUserID   = "x-user-id"
+	MetadataUsername = "x-username"
+	MetadataRole     = "x-user-role"
+
+	RoleUser      = "user"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
+// Principal is the authenticated caller, taken from the token claims.
+type Principal struct {
+	UserID    int32
+	Username  string
+	Role      string
+	SessionID string
+}
+
+// SetKeySet sets the key set used to verify token signatures.
+func SetKeySet(keySet *KeySet) {
+	keys = keySet
+}
+
+// SetSessionStore sets the Redis client used to reject tokens of revoked sessions.
+func SetSessionStore(client *redis.Client) {
+	sessions = client
+}
+
 func Protected() gin.HandlerFunc {
 	return func(c *gin.Context) {
+		if _, ok := GetPrincipal(c); ok {
+			c.Next()
+			return
+		}
+
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Missing authorization header"})
-			c.Abort()
+			apierror.Abort(c, http.StatusUnauthorized, "MISSING_AUTHORIZATION", "Missing authorization header")
 			return
 		}
 
 		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
 		if tokenString == authHeader {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token format"})
-			c.Abort()
+			apierror.Abort(c, http.StatusUnauthorized, "INVALID_TOKEN_FORMAT", "Invalid token format")
 			return
 		}
 
-		err := verifyToken(authHeader)
+		principal, err := verifyToken(c.Request.Context(), tokenString)
 		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token: " + err.Error()})
-			c.Abort()
+			_ = c.Error(err)
+			apierror.Abort(c, http.StatusUnauthorized, "INVALID_TOKEN", "Invalid token")
 			return
 		}
 
+		setPrincipal(c, principal)
+		c.Next()
+	}
+}
+
+// Identify sets the principal when the request carries a valid token and lets
+// every other request through, so middleware such as the rate limiter can tell
+// users apart before Protected runs.
+func Identify() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
+		if authHeader != "" && tokenString != authHeader {
+			if principal, err := verifyToken(c.Request.Context(), tokenString); err == nil {
+				setPrincipal(c, principal)
+			}
+		}
 		c.Next()
 	}
 }
 
-func verifyToken(tokenString string) error {
+func setPrincipal(c *gin.Context, principal Principal) {
+	c.Set(PrincipalKey, principal)
+	ctx := logger.WithUserID(c.Request.Context(), int64(principal.UserID))
+	ctx = context.WithValue(ctx, principalContextKey{}, principal)
+	ctx = metadata.AppendToOutgoingContext(ctx,
+		MetadataUserID, strconv.Itoa(int(principal.UserID)),
+		MetadataUsername, principal.Username,
+		MetadataRole, principal.Role,
+	)
+	c.Request = c.Request.WithContext(ctx)
+}
+
+// RequireRole rejects callers whose role is not one of roles. It must run after Protected.
+func RequireRole(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := GetPrincipal(c)
+		if !ok {
+			apierror.Abort(c, http.StatusUnauthorized, "MISSING_AUTHORIZATION", "Missing authorization header")
+			return
+		}
+		for _, role := range roles {
+			if principal.Role == role {
+				c.Next()
+				return
+			}
+		}
+		apierror.Abort(c, http.StatusForbidden, "INSUFFICIENT_ROLE", "Insufficient role")
+	}
+}
+
+// GetPrincipal returns the principal stored by Protected or Identify, if any.
+func GetPrincipal(c *gin.Context) (Principal, bool) {
+	value, ok := c.Get(PrincipalKey)
+	if !ok {
+		return Principal{}, false
+	}
+	principal, ok := value.(Principal)
+	return principal, ok
+}
+
+type principalContextKey struct{}
+
+// PrincipalFromContext returns the principal Identify stored in the request
+// context. Handlers that only see the *http.Request use it instead of GetPrincipal.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(Principal)
+	return principal, ok
+}
+
+// CurrentUser returns the principal of a request that went through Protected.
+// It panics when used on an unprotected route.
+func CurrentUser(c *gin.Context) Principal {
+	return c.MustGet(PrincipalKey).(Principal)
+}
+
+func verifyToken(ctx context.Context, tokenString string) (Principal, error) {
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		if token.Method != jwt.SigningMethodRS256 {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return secretKey, nil
+		kid, ok := token.Header["kid"].(string)
+		if !ok || kid == "" {
+			return nil, errors.New("token has no kid header")
+		}
+		if keys == nil {
+			return nil, errors.New("key set is not configured")
+		}
+		return keys.Key(ctx, kid)
 	})
 	if err != nil {
-		return err
+		return Principal{}, err
 	}
 	if !token.Valid {
-		return errors.New("token is invalid")
+		return Principal{}, errors.New("token is invalid")
 	}
 
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return Principal{}, errors.New("invalid token claims")
+	}
+	userID, ok := claims["user_id"].(float64)
+	if !ok || userID <= 0 {
+		return Principal{}, errors.New("token has no user_id claim")
+	}
+	username, _ := claims["username"].(string)
+	role, _ := claims["role"].(string)
+	if role == "" {
+		role = RoleUser
+	}
+	sessionID, ok := claims["jti"].(string)
+	if !ok || sessionID == "" {
+		return Principal{}, errors.New("token has no jti claim")
+	}
+
+	if err := checkSession(ctx, sessionID); err != nil {
+		return Principal{}, err
+	}
+
+	return Principal{UserID: int32(userID), Username: username, Role: role, SessionID: sessionID}, nil
+}
+
+// checkSession rejects tokens whose session was revoked by logout or a password change.
+func checkSession(ctx context.Context, sessionID string) error {
+	if sessions == nil {
+		return errors.New("session store is not configured")
+	}
+	n, err := sessions.Exists(ctx, "session:"+sessionID).Result()
+	if err != nil {
+		return fmt.Errorf("error checking session: %v", err)
+	}
+	if n == 0 {
+		return errors.New("session has been revoked")
+	}
 	return nil
 }
@@ -0,0 +1,265 @@
+package jwt
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	proto "api-gateway/protos/user-proto"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"github.com/golang-jwt/jwt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// fakeJWKS user-service o'rnida public kalitlarni qaytaradi
+type fakeJWKS struct {
+	proto.UserServiceClient
+	keys  map[string]*rsa.PrivateKey
+	err   error
+	calls atomic.Int32
+}
+
+func (f *fakeJWKS) GetJWKS(ctx context.Context, req *proto.GetJWKSRequest, opts ...grpc.CallOption) (*proto.GetJWKSResponse, error) {
+	f.calls.Add(1)
+	if f.err != nil {
+		return nil, f.err
+	}
+	resp := &proto.GetJWKSResponse{}
+	for kid, key := range f.keys {
+		resp.Keys = append(resp.Keys, &proto.JWK{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			Alg: "RS256",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+	return resp, nil
+}
+
+func newKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// setup kalitlar to'plami va sessiyalar Redisini sozlaydi, "s1" sessiyasi faol
+func setup(t *testing.T, client *fakeJWKS) *miniredis.Miniredis {
+	t.Helper()
+	server := miniredis.RunT(t)
+	redisClient := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { redisClient.Close() })
+	server.HSet("session:s1", "user_id", "7")
+
+	SetKeySet(NewKeySet(client))
+	SetSessionStore(redisClient)
+	t.Cleanup(func() {
+		SetKeySet(nil)
+		SetSessionStore(nil)
+	})
+	return server
+}
+
+func sign(t *testing.T, kid string, key *rsa.PrivateKey, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func claims(overrides map[string]interface{}) jwt.MapClaims {
+	c := jwt.MapClaims{
+		"user_id":  7,
+		"username": "alice",
+		"role":     "moderator",
+		"jti":      "s1",
+		"exp":      time.Now().Add(time.Minute).Unix(),
+	}
+	for k, v := range overrides {
+		if v == nil {
+			delete(c, k)
+		} else {
+			c[k] = v
+		}
+	}
+	return c
+}
+
+func TestVerifyToken(t *testing.T) {
+	key, other := newKey(t), newKey(t)
+	setup(t, &fakeJWKS{keys: map[string]*rsa.PrivateKey{"k1": key}})
+	ctx := context.Background()
+
+	principal, err := verifyToken(ctx, sign(t, "k1", key, claims(nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if principal != (Principal{UserID: 7, Username: "alice", Role: RoleModerator, SessionID: "s1"}) {
+		t.Errorf("principal = %+v", principal)
+	}
+	if principal, err := verifyToken(ctx, sign(t, "k1", key, claims(map[string]interface{}{"role": nil}))); err != nil || principal.Role != RoleUser {
+		t.Errorf("token without role: %+v, %v; want role %s", principal, err, RoleUser)
+	}
+
+	hs256, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims(nil)).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rejected := map[string]string{
+		"signed by another key": sign(t, "k1", other, claims(nil)),
+		"unknown kid":           sign(t, "k2", key, claims(nil)),
+		"no kid":                sign(t, "", key, claims(nil)),
+		"HS256":                 hs256,
+		"expired":               sign(t, "k1", key, claims(map[string]interface{}{"exp": time.Now().Add(-time.Minute).Unix()})),
+		"no user_id":            sign(t, "k1", key, claims(map[string]interface{}{"user_id": nil})),
+		"no jti":                sign(t, "k1", key, claims(map[string]interface{}{"jti": nil})),
+		"revoked session":       sign(t, "k1", key, claims(map[string]interface{}{"jti": "s2"})),
+		"malformed":             "not.a.token",
+	}
+	for name, token := range rejected {
+		t.Run(name, func(t *testing.T) {
+			if _, err := verifyToken(ctx, token); err == nil {
+				t.Error("token accepted")
+			}
+		})
+	}
+}
+
+func TestKeySetRotation(t *testing.T) {
+	oldKey, rotated := newKey(t), newKey(t)
+	client := &fakeJWKS{keys: map[string]*rsa.PrivateKey{"old": oldKey}}
+	keySet := NewKeySet(client)
+	ctx := context.Background()
+
+	if _, err := keySet.Key(ctx, "old"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := keySet.Key(ctx, "old"); err != nil || client.calls.Load() != 1 {
+		t.Fatalf("cached key: err = %v, %d fetches, want 1", err, client.calls.Load())
+	}
+
+	// user-service yangi kalitga o'tdi; noma'lum kid keysMinRefresh dan keyin yangilaydi
+	client.keys = map[string]*rsa.PrivateKey{"old": oldKey, "new": rotated}
+	if _, err := keySet.Key(ctx, "new"); err == nil {
+		t.Fatal("unknown kid refetched before keysMinRefresh")
+	}
+	keySet.fetchedAt = time.Now().Add(-keysMinRefresh)
+	key, err := keySet.Key(ctx, "new")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.N.Cmp(rotated.N) != 0 || client.calls.Load() != 2 {
+		t.Errorf("rotated key fetched with %d calls", client.calls.Load())
+	}
+
+	// eskirgan kesh user-service ishlamasa ham ishlatiladi
+	client.err = errors.New("unavailable")
+	keySet.fetchedAt = time.Now().Add(-keysTTL)
+	if _, err := keySet.Key(ctx, "old"); err != nil {
+		t.Errorf("stale key while user-service is down: %v", err)
+	}
+	if _, err := keySet.Key(ctx, "missing"); err == nil {
+		t.Error("unknown kid accepted while user-service is down")
+	}
+}
+
+func TestProtected(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	key := newKey(t)
+	setup(t, &fakeJWKS{keys: map[string]*rsa.PrivateKey{"k1": key}})
+
+	var forwarded metadata.MD
+	router := gin.New()
+	router.GET("/me", Protected(), func(c *gin.Context) {
+		forwarded, _ = metadata.FromOutgoingContext(c.Request.Context())
+		c.JSON(http.StatusOK, gin.H{"user_id": CurrentUser(c).UserID})
+	})
+
+	tests := []struct {
+		name   string
+		header string
+		want   int
+	}{
+		{"valid token", "Bearer " + sign(t, "k1", key, claims(nil)), http.StatusOK},
+		{"missing header", "", http.StatusUnauthorized},
+		{"no bearer prefix", sign(t, "k1", key, claims(nil)), http.StatusUnauthorized},
+		{"invalid token", "Bearer invalid", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			forwarded = nil
+			req := httptest.NewRequest(http.MethodGet, "/me", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			if rec.Code != tt.want {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.want, rec.Body)
+			}
+			if tt.want == http.StatusOK {
+				if got := forwarded.Get(MetadataUserID); len(got) != 1 || got[0] != "7" {
+					t.Errorf("forwarded %s = %v, want 7", MetadataUserID, got)
+				}
+				if got := forwarded.Get(MetadataRole); len(got) != 1 || got[0] != RoleModerator {
+					t.Errorf("forwarded %s = %v, want %s", MetadataRole, got, RoleModerator)
+				}
+			}
+		})
+	}
+}
+
+func TestRequireRole(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name      string
+		principal *Principal
+		roles     []string
+		want      int
+	}{
+		{"admin", &Principal{UserID: 1, Role: RoleAdmin}, []string{RoleAdmin}, http.StatusOK},
+		{"moderator on a moderator route", &Principal{UserID: 1, Role: RoleModerator}, []string{RoleModerator, RoleAdmin}, http.StatusOK},
+		{"moderator on an admin route", &Principal{UserID: 1, Role: RoleModerator}, []string{RoleAdmin}, http.StatusForbidden},
+		{"user", &Principal{UserID: 1, Role: RoleUser}, []string{RoleModerator, RoleAdmin}, http.StatusForbidden},
+		{"no principal", nil, []string{RoleAdmin}, http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.GET("/admin", func(c *gin.Context) {
+				if tt.principal != nil {
+					c.Set(PrincipalKey, *tt.principal)
+				}
+			}, RequireRole(tt.roles...), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin", nil))
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// Package logger gateway ning HTTP so'rovlar logi. Logger ning o'zi, PII
+// yashirish va gRPC interceptorlar umumiy pkg/logger da.
+package logger
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Middleware gin.Logger o'rniga har bir HTTP so'rovni JSON ko'rinishida yozadi.
+// Query string yozilmaydi, unda qidiruv so'zlari va tokenlar bo'lishi mumkin.
+// requestid.Middleware dan keyin turishi kerak.
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		status := c.Writer.Status()
+		level := slog.LevelInfo
+		switch {
+		case status >= 500:
+			level = slog.LevelError
+		case status >= 400:
+			level = slog.LevelWarn
+		}
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		attrs := []any{
+			slog.String("method", c.Request.Method),
+			slog.String("route", route),
+			slog.String("path", c.Request.URL.Path),
+			slog.Int("status_code", status),
+			slog.Duration("duration", time.Since(start)),
+			slog.String("client_ip", c.ClientIP()),
+		}
+		if len(c.Errors) > 0 {
+			attrs = append(attrs, slog.String("error", c.Errors.String()))
+		}
+		slog.Log(c.Request.Context(), level, "http request", attrs...)
+	}
+}
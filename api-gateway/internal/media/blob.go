@@ -0,0 +1,87 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"time"
+)
+
+// BlobStore media fayllar saqlanadigan joy. Kalitlar "/" bilan ajratilgan nisbiy
+// yo'l ("media/ab/<hash>.jpg"), ".." va bo'sh qismlarsiz.
+type BlobStore interface {
+	// Put r ni oxirigacha o'qib key ga yozadi. size noma'lum bo'lsa -1.
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, *BlobInfo, error)
+	// Stat obyekt bo'lmasa ErrBlobNotFound qaytaradi
+	Stat(ctx context.Context, key string) (*BlobInfo, error)
+	// Delete obyekt bo'lmasa ham xato qaytarmaydi
+	Delete(ctx context.Context, key string) error
+	// Copy obyektni storage ichida nusxalaydi, fayl gateway orqali o'tmaydi
+	Copy(ctx context.Context, src, dst string) error
+	// Presign method (GET yoki PUT) uchun expires vaqtgacha ishlaydigan URL
+	Presign(ctx context.Context, method, key string, expires time.Duration) (string, error)
+	// URL obyektning doimiy ochiq manzili
+	URL(key string) string
+}
+
+// BlobInfo saqlangan obyekt haqida
+type BlobInfo struct {
+	Key         string
+	Size        int64
+	ContentType string
+	ModTime     time.Time
+}
+
+var (
+	ErrBlobNotFound = errors.New("blob not found")
+	ErrInvalidKey   = errors.New("invalid blob key")
+)
+
+var keyPattern = regexp.MustCompile(`^[A-Za-z0-9_-][A-Za-z0-9._-]*(/[A-Za-z0-9_-][A-Za-z0-9._-]*)*$`)
+
+// validKey local va memory store lar kalitni fayl yo'li sifatida ishlatadi,
+// shuning uchun ".", ".." va yashirin fayllar rad etiladi
+func validKey(key string) bool {
+	return len(key) <= 1024 && keyPattern.MatchString(key)
+}
+
+// BlobConfig storage backendi: minio, local yoki memory
+type BlobConfig struct {
+	Backend string
+	// LocalDir local backend fayllari saqlanadigan papka
+	LocalDir string
+	// PublicURL obyekt URL larining boshi. local va memory uchun gateway dagi
+	// /blobs manzili, minio uchun bo'sh bo'lsa https://<endpoint>/<bucket>.
+	PublicURL string
+	// SigningKey local va memory presigned URL larini imzolash uchun
+	SigningKey string
+
+	MinioEndpoint  string
+	MinioAccessKey string
+	MinioSecretKey string
+	MinioBucket    string
+	MinioUseSSL    bool
+}
+
+// NewBlobStore konfiguratsiyadagi backendni yaratadi
+func NewBlobStore(cfg BlobConfig) (BlobStore, error) {
+	switch cfg.Backend {
+	case "minio", "s3":
+		return NewMinioStore(cfg.MinioEndpoint, cfg.MinioAccessKey, cfg.MinioSecretKey, cfg.MinioBucket, cfg.MinioUseSSL, cfg.PublicURL)
+	case "local":
+		if cfg.SigningKey == "" {
+			return nil, fmt.Errorf("BLOB_SIGNING_KEY is required for the local blob backend")
+		}
+		return NewLocalStore(cfg.LocalDir, cfg.PublicURL, cfg.SigningKey)
+	case "memory":
+		if cfg.SigningKey == "" {
+			return nil, fmt.Errorf("BLOB_SIGNING_KEY is required for the memory blob backend")
+		}
+		return NewMemoryStore(cfg.PublicURL, cfg.SigningKey), nil
+	default:
+		return nil, fmt.Errorf("unknown blob backend %q", cfg.Backend)
+	}
+}
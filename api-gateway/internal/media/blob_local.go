@@ -0,0 +1,141 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+)
+
+// LocalStore fayllarni diskdagi papkada saqlaydi va ularni gateway ning /blobs
+// routeri orqali beradi. MinIO siz lokal ishlab chiqish uchun.
+type LocalStore struct {
+	signer
+	dir string
+}
+
+// NewLocalStore dir papkasini yaratadi (bo'lmasa) va LocalStore qaytaradi
+func NewLocalStore(dir, publicURL, signingKey string) (*LocalStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("error creating blob directory: %v", err)
+	}
+	return &LocalStore{signer: newSigner(publicURL, signingKey), dir: dir}, nil
+}
+
+func (s *LocalStore) path(key string) (string, error) {
+	if !validKey(key) {
+		return "", ErrInvalidKey
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
+
+// Put avval vaqtinchalik faylga yozadi, keyin nomini o'zgartiradi, shuning uchun
+// yarim yozilgan fayl hech qachon o'qilmaydi
+func (s *LocalStore) Put(_ context.Context, key string, r io.Reader, _ int64, _ string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return fmt.Errorf("error creating blob directory: %v", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("error creating blob file: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return fmt.Errorf("error writing blob file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("error writing blob file: %v", err)
+	}
+	if err := os.Rename(tmp.Name(), name); err != nil {
+		return fmt.Errorf("error writing blob file: %v", err)
+	}
+	return nil
+}
+
+func (s *LocalStore) Get(_ context.Context, key string) (io.ReadCloser, *BlobInfo, error) {
+	name, err := s.path(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, nil, localError(err)
+	}
+	stat, err := f.Stat()
+	if err != nil || stat.IsDir() {
+		f.Close()
+		return nil, nil, ErrBlobNotFound
+	}
+	return f, localInfo(key, stat), nil
+}
+
+func (s *LocalStore) Stat(_ context.Context, key string) (*BlobInfo, error) {
+	name, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	stat, err := os.Stat(name)
+	if err != nil {
+		return nil, localError(err)
+	}
+	if stat.IsDir() {
+		return nil, ErrBlobNotFound
+	}
+	return localInfo(key, stat), nil
+}
+
+func (s *LocalStore) Delete(_ context.Context, key string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("error deleting blob file: %v", err)
+	}
+	return nil
+}
+
+func (s *LocalStore) Copy(ctx context.Context, src, dst string) error {
+	r, _, err := s.Get(ctx, src)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	return s.Put(ctx, dst, r, -1, "")
+}
+
+func (s *LocalStore) Presign(_ context.Context, method, key string, expires time.Duration) (string, error) {
+	if !validKey(key) {
+		return "", ErrInvalidKey
+	}
+	return s.presign(method, key, expires), nil
+}
+
+func localError(err error) error {
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrBlobNotFound
+	}
+	return fmt.Errorf("error reading blob file: %v", err)
+}
+
+// localInfo turi fayl kengaytmasidan, kalitlar Uploader da tur bo'yicha nomlanadi
+func localInfo(key string, stat fs.FileInfo) *BlobInfo {
+	return &BlobInfo{
+		Key:         key,
+		Size:        stat.Size(),
+		ContentType: mime.TypeByExtension(path.Ext(key)),
+		ModTime:     stat.ModTime(),
+	}
+}
@@ -0,0 +1,101 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// MemoryStore obyektlarni xotirada saqlaydi. Testlar uchun; gateway qayta
+// ishga tushganda hamma fayllar yo'qoladi.
+type MemoryStore struct {
+	signer
+	mu      sync.RWMutex
+	objects map[string]memoryObject
+}
+
+type memoryObject struct {
+	data        []byte
+	contentType string
+	modTime     time.Time
+}
+
+func NewMemoryStore(publicURL, signingKey string) *MemoryStore {
+	return &MemoryStore{signer: newSigner(publicURL, signingKey), objects: map[string]memoryObject{}}
+}
+
+func (s *MemoryStore) Put(_ context.Context, key string, r io.Reader, _ int64, contentType string) error {
+	if !validKey(key) {
+		return ErrInvalidKey
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("error reading blob: %w", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[key] = memoryObject{data: data, contentType: contentType, modTime: time.Now()}
+	return nil
+}
+
+func (s *MemoryStore) Get(_ context.Context, key string) (io.ReadCloser, *BlobInfo, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	obj, ok := s.objects[key]
+	if !ok {
+		return nil, nil, ErrBlobNotFound
+	}
+	return readSeekNopCloser{bytes.NewReader(obj.data)}, obj.info(key), nil
+}
+
+func (s *MemoryStore) Stat(_ context.Context, key string) (*BlobInfo, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	obj, ok := s.objects[key]
+	if !ok {
+		return nil, ErrBlobNotFound
+	}
+	return obj.info(key), nil
+}
+
+func (s *MemoryStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.objects, key)
+	return nil
+}
+
+func (s *MemoryStore) Copy(_ context.Context, src, dst string) error {
+	if !validKey(dst) {
+		return ErrInvalidKey
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, ok := s.objects[src]
+	if !ok {
+		return ErrBlobNotFound
+	}
+	obj.modTime = time.Now()
+	s.objects[dst] = obj
+	return nil
+}
+
+func (s *MemoryStore) Presign(_ context.Context, method, key string, expires time.Duration) (string, error) {
+	if !validKey(key) {
+		return "", ErrInvalidKey
+	}
+	return s.presign(method, key, expires), nil
+}
+
+func (o memoryObject) info(key string) *BlobInfo {
+	return &BlobInfo{Key: key, Size: int64(len(o.data)), ContentType: o.contentType, ModTime: o.modTime}
+}
+
+type readSeekNopCloser struct {
+	*bytes.Reader
+}
+
+func (readSeekNopCloser) Close() error { return nil }
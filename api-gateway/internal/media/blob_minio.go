@@ -0,0 +1,122 @@
+package media
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// partSize hajmi noma'lum oqim uchun multipart qismi. minio-go standart qiymati
+// 5TB obyektga mo'ljallangan va har bir yuklash uchun yuzlab MB bufer ajratadi.
+const partSize = 16 << 20
+
+// MinioStore MinIO yoki S3 bucketi
+type MinioStore struct {
+	client    *minio.Client
+	bucket    string
+	publicURL string
+}
+
+// NewMinioStore yangi MinioStore yaratadi
+func NewMinioStore(endpoint, accessKeyID, secretAccessKey, bucket string, useSSL bool, publicURL string) (*MinioStore, error) {
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(accessKeyID, secretAccessKey, ""),
+		Secure: useSSL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating minio client: %v", err)
+	}
+	if publicURL == "" {
+		publicURL = "https://" + endpoint + "/" + bucket
+	}
+	return &MinioStore{client: client, bucket: bucket, publicURL: strings.TrimSuffix(publicURL, "/")}, nil
+}
+
+func (s *MinioStore) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	opts := minio.PutObjectOptions{ContentType: contentType}
+	if size < 0 {
+		opts.PartSize = partSize
+	}
+	if _, err := s.client.PutObject(ctx, s.bucket, key, r, size, opts); err != nil {
+		return fmt.Errorf("error uploading to minio: %v", err)
+	}
+	return nil
+}
+
+func (s *MinioStore) Get(ctx context.Context, key string) (io.ReadCloser, *BlobInfo, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting minio object: %v", err)
+	}
+	// GetObject so'rovni birinchi o'qishgacha yubormaydi, Stat xatoni darhol ko'rsatadi
+	info, err := obj.Stat()
+	if err != nil {
+		obj.Close()
+		return nil, nil, s.statError(err)
+	}
+	return obj, toBlobInfo(info), nil
+}
+
+func (s *MinioStore) Stat(ctx context.Context, key string) (*BlobInfo, error) {
+	info, err := s.client.StatObject(ctx, s.bucket, key, minio.StatObjectOptions{})
+	if err != nil {
+		return nil, s.statError(err)
+	}
+	return toBlobInfo(info), nil
+}
+
+func (s *MinioStore) Delete(ctx context.Context, key string) error {
+	if err := s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("error deleting minio object: %v", err)
+	}
+	return nil
+}
+
+func (s *MinioStore) Copy(ctx context.Context, src, dst string) error {
+	_, err := s.client.CopyObject(ctx,
+		minio.CopyDestOptions{Bucket: s.bucket, Object: dst},
+		minio.CopySrcOptions{Bucket: s.bucket, Object: src},
+	)
+	if err != nil {
+		return fmt.Errorf("error copying minio object: %v", err)
+	}
+	return nil
+}
+
+func (s *MinioStore) Presign(ctx context.Context, method, key string, expires time.Duration) (string, error) {
+	var presigned fmt.Stringer
+	var err error
+	switch method {
+	case http.MethodGet:
+		presigned, err = s.client.PresignedGetObject(ctx, s.bucket, key, expires, nil)
+	case http.MethodPut:
+		presigned, err = s.client.PresignedPutObject(ctx, s.bucket, key, expires)
+	default:
+		return "", fmt.Errorf("unsupported presign method %q", method)
+	}
+	if err != nil {
+		return "", fmt.Errorf("error presigning minio object: %v", err)
+	}
+	return presigned.String(), nil
+}
+
+func (s *MinioStore) URL(key string) string {
+	return s.publicURL + "/" + key
+}
+
+func (s *MinioStore) statError(err error) error {
+	if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+		return ErrBlobNotFound
+	}
+	return fmt.Errorf("error getting minio object info: %v", err)
+}
+
+func toBlobInfo(info minio.ObjectInfo) *BlobInfo {
+	return &BlobInfo{Key: info.Key, Size: info.Size, ContentType: info.ContentType, ModTime: info.LastModified}
+}
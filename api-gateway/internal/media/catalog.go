@@ -0,0 +1,202 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	userproto "api-gateway/protos/user-proto"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var ErrQuotaExceeded = errors.New("media storage quota exceeded")
+
+// Catalog media yozuvlari va foydalanuvchilarning kvotasi. Yozuv fayl saqlangandan
+// keyin biriktirilmagan holda yaratiladi; tweet, xabar yoki avatar uni Attach
+// bilan egallaydi, ClaimTTL ichida egallanmagani Sessions.Collect da o'chiriladi.
+type Catalog interface {
+	// Create m.ID bilan yozuv qo'shadi. Egasining kvotasi yetmasa ErrQuotaExceeded.
+	Create(ctx context.Context, owner int32, m *Media) error
+	// Remaining egasining kvotasidan qolgan baytlar
+	Remaining(ctx context.Context, owner int32) (int64, error)
+	// Attach owner ning biriktirilmagan media larini ids tartibida biriktiradi.
+	// Birortasi topilmasa hech biri biriktirilmaydi va ErrMediaNotFound.
+	Attach(ctx context.Context, owner int32, ids []string) ([]*Media, error)
+	Detach(ctx context.Context, owner int32, ids []string) error
+	// Unattached before dan oldin yaratilgan biriktirilmagan media, eng eskisi birinchi
+	Unattached(ctx context.Context, before time.Time, limit int) ([]*Media, error)
+	// Delete biriktirilmagan yozuvni o'chiradi. Yozuv shu orada biriktirilgan
+	// bo'lsa false, uning fayllari o'chirilmasligi kerak.
+	Delete(ctx context.Context, id string) (bool, error)
+}
+
+// userCatalog yozuvlarni user-service dagi media jadvalida saqlaydi
+type userCatalog struct {
+	client userproto.UserServiceClient
+}
+
+// NewCatalog user-service ga ulangan Catalog
+func NewCatalog(client userproto.UserServiceClient) Catalog {
+	return &userCatalog{client: client}
+}
+
+func (c *userCatalog) Create(ctx context.Context, owner int32, m *Media) error {
+	_, err := c.client.CreateMedia(ctx, &userproto.CreateMediaRequest{Media: m.toProto(owner)})
+	return catalogError(err)
+}
+
+func (c *userCatalog) Remaining(ctx context.Context, owner int32) (int64, error) {
+	resp, err := c.client.GetMediaQuota(ctx, &userproto.GetMediaQuotaRequest{UserId: owner})
+	if err != nil {
+		return 0, catalogError(err)
+	}
+	return resp.RemainingBytes, nil
+}
+
+func (c *userCatalog) Attach(ctx context.Context, owner int32, ids []string) ([]*Media, error) {
+	resp, err := c.client.AttachMedia(ctx, &userproto.AttachMediaRequest{UserId: owner, Ids: ids})
+	if err != nil {
+		return nil, catalogError(err)
+	}
+	return fromProto(resp.Media), nil
+}
+
+func (c *userCatalog) Detach(ctx context.Context, owner int32, ids []string) error {
+	_, err := c.client.DetachMedia(ctx, &userproto.DetachMediaRequest{UserId: owner, Ids: ids})
+	return catalogError(err)
+}
+
+func (c *userCatalog) Unattached(ctx context.Context, before time.Time, limit int) ([]*Media, error) {
+	resp, err := c.client.ListUnattachedMedia(ctx, &userproto.ListUnattachedMediaRequest{
+		CreatedBefore: timestamppb.New(before),
+		Limit:         int32(limit),
+	})
+	if err != nil {
+		return nil, catalogError(err)
+	}
+	return fromProto(resp.Media), nil
+}
+
+func (c *userCatalog) Delete(ctx context.Context, id string) (bool, error) {
+	resp, err := c.client.DeleteMedia(ctx, &userproto.DeleteMediaRequest{Id: id})
+	if err != nil {
+		return false, catalogError(err)
+	}
+	return resp.Deleted, nil
+}
+
+// catalogError user-service xatolarini Abort taniydigan xatolarga aylantiradi
+func catalogError(err error) error {
+	if err == nil {
+		return nil
+	}
+	st := status.Convert(err)
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok {
+			continue
+		}
+		switch info.Reason {
+		case "MEDIA_QUOTA_EXCEEDED":
+			return ErrQuotaExceeded
+		case "MEDIA_NOT_FOUND":
+			return ErrMediaNotFound
+		case "ALT_TEXT_TOO_LONG":
+			return ErrAltTextTooLong
+		}
+	}
+	return err
+}
+
+func (m *Media) toProto(owner int32) *userproto.Media {
+	renditions := make(map[string]string, len(m.Renditions))
+	for _, r := range m.Renditions {
+		renditions[r.Name] = r.URL
+	}
+	return &userproto.Media{
+		Id:         m.ID,
+		OwnerId:    owner,
+		Url:        m.URL,
+		MimeType:   m.Type,
+		SizeBytes:  m.Size,
+		Width:      int32(m.Width),
+		Height:     int32(m.Height),
+		DurationMs: m.DurationMS,
+		Blurhash:   m.Blurhash,
+		AltText:    m.AltText,
+		Renditions: renditions,
+	}
+}
+
+// fromProto yozuvdagi rendition larning o'lchami saqlanmaydi, faqat nomi va URL i
+func fromProto(media []*userproto.Media) []*Media {
+	result := make([]*Media, len(media))
+	for i, m := range media {
+		result[i] = &Media{
+			ID:         m.Id,
+			URL:        m.Url,
+			Type:       m.MimeType,
+			Size:       m.SizeBytes,
+			Width:      int(m.Width),
+			Height:     int(m.Height),
+			DurationMS: m.DurationMs,
+			Blurhash:   m.Blurhash,
+			AltText:    m.AltText,
+		}
+		for name, url := range m.Renditions {
+			result[i].Renditions = append(result[i].Renditions, Rendition{Name: name, URL: url})
+		}
+	}
+	return result
+}
+
+// Claim so'rovga biriktirilgan media
+type Claim struct {
+	catalog Catalog
+	owner   int32
+	media   []*Media
+}
+
+// Claim owner yuklagan media ID larini so'rovga biriktiradi. So'rov
+// muvaffaqiyatsiz tugasa Claim.Release media ni qaytaradi, aks holda media endi
+// GC qilinmaydi.
+func (u *Uploader) Claim(ctx context.Context, owner int32, ids []string, opts Options) (*Claim, error) {
+	media, err := u.catalog.Attach(ctx, owner, ids)
+	if err != nil {
+		return nil, err
+	}
+	claim := &Claim{catalog: u.catalog, owner: owner, media: media}
+	for _, m := range media {
+		if !Accepts(opts.Accept, m.Type) {
+			claim.Release(ctx)
+			return nil, ErrUnsupportedType
+		}
+	}
+	return claim, nil
+}
+
+// Media biriktirilgan media ID lar tartibida
+func (c *Claim) Media() []*Media {
+	return c.media
+}
+
+// Release media ni yana biriktirilmagan holatga qaytaradi
+func (c *Claim) Release(ctx context.Context) {
+	if len(c.media) == 0 {
+		return
+	}
+	ids := make([]string, len(c.media))
+	for i, m := range c.media {
+		ids[i] = m.ID
+	}
+	// so'rov bekor qilingan bo'lsa ham media qaytarilishi kerak
+	ctx = context.WithoutCancel(ctx)
+	if err := c.catalog.Detach(ctx, c.owner, ids); err != nil {
+		slog.ErrorContext(ctx, "failed to release media", "error", err)
+	}
+	c.media = nil
+}
@@ -0,0 +1,187 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memoryCatalog testlar uchun user-service dagi media jadvalining o'rnida
+type memoryCatalog struct {
+	mu    sync.Mutex
+	quota int64
+	now   func() time.Time
+	items map[string]*catalogItem
+}
+
+type catalogItem struct {
+	owner    int32
+	media    Media
+	created  time.Time
+	attached bool
+}
+
+func newMemoryCatalog() *memoryCatalog {
+	return &memoryCatalog{quota: 1 << 30, now: time.Now, items: make(map[string]*catalogItem)}
+}
+
+func (c *memoryCatalog) Create(_ context.Context, owner int32, m *Media) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.used(owner)+m.Size > c.quota {
+		return ErrQuotaExceeded
+	}
+	c.items[m.ID] = &catalogItem{owner: owner, media: *m, created: c.now()}
+	return nil
+}
+
+func (c *memoryCatalog) Remaining(_ context.Context, owner int32) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return max(c.quota-c.used(owner), 0), nil
+}
+
+func (c *memoryCatalog) used(owner int32) int64 {
+	var used int64
+	for _, item := range c.items {
+		if item.owner == owner {
+			used += item.media.Size
+		}
+	}
+	return used
+}
+
+func (c *memoryCatalog) Attach(_ context.Context, owner int32, ids []string) ([]*Media, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	seen := make(map[string]bool)
+	media := make([]*Media, 0, len(ids))
+	for _, id := range ids {
+		item, ok := c.items[id]
+		if !ok || item.owner != owner || item.attached || seen[id] {
+			return nil, ErrMediaNotFound
+		}
+		seen[id] = true
+		m := item.media
+		media = append(media, &m)
+	}
+	for id := range seen {
+		c.items[id].attached = true
+	}
+	return media, nil
+}
+
+func (c *memoryCatalog) Detach(_ context.Context, owner int32, ids []string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, id := range ids {
+		if item, ok := c.items[id]; ok && item.owner == owner {
+			item.attached = false
+		}
+	}
+	return nil
+}
+
+func (c *memoryCatalog) Unattached(_ context.Context, before time.Time, limit int) ([]*Media, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var items []*catalogItem
+	for _, item := range c.items {
+		if !item.attached && item.created.Before(before) {
+			items = append(items, item)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].created.Before(items[j].created) })
+	media := make([]*Media, 0, len(items))
+	for _, item := range items[:min(len(items), limit)] {
+		m := item.media
+		media = append(media, &m)
+	}
+	return media, nil
+}
+
+func (c *memoryCatalog) Delete(_ context.Context, id string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	item, ok := c.items[id]
+	if !ok || item.attached {
+		return false, nil
+	}
+	delete(c.items, id)
+	return true, nil
+}
+
+func TestClaimAttachesUploadedMedia(t *testing.T) {
+	catalog := newMemoryCatalog()
+	uploader := NewUploader(NewMemoryStore(strings.TrimSuffix(publicURL, "/"), "key"), catalog)
+	ctx := context.Background()
+	video := append(append([]byte{}, mp4Header...), "video data"...)
+
+	m, err := uploader.Upload(ctx, 7, bytes.NewReader(video), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// avatar faqat rasm qabul qiladi, boshqa foydalanuvchi ID ni ishlata olmaydi
+	if _, err := uploader.Claim(ctx, 7, []string{m.ID}, Options{Accept: []string{"image/*"}}); !errors.Is(err, ErrUnsupportedType) {
+		t.Errorf("claim as avatar: err = %v, want %v", err, ErrUnsupportedType)
+	}
+	if _, err := uploader.Claim(ctx, 8, []string{m.ID}, Options{}); !errors.Is(err, ErrMediaNotFound) {
+		t.Errorf("claim by another user: err = %v, want %v", err, ErrMediaNotFound)
+	}
+	if _, err := uploader.Claim(ctx, 7, []string{m.ID, m.ID}, Options{}); !errors.Is(err, ErrMediaNotFound) {
+		t.Errorf("claim the same media twice: err = %v, want %v", err, ErrMediaNotFound)
+	}
+
+	claim, err := uploader.Claim(ctx, 7, []string{m.ID}, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if media := claim.Media(); len(media) != 1 || media[0].URL != m.URL {
+		t.Fatalf("claimed %+v", media)
+	}
+	if _, err := uploader.Claim(ctx, 7, []string{m.ID}, Options{}); !errors.Is(err, ErrMediaNotFound) {
+		t.Errorf("second claim: err = %v, want %v", err, ErrMediaNotFound)
+	}
+
+	// so'rov muvaffaqiyatsiz bo'lsa media qaytariladi
+	claim.Release(ctx)
+	if _, err := uploader.Claim(ctx, 7, []string{m.ID}, Options{}); err != nil {
+		t.Errorf("claim after release: %v", err)
+	}
+}
+
+func TestUploaderEnforcesQuota(t *testing.T) {
+	catalog := newMemoryCatalog()
+	store := NewMemoryStore(strings.TrimSuffix(publicURL, "/"), "key")
+	uploader := NewUploader(store, catalog)
+	ctx := context.Background()
+	video := append(append([]byte{}, mp4Header...), "video data"...)
+
+	catalog.quota = int64(len(video))*2 - 1
+	if _, err := uploader.Upload(ctx, 7, bytes.NewReader(video), Options{}); err != nil {
+		t.Fatal(err)
+	}
+	// ikkinchi fayl kvotaga sig'maydi; storage tekshiruvdan oldin yozilgan
+	// vaqtinchalik obyekt ham qolmaydi
+	if _, err := uploader.Upload(ctx, 7, bytes.NewReader(video), Options{}); !errors.Is(err, ErrQuotaExceeded) {
+		t.Fatalf("upload over quota: err = %v, want %v", err, ErrQuotaExceeded)
+	}
+	if len(store.objects) != 1 {
+		t.Errorf("store has %d objects, want 1", len(store.objects))
+	}
+	// kvota har bir foydalanuvchiga alohida
+	if _, err := uploader.Upload(ctx, 8, bytes.NewReader(video), Options{}); err != nil {
+		t.Errorf("upload by another user: %v", err)
+	}
+
+	sessions := NewSessions(nil, uploader)
+	if _, err := sessions.Create(ctx, 7, "video/mp4", int64(len(video)), ""); !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("create session over quota: err = %v, want %v", err, ErrQuotaExceeded)
+	}
+}
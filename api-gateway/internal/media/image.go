@@ -0,0 +1,158 @@
+package media
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+
+	"golang.org/x/image/draw"
+	// image.Decode uchun
+	_ "golang.org/x/image/webp"
+)
+
+// maxPixels dekodlashdan oldin tekshiriladi: kichik faylda ulkan o'lchamli
+// rasm (decompression bomb) gateway xotirasini to'ldirmasligi uchun
+const maxPixels = 50_000_000
+
+// jpegQuality original va rendition larni qayta kodlash sifati
+const jpegQuality = 88
+
+var ErrInvalidImage = errors.New("media file is not a valid image")
+
+// Rendition rasmning o'lchami o'zgartirilgan nusxasi
+type Rendition struct {
+	Name   string `json:"name"`
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// RenditionSpec rendition o'lchami. Square bo'lsa markazdan kvadrat kesiladi va
+// Size tomonli bo'ladi, aks holda nisbat saqlanib Size x Size ichiga sig'diriladi.
+// Rasm hech qachon kattalashtirilmaydi.
+type RenditionSpec struct {
+	Name   string
+	Size   int
+	Square bool
+}
+
+// AvatarRenditions profil rasmlari uchun
+var AvatarRenditions = []RenditionSpec{
+	{Name: "48", Size: 48, Square: true},
+	{Name: "128", Size: 128, Square: true},
+	{Name: "400", Size: 400, Square: true},
+}
+
+// TweetRenditions tweet va direct dagi rasmlar uchun
+var TweetRenditions = []RenditionSpec{
+	{Name: "small", Size: 680},
+	{Name: "medium", Size: 1200},
+	{Name: "large", Size: 2048},
+}
+
+// bounds manba rasmdan olinadigan qism va natija o'lchami
+func (s RenditionSpec) bounds(width, height int) (src image.Rectangle, w, h int) {
+	if s.Square {
+		side := min(width, height)
+		x, y := (width-side)/2, (height-side)/2
+		size := min(side, s.Size)
+		return image.Rect(x, y, x+side, y+side), size, size
+	}
+	w, h = width, height
+	if w > s.Size || h > s.Size {
+		if w >= h {
+			w, h = s.Size, max(1, height*s.Size/width)
+		} else {
+			w, h = max(1, width*s.Size/height), s.Size
+		}
+	}
+	return image.Rect(0, 0, width, height), w, h
+}
+
+// decodeImage rasmni dekodlaydi va EXIF dagi orientatsiyani piksellarga
+// qo'llaydi. Qayta kodlangan rasmda EXIF bo'lmagani uchun aks holda telefonda
+// olingan rasmlar yonboshlab ko'rinardi.
+func decodeImage(data []byte) (image.Image, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, ErrInvalidImage
+	}
+	if config.Width*config.Height > maxPixels {
+		return nil, ErrTooLarge
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+	return orient(img, exifOrientation(data)), nil
+}
+
+// encodeImage rasmni t turida kodlaydi. Go kodlovchilari metadata yozmaydi,
+// shuning uchun EXIF (GPS, qurilma) va boshqa metadata natijada bo'lmaydi.
+func encodeImage(img image.Image, t Type) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	switch t.MIME {
+	case "image/jpeg":
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+	case "image/png":
+		err = (&png.Encoder{CompressionLevel: png.BestCompression}).Encode(&buf, img)
+	default:
+		return nil, fmt.Errorf("cannot encode %s", t.MIME)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error encoding image: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func resize(img image.Image, spec RenditionSpec) image.Image {
+	b := img.Bounds()
+	src, w, h := spec.bounds(b.Dx(), b.Dy())
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, src.Add(b.Min), draw.Src, nil)
+	return dst
+}
+
+// orient EXIF Orientation (1-8) bo'yicha rasmni aylantiradi yoki aks ettiradi
+func orient(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2:
+				dx, dy = w-1-x, y
+			case 3:
+				dx, dy = w-1-x, h-1-y
+			case 4:
+				dx, dy = x, h-1-y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = h-1-y, x
+			case 7:
+				dx, dy = h-1-y, w-1-x
+			case 8:
+				dx, dy = y, w-1-x
+			}
+			dst.Set(dx, dy, img.At(b.Min.X+x, b.Min.Y+y))
+		}
+	}
+	return dst
+}
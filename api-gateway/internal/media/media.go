@@ -0,0 +1,127 @@
+// Package media gateway orqali yuklanadigan rasm va videolar. Fayl diskka yoki
+// xotiraga to'liq yig'ilmasdan to'g'ridan-to'g'ri object storage ga oqim bilan
+// yoziladi. Turi client yuborgan nom yoki Content-Type dan emas, faylning o'zidan
+// aniqlanadi; har bir tur uchun o'lcham chegarasi bor. Obyekt nomi kontentning
+// SHA-256 hashidan olinadi, shuning uchun bir xil fayl bir marta saqlanadi va
+// fayl nomi orqali boshqa obyektni almashtirib yoki yo'l bilan o'ynab bo'lmaydi.
+// Har bir yuklangan fayl Catalog da yozuv oladi va egasining kvotasiga hisoblanadi.
+package media
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"api-gateway/internal/apierror"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Media saqlangan faylning tavsifi. Yozuvi Catalog da, uning ID si tweet,
+// xabar va avatar so'rovlarida yuboriladi.
+type Media struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+	// Type fayl boshidan aniqlangan MIME turi
+	Type string `json:"type"`
+	// Size saqlangan baytlar: fayl va uning rendition lari, kvotaga shu hisoblanadi
+	Size int64 `json:"size"`
+	// Hash saqlangan kontentning hex SHA-256 i, multipart yuklashda obyekt nomi
+	// ham shundan. Sessiya orqali yuklangan video hashlanmaydi.
+	Hash string `json:"hash,omitempty"`
+	// Width, Height va Blurhash qayta kodlangan rasmlar uchun
+	Width      int    `json:"width,omitempty"`
+	Height     int    `json:"height,omitempty"`
+	DurationMS int64  `json:"duration_ms,omitempty"`
+	Blurhash   string `json:"blurhash,omitempty"`
+	// AltText ekran o'quvchilari uchun tavsif, PATCH /media/{id} bilan o'zgartiriladi
+	AltText    string      `json:"alt_text,omitempty"`
+	Renditions []Rendition `json:"renditions,omitempty"`
+}
+
+// Type ruxsat etilgan media turi
+type Type struct {
+	MIME    string
+	Ext     string
+	MaxSize int64
+	// Encode bo'sh bo'lmasa rasm dekodlanadi, metadata siz shu turda qayta
+	// kodlanadi va rendition lar yaratiladi
+	Encode string
+}
+
+// Types yuklash mumkin bo'lgan turlar. Ro'yxatda yo'q tur rad etiladi.
+// WebP uchun kodlovchi yo'q, u PNG ga aylantiriladi. GIF animatsiyasi
+// buzilmasligi uchun o'zgartirilmaydi.
+var Types = []Type{
+	{MIME: "image/jpeg", Ext: ".jpg", MaxSize: 10 << 20, Encode: "image/jpeg"},
+	{MIME: "image/png", Ext: ".png", MaxSize: 10 << 20, Encode: "image/png"},
+	{MIME: "image/webp", Ext: ".webp", MaxSize: 10 << 20, Encode: "image/png"},
+	{MIME: "image/gif", Ext: ".gif", MaxSize: 15 << 20},
+	{MIME: "video/mp4", Ext: ".mp4", MaxSize: 512 << 20},
+	{MIME: "video/webm", Ext: ".webm", MaxSize: 512 << 20},
+}
+
+// sniffLen http.DetectContentType o'qiydigan baytlar soni
+const sniffLen = 512
+
+var (
+	ErrEmpty           = errors.New("media file is empty")
+	ErrUnsupportedType = errors.New("media type is not allowed")
+	ErrTooLarge        = errors.New("media file is too large")
+)
+
+// Lookup MIME turi bo'yicha ruxsat etilgan tur
+func Lookup(mime string) (Type, bool) {
+	for _, t := range Types {
+		if t.MIME == mime {
+			return t, true
+		}
+	}
+	return Type{}, false
+}
+
+// Accepts accept ro'yxati mime ni qamraydimi. Ro'yxatda to'liq tur ("image/png")
+// yoki oila ("image/*") bo'ladi; bo'sh ro'yxat hamma ruxsat etilgan turlarni qabul qiladi.
+func Accepts(accept []string, mime string) bool {
+	if len(accept) == 0 {
+		return true
+	}
+	for _, pattern := range accept {
+		if pattern == mime {
+			return true
+		}
+		if family, ok := strings.CutSuffix(pattern, "/*"); ok && strings.HasPrefix(mime, family+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// Abort yuklash xatosini HTTP javobga aylantiradi
+func Abort(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, ErrEmpty):
+		apierror.BadRequest(c, "EMPTY_MEDIA", "Media file is empty")
+	case errors.Is(err, ErrInvalidImage):
+		apierror.BadRequest(c, "INVALID_IMAGE", "Image could not be decoded")
+	case errors.Is(err, ErrUnsupportedType):
+		apierror.Abort(c, http.StatusUnsupportedMediaType, "UNSUPPORTED_MEDIA_TYPE", "Media type is not allowed")
+	case errors.Is(err, ErrTooLarge):
+		apierror.Abort(c, http.StatusRequestEntityTooLarge, "MEDIA_TOO_LARGE", "Media file is too large")
+	case errors.Is(err, ErrSizeMismatch):
+		apierror.BadRequest(c, "MEDIA_SIZE_MISMATCH", "Uploaded file size differs from the declared size")
+	case errors.Is(err, ErrNotUploaded):
+		apierror.Abort(c, http.StatusConflict, "MEDIA_NOT_UPLOADED", "File has not been uploaded yet")
+	case errors.Is(err, ErrSessionNotFound):
+		apierror.Abort(c, http.StatusNotFound, "UPLOAD_SESSION_NOT_FOUND", "Upload session not found or expired")
+	case errors.Is(err, ErrMediaNotFound):
+		apierror.Abort(c, http.StatusNotFound, "MEDIA_NOT_FOUND", "Media not found or already used")
+	case errors.Is(err, ErrQuotaExceeded):
+		apierror.Abort(c, http.StatusForbidden, "MEDIA_QUOTA_EXCEEDED", "Media storage quota exceeded")
+	case errors.Is(err, ErrAltTextTooLong):
+		apierror.BadRequest(c, "ALT_TEXT_TOO_LONG", "Alt text is too long")
+	default:
+		_ = c.Error(err)
+		apierror.Abort(c, http.StatusInternalServerError, "INTERNAL", "Failed to upload media")
+	}
+}
@@ -0,0 +1,142 @@
+package media
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"api-gateway/internal/apierror"
+
+	"github.com/gin-gonic/gin"
+)
+
+// signer gateway orqali beriladigan obyekt URL lari. Presigned URL S3 dagi kabi
+// method, kalit va muddatni imzolaydi; imzo faqat shu gateway tekshira oladi.
+type signer struct {
+	publicURL string
+	key       []byte
+	now       func() time.Time
+}
+
+func newSigner(publicURL, key string) signer {
+	return signer{publicURL: strings.TrimSuffix(publicURL, "/"), key: []byte(key), now: time.Now}
+}
+
+func (s signer) URL(key string) string {
+	return s.publicURL + "/" + key
+}
+
+func (s signer) presign(method, key string, expires time.Duration) string {
+	expiresAt := s.now().Add(expires).Unix()
+	query := url.Values{
+		"expires":   {strconv.FormatInt(expiresAt, 10)},
+		"signature": {s.signature(method, key, expiresAt)},
+	}
+	return s.URL(key) + "?" + query.Encode()
+}
+
+func (s signer) verify(method, key string, query url.Values) bool {
+	expiresAt, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil || s.now().Unix() > expiresAt {
+		return false
+	}
+	return hmac.Equal([]byte(s.signature(method, key, expiresAt)), []byte(query.Get("signature")))
+}
+
+func (s signer) signature(method, key string, expiresAt int64) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(method + "\n" + key + "\n" + strconv.FormatInt(expiresAt, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// maxBlobSize presigned PUT bilan yuklanadigan faylning chegarasi: eng katta
+// ruxsat etilgan turning hajmi. Tur keyin aniqlanadi, shuning uchun bu yerda
+// faqat umumiy chegara tekshiriladi.
+var maxBlobSize = maxTypeSize()
+
+func maxTypeSize() int64 {
+	var size int64
+	for _, t := range Types {
+		size = max(size, t.MaxSize)
+	}
+	return size
+}
+
+type verifier interface {
+	verify(method, key string, query url.Values) bool
+}
+
+// BlobHandler /blobs/*key routeri: local va memory store fayllarini beradi va
+// presigned PUT URL lari orqali yuklashni qabul qiladi. MinIO da fayllar
+// bucketdan to'g'ridan-to'g'ri olinadi, shuning uchun ok=false.
+func BlobHandler(store BlobStore) (handler gin.HandlerFunc, ok bool) {
+	v, ok := store.(verifier)
+	if !ok {
+		return nil, false
+	}
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		key := strings.TrimPrefix(c.Param("key"), "/")
+
+		if c.Request.Method == http.MethodPut {
+			if !v.verify(http.MethodPut, key, c.Request.URL.Query()) {
+				apierror.Abort(c, http.StatusForbidden, "INVALID_SIGNATURE", "Upload URL is invalid or expired")
+				return
+			}
+			if c.Request.ContentLength > maxBlobSize {
+				Abort(c, ErrTooLarge)
+				return
+			}
+			body := http.MaxBytesReader(c.Writer, c.Request.Body, maxBlobSize)
+			if err := store.Put(ctx, key, body, c.Request.ContentLength, c.ContentType()); err != nil {
+				var tooLarge *http.MaxBytesError
+				if errors.As(err, &tooLarge) {
+					Abort(c, ErrTooLarge)
+					return
+				}
+				_ = c.Error(err)
+				apierror.Abort(c, http.StatusInternalServerError, "INTERNAL", "Failed to store media")
+				return
+			}
+			c.Status(http.StatusOK)
+			return
+		}
+
+		body, info, err := store.Get(ctx, key)
+		if errors.Is(err, ErrBlobNotFound) || errors.Is(err, ErrInvalidKey) {
+			apierror.Abort(c, http.StatusNotFound, "MEDIA_NOT_FOUND", "Media not found")
+			return
+		}
+		if err != nil {
+			_ = c.Error(err)
+			apierror.Abort(c, http.StatusInternalServerError, "INTERNAL", "Failed to read media")
+			return
+		}
+		defer body.Close()
+
+		if info.ContentType != "" {
+			c.Header("Content-Type", info.ContentType)
+		}
+		c.Header("X-Content-Type-Options", "nosniff")
+		// media/ dagi nom kontent hashi, fayl hech qachon o'zgarmaydi
+		if strings.HasPrefix(key, "media/") {
+			c.Header("Cache-Control", "public, max-age=31536000, immutable")
+		}
+		if rs, ok := body.(io.ReadSeeker); ok {
+			http.ServeContent(c.Writer, c.Request, "", info.ModTime, rs)
+			return
+		}
+		c.Header("Content-Length", strconv.FormatInt(info.Size, 10))
+		c.Status(http.StatusOK)
+		if c.Request.Method != http.MethodHead {
+			_, _ = io.Copy(c.Writer, body)
+		}
+	}, true
+}
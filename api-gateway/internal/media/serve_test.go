@@ -0,0 +1,117 @@
+package media
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestBlobHandlerServesLocalStore(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	store, err := NewLocalStore(t.TempDir(), "https://localhost:5050/blobs", "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	serve, ok := BlobHandler(store)
+	if !ok {
+		t.Fatal("local store is not served by the gateway")
+	}
+	router := gin.New()
+	router.GET("/blobs/*key", serve)
+	router.PUT("/blobs/*key", serve)
+	do := func(method, rawURL, body string) *httptest.ResponseRecorder {
+		u, _ := url.Parse(rawURL)
+		req := httptest.NewRequest(method, u.RequestURI(), strings.NewReader(body))
+		req.Header.Set("Content-Type", "video/mp4")
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	ctx := context.Background()
+	presigned, err := store.Presign(ctx, http.MethodPut, "uploads/abc/video.mp4", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec := do(http.MethodPut, strings.Replace(presigned, "abc", "abd", 1), "video"); rec.Code != http.StatusForbidden {
+		t.Errorf("PUT with a signature for another key: %d", rec.Code)
+	}
+	if rec := do(http.MethodPut, presigned, "video"); rec.Code != http.StatusOK {
+		t.Fatalf("presigned PUT: %d %s", rec.Code, rec.Body)
+	}
+	info, err := store.Stat(ctx, "uploads/abc/video.mp4")
+	if err != nil || info.Size != 5 || info.ContentType != "video/mp4" {
+		t.Fatalf("stat after PUT: %+v, %v", info, err)
+	}
+
+	rec := do(http.MethodGet, store.URL("uploads/abc/video.mp4"), "")
+	if rec.Code != http.StatusOK || rec.Body.String() != "video" || rec.Header().Get("Content-Type") != "video/mp4" {
+		t.Errorf("GET: %d %q %s", rec.Code, rec.Body, rec.Header().Get("Content-Type"))
+	}
+	if rec := do(http.MethodGet, "https://localhost:5050/blobs/uploads/../../etc/passwd", ""); rec.Code != http.StatusNotFound {
+		t.Errorf("GET outside the blob directory: %d", rec.Code)
+	}
+
+	store.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+	if rec := do(http.MethodPut, presigned, "video"); rec.Code != http.StatusForbidden {
+		t.Errorf("PUT with an expired URL: %d", rec.Code)
+	}
+}
+
+func TestBlobHandlerLimitsUploadSize(t *testing.T) {
+	defer func(size int64) { maxBlobSize = size }(maxBlobSize)
+	maxBlobSize = 8
+
+	gin.SetMode(gin.TestMode)
+	store, err := NewLocalStore(t.TempDir(), "https://localhost:5050/blobs", "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	serve, _ := BlobHandler(store)
+	router := gin.New()
+	router.PUT("/blobs/*key", serve)
+
+	ctx := context.Background()
+	presigned, err := store.Presign(ctx, http.MethodPut, "uploads/abc/video.mp4", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse(presigned)
+	for _, contentLength := range []int64{9, -1} {
+		// -1: chunked, o'lcham oldindan ma'lum emas
+		req := httptest.NewRequest(http.MethodPut, u.RequestURI(), strings.NewReader("too large"))
+		req.ContentLength = contentLength
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		if rec.Code != http.StatusRequestEntityTooLarge || !strings.Contains(rec.Body.String(), "MEDIA_TOO_LARGE") {
+			t.Errorf("content length %d: %d %s", contentLength, rec.Code, rec.Body)
+		}
+	}
+	if _, err := store.Stat(ctx, "uploads/abc/video.mp4"); err == nil {
+		t.Error("oversized upload was stored")
+	}
+}
+
+func TestValidKey(t *testing.T) {
+	for key, want := range map[string]bool{
+		"media/ab/abcdef.jpg": true,
+		"tmp/0123":            true,
+		"":                    false,
+		"/media/a.jpg":        false,
+		"media//a.jpg":        false,
+		"media/../a.jpg":      false,
+		"media/.hidden":       false,
+		"media/a b.jpg":       false,
+		`media\a.jpg`:         false,
+	} {
+		if got := validKey(key); got != want {
+			t.Errorf("validKey(%q) = %v, want %v", key, got, want)
+		}
+	}
+}
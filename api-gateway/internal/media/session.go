@@ -0,0 +1,342 @@
+package media
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"api-gateway/internal/apierror"
+	"api-gateway/internal/jwt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+)
+
+// Upload sessiyalari katta fayllarni (video) gateway dagi multipart orqali emas,
+// presigned PUT URL bilan to'g'ridan-to'g'ri storage ga yuklash uchun. Client
+// sessiya ochadi, faylni URL ga PUT qiladi va complete chaqiradi; gateway
+// obyektni tekshirib media ID qaytaradi. ID CreateTweet, CreateDirectMessage va
+// AddAvatar da URL o'rniga yuboriladi. Tugatilmagan sessiyalar, shuningdek
+// ClaimTTL ichida biriktirilmagan media (multipart bilan yuklanganlari ham)
+// fayllari bilan birga o'chiriladi.
+const (
+	// SessionTTL presigned URL va sessiyaning amal qilish muddati
+	SessionTTL = time.Hour
+	// ClaimTTL yuklangan media shu muddatda biriktirilmasa o'chiriladi
+	ClaimTTL = 24 * time.Hour
+
+	collectInterval = 5 * time.Minute
+	collectBatch    = 100
+
+	// uploadsKey sorted set: sessiya ID -> o'chirish vaqti (unix)
+	uploadsKey = "media:uploads"
+
+	// MaxAltTextLength alt matnning belgilardagi chegarasi
+	MaxAltTextLength = 1000
+)
+
+var (
+	ErrSessionNotFound = errors.New("upload session not found")
+	ErrNotUploaded     = errors.New("file has not been uploaded yet")
+	ErrSizeMismatch    = errors.New("uploaded file size differs from the declared size")
+	ErrMediaNotFound   = errors.New("media not found")
+	ErrAltTextTooLong  = errors.New("alt text is too long")
+)
+
+// Session client ga qaytariladigan upload sessiyasi
+type Session struct {
+	ID        string `json:"id"`
+	UploadURL string `json:"upload_url"`
+	Method    string `json:"method"`
+	// Headers PUT so'rovida yuborilishi kerak
+	Headers   map[string]string `json:"headers"`
+	ExpiresAt time.Time         `json:"expires_at"`
+}
+
+// session Redisda saqlanadigan sessiya
+type session struct {
+	Owner   int32  `json:"owner"`
+	Type    string `json:"type"`
+	Size    int64  `json:"size"`
+	AltText string `json:"alt_text,omitempty"`
+}
+
+// Sessions upload sessiyalarini Redisda saqlaydi. Bir nechta gateway bir vaqtda
+// ishlaganda ham har bir sessiyani sorted set dan ZREM bilan olib tashlagan
+// bitta jarayon egallaydi: complete yoki GC. Biriktirilmagan media ni esa
+// Catalog dagi yozuvni o'chira olgan jarayon o'chiradi.
+type Sessions struct {
+	client   *redis.Client
+	uploader *Uploader
+	now      func() time.Time
+}
+
+func NewSessions(client *redis.Client, uploader *Uploader) *Sessions {
+	return &Sessions{client: client, uploader: uploader, now: time.Now}
+}
+
+// Create size baytli mimeType faylni yuklash uchun sessiya ochadi. Kvota shu
+// yerda ham tekshiriladi, shunda client sig'maydigan faylni yuklab o'tirmaydi.
+func (s *Sessions) Create(ctx context.Context, owner int32, mimeType string, size int64, altText string) (*Session, error) {
+	t, ok := Lookup(mimeType)
+	if !ok {
+		return nil, ErrUnsupportedType
+	}
+	if size <= 0 {
+		return nil, ErrEmpty
+	}
+	if size > t.MaxSize {
+		return nil, ErrTooLarge
+	}
+	if utf8.RuneCountInString(altText) > MaxAltTextLength {
+		return nil, ErrAltTextTooLong
+	}
+	remaining, err := s.uploader.catalog.Remaining(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	if size > remaining {
+		return nil, ErrQuotaExceeded
+	}
+
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+	url, err := s.uploader.store.Presign(ctx, http.MethodPut, uploadName(id), SessionTTL)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(session{Owner: owner, Type: t.MIME, Size: size, AltText: altText})
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := s.now().Add(SessionTTL)
+	pipe := s.client.TxPipeline()
+	pipe.Set(ctx, sessionKey(id), data, SessionTTL)
+	pipe.ZAdd(ctx, uploadsKey, &redis.Z{Score: float64(expiresAt.Unix()), Member: id})
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("error saving upload session: %v", err)
+	}
+
+	return &Session{
+		ID:        id,
+		UploadURL: url,
+		Method:    http.MethodPut,
+		Headers:   map[string]string{"Content-Type": t.MIME},
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// Complete yuklangan obyektni tekshiradi va biriktirilmagan media ga aylantiradi.
+// Rasmlar uchun tweet va avatar rendition lari birdaniga yaratiladi, chunki ID
+// qayerga biriktirilishi hali ma'lum emas. Fayl hali yuklanmagan bo'lsa sessiya
+// ochiq qoladi; boshqa har qanday natijada sessiya yopiladi va yuklangan obyekt
+// o'chiriladi.
+func (s *Sessions) Complete(ctx context.Context, owner int32, id string) (*Media, error) {
+	var sess session
+	if err := s.load(ctx, sessionKey(id), &sess, ErrSessionNotFound); err != nil {
+		return nil, err
+	}
+	if sess.Owner != owner {
+		return nil, ErrSessionNotFound
+	}
+	t, ok := Lookup(sess.Type)
+	if !ok {
+		return nil, ErrUnsupportedType
+	}
+	info, err := s.uploader.store.Stat(ctx, uploadName(id))
+	if errors.Is(err, ErrBlobNotFound) {
+		return nil, ErrNotUploaded
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := s.client.ZRem(ctx, uploadsKey, id).Result()
+	if err != nil {
+		return nil, fmt.Errorf("error closing upload session: %v", err)
+	}
+	if removed == 0 {
+		// GC yoki parallel complete egallab oldi
+		return nil, ErrSessionNotFound
+	}
+	defer s.closeSession(id)
+
+	if info.Size != sess.Size {
+		return nil, ErrSizeMismatch
+	}
+	m, err := s.uploader.importObject(ctx, uploadName(id), id, t, sessionRenditions)
+	if err != nil {
+		return nil, err
+	}
+	m.AltText = sess.AltText
+	if err := s.uploader.catalog.Create(ctx, owner, m); err != nil {
+		s.deleteMedia(ctx, m)
+		return nil, err
+	}
+	return m, nil
+}
+
+// sessionRenditions sessiya orqali yuklangan rasmlar uchun
+var sessionRenditions = append(append([]RenditionSpec{}, TweetRenditions...), AvatarRenditions...)
+
+// Run Collect ni ctx tugaguncha davriy chaqiradi
+func (s *Sessions) Run(ctx context.Context) {
+	ticker := time.NewTicker(collectInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := s.Collect(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "failed to collect unclaimed media", "error", err)
+			}
+			if n > 0 {
+				slog.InfoContext(ctx, "collected unclaimed media", "count", n)
+			}
+		}
+	}
+}
+
+// Collect muddati o'tgan sessiyalar va biriktirilmagan media fayllarini
+// o'chiradi, o'chirilganlar sonini qaytaradi
+func (s *Sessions) Collect(ctx context.Context) (int, error) {
+	expired := &redis.ZRangeBy{Min: "-inf", Max: strconv.FormatInt(s.now().Unix(), 10), Count: collectBatch}
+	n := 0
+
+	ids, err := s.client.ZRangeByScore(ctx, uploadsKey, expired).Result()
+	if err != nil {
+		return n, fmt.Errorf("error listing upload sessions: %v", err)
+	}
+	for _, id := range ids {
+		removed, err := s.client.ZRem(ctx, uploadsKey, id).Result()
+		if err != nil {
+			return n, fmt.Errorf("error removing upload session: %v", err)
+		}
+		if removed == 1 {
+			s.closeSession(id)
+			n++
+		}
+	}
+
+	media, err := s.uploader.catalog.Unattached(ctx, s.now().Add(-ClaimTTL), collectBatch)
+	if err != nil {
+		return n, fmt.Errorf("error listing unclaimed media: %v", err)
+	}
+	for _, m := range media {
+		deleted, err := s.uploader.catalog.Delete(ctx, m.ID)
+		if err != nil {
+			return n, fmt.Errorf("error removing unclaimed media: %v", err)
+		}
+		if !deleted {
+			// shu orada biriktirildi
+			continue
+		}
+		s.deleteMedia(ctx, m)
+		n++
+	}
+	return n, nil
+}
+
+// load Redis dagi JSON ni o'qiydi, kalit bo'lmasa notFound qaytaradi
+func (s *Sessions) load(ctx context.Context, key string, v any, notFound error) error {
+	data, err := s.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return notFound
+	}
+	if err != nil {
+		return fmt.Errorf("error reading %s: %v", key, err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("error decoding %s: %v", key, err)
+	}
+	return nil
+}
+
+// closeSession yuklangan obyekt va sessiyani o'chiradi
+func (s *Sessions) closeSession(id string) {
+	s.uploader.remove(uploadName(id))
+	ctx, cancel := context.WithTimeout(context.Background(), removeTimeout)
+	defer cancel()
+	_ = s.client.Del(ctx, sessionKey(id)).Err()
+}
+
+// deleteMedia ID nomidagi media fayli va rendition larini o'chiradi. Multipart
+// bilan yuklangan fayllar kontent hashi nomida va boshqa yozuvlar bilan umumiy
+// bo'lishi mumkin, ular o'chirilmaydi.
+func (s *Sessions) deleteMedia(ctx context.Context, m *Media) {
+	t, _ := Lookup(m.Type)
+	keys := []string{objectName(m.ID, t.Ext)}
+	for _, r := range m.Renditions {
+		keys = append(keys, renditionName(m.ID, r.Name, t.Ext))
+	}
+	for _, key := range keys {
+		if err := s.uploader.store.Delete(ctx, key); err != nil {
+			slog.ErrorContext(ctx, "failed to delete media object", "key", key, "error", err)
+		}
+	}
+}
+
+func sessionKey(id string) string {
+	return "media:upload:" + id
+}
+
+// uploadName client presigned URL orqali yozadigan obyekt
+func uploadName(id string) string {
+	return "uploads/" + id
+}
+
+// newID media ID si hash bilan bir xil ko'rinishda, shuning uchun objectName
+// ikkalasi uchun ham ishlaydi
+func newID() (string, error) {
+	id := make([]byte, 32)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("error generating media id: %v", err)
+	}
+	return hex.EncodeToString(id), nil
+}
+
+// createSessionRequest POST /media/uploads body si
+type createSessionRequest struct {
+	// Type faylning MIME turi, Types ga qarang
+	Type string `json:"type"`
+	Size int64  `json:"size"`
+	// AltText ekran o'quvchilar uchun tavsif, keyin PATCH /media/:id bilan o'zgartiriladi
+	AltText string `json:"alt_text"`
+}
+
+// CreateHandler POST /media/uploads
+func (s *Sessions) CreateHandler(c *gin.Context) {
+	var req createSessionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.BadRequest(c, "INVALID_REQUEST", "Invalid request")
+		return
+	}
+	session, err := s.Create(c.Request.Context(), jwt.CurrentUser(c).UserID, req.Type, req.Size, req.AltText)
+	if err != nil {
+		Abort(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, session)
+}
+
+// CompleteHandler POST /media/uploads/:id/complete
+func (s *Sessions) CompleteHandler(c *gin.Context) {
+	m, err := s.Complete(c.Request.Context(), jwt.CurrentUser(c).UserID, c.Param("id"))
+	if err != nil {
+		Abort(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, m)
+}
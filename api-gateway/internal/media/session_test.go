@@ -0,0 +1,250 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+)
+
+func newTestSessions(t *testing.T) (*Sessions, *MemoryStore, *memoryCatalog) {
+	t.Helper()
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+	store := NewMemoryStore(strings.TrimSuffix(publicURL, "/"), "key")
+	catalog := newMemoryCatalog()
+	return NewSessions(client, NewUploader(store, catalog)), store, catalog
+}
+
+// upload presigned URL ga client qiladigan PUT
+func upload(t *testing.T, store BlobStore, id string, data []byte) {
+	t.Helper()
+	if err := store.Put(context.Background(), uploadName(id), bytes.NewReader(data), int64(len(data)), ""); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSessionUploadAndClaim(t *testing.T) {
+	sessions, store, catalog := newTestSessions(t)
+	ctx := context.Background()
+	video := append(append([]byte{}, mp4Header...), "video data"...)
+
+	session, err := sessions.Create(ctx, 7, "video/mp4", int64(len(video)), "A cat playing piano")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(session.UploadURL, "/uploads/"+session.ID+"?") || session.Method != "PUT" {
+		t.Fatalf("unexpected session %+v", session)
+	}
+	if _, err := sessions.Complete(ctx, 7, session.ID); !errors.Is(err, ErrNotUploaded) {
+		t.Fatalf("complete before upload: err = %v, want %v", err, ErrNotUploaded)
+	}
+	upload(t, store, session.ID, video)
+	if _, err := sessions.Complete(ctx, 8, session.ID); !errors.Is(err, ErrSessionNotFound) {
+		t.Fatalf("complete by another user: err = %v, want %v", err, ErrSessionNotFound)
+	}
+
+	m, err := sessions.Complete(ctx, 7, session.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.ID != session.ID || m.URL != publicURL+objectName(session.ID, ".mp4") || m.Size != int64(len(video)) {
+		t.Fatalf("unexpected media %+v", m)
+	}
+	if item := catalog.items[m.ID]; item == nil || item.owner != 7 || item.attached || item.media.AltText != "A cat playing piano" {
+		t.Fatalf("unexpected catalog item %+v", item)
+	}
+	if _, err := store.Stat(ctx, uploadName(session.ID)); !errors.Is(err, ErrBlobNotFound) {
+		t.Errorf("uploaded object was not removed: %v", err)
+	}
+	if _, err := sessions.Complete(ctx, 7, session.ID); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("second complete: err = %v, want %v", err, ErrSessionNotFound)
+	}
+	if _, err := sessions.uploader.Claim(ctx, 7, []string{m.ID}, Options{}); err != nil {
+		t.Errorf("claim: %v", err)
+	}
+}
+
+func TestSessionCompleteCreatesRenditions(t *testing.T) {
+	sessions, store, _ := newTestSessions(t)
+	ctx := context.Background()
+	file := encodePNG(t, gradient(800, 600))
+
+	session, err := sessions.Create(ctx, 7, "image/png", int64(len(file)), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	upload(t, store, session.ID, file)
+	m, err := sessions.Complete(ctx, 7, session.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Width != 800 || m.Height != 600 || m.Blurhash == "" {
+		t.Fatalf("unexpected media %+v", m)
+	}
+	// ID tweet ga ham, avatar ga ham biriktirilishi mumkin
+	if len(m.Renditions) != len(TweetRenditions)+len(AvatarRenditions) {
+		t.Fatalf("got %d renditions, want %d", len(m.Renditions), len(TweetRenditions)+len(AvatarRenditions))
+	}
+	for _, r := range m.Renditions {
+		if _, contentType := read(t, store, r.URL); contentType != "image/png" {
+			t.Errorf("rendition %s stored as %s", r.Name, contentType)
+		}
+	}
+}
+
+func TestSessionCompleteRejectsFiles(t *testing.T) {
+	sessions, store, catalog := newTestSessions(t)
+	ctx := context.Background()
+	video := append(append([]byte{}, mp4Header...), "video data"...)
+
+	tests := []struct {
+		name     string
+		mimeType string
+		size     int64
+		want     error
+	}{
+		{"size differs", "video/mp4", int64(len(video)) + 1, ErrSizeMismatch},
+		{"type differs", "image/png", int64(len(video)), ErrUnsupportedType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session, err := sessions.Create(ctx, 7, tt.mimeType, tt.size, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			upload(t, store, session.ID, video)
+			if _, err := sessions.Complete(ctx, 7, session.ID); !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+			if len(store.objects) != 0 || len(catalog.items) != 0 {
+				t.Errorf("store has %d objects and catalog %d items after a rejected upload", len(store.objects), len(catalog.items))
+			}
+		})
+	}
+
+	if _, err := sessions.Create(ctx, 7, "text/html", 10, ""); !errors.Is(err, ErrUnsupportedType) {
+		t.Errorf("create html session: err = %v, want %v", err, ErrUnsupportedType)
+	}
+	if _, err := sessions.Create(ctx, 7, "image/png", 11<<20, ""); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("create large session: err = %v, want %v", err, ErrTooLarge)
+	}
+	if _, err := sessions.Create(ctx, 7, "image/png", 10, strings.Repeat("я", MaxAltTextLength+1)); !errors.Is(err, ErrAltTextTooLong) {
+		t.Errorf("create session with long alt text: err = %v, want %v", err, ErrAltTextTooLong)
+	}
+}
+
+func TestCollectRemovesUnclaimedMedia(t *testing.T) {
+	sessions, store, catalog := newTestSessions(t)
+	ctx := context.Background()
+	now := time.Now()
+	sessions.now = func() time.Time { return now }
+	catalog.now = sessions.now
+	video := append(append([]byte{}, mp4Header...), "video data"...)
+
+	newMedia := func() *Media {
+		session, err := sessions.Create(ctx, 7, "video/mp4", int64(len(video)), "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		upload(t, store, session.ID, video)
+		m, err := sessions.Complete(ctx, 7, session.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+	unclaimed := newMedia()
+	claimed := newMedia()
+	if _, err := sessions.uploader.Claim(ctx, 7, []string{claimed.ID}, Options{}); err != nil {
+		t.Fatal(err)
+	}
+	// multipart bilan yuklangan fayl hash nomida, uni boshqa yozuvlar ham ishlatadi
+	multipart, err := sessions.uploader.Upload(ctx, 7, bytes.NewReader(video), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// yuklangan, lekin complete qilinmagan sessiya
+	abandoned, err := sessions.Create(ctx, 7, "video/mp4", int64(len(video)), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	upload(t, store, abandoned.ID, video)
+
+	if n, err := sessions.Collect(ctx); err != nil || n != 0 {
+		t.Fatalf("collect before TTL = %d, %v", n, err)
+	}
+
+	now = now.Add(ClaimTTL + time.Minute)
+	if n, err := sessions.Collect(ctx); err != nil || n != 3 {
+		t.Fatalf("collect after TTL = %d, %v, want 3", n, err)
+	}
+	for _, key := range []string{objectName(unclaimed.ID, ".mp4"), uploadName(abandoned.ID)} {
+		if _, err := store.Stat(ctx, key); !errors.Is(err, ErrBlobNotFound) {
+			t.Errorf("%s was not collected: %v", key, err)
+		}
+	}
+	if _, err := store.Stat(ctx, objectName(claimed.ID, ".mp4")); err != nil {
+		t.Errorf("claimed media was collected: %v", err)
+	}
+	if _, err := store.Stat(ctx, objectName(multipart.Hash, ".mp4")); err != nil {
+		t.Errorf("content addressed object was deleted: %v", err)
+	}
+	if len(catalog.items) != 1 || catalog.items[claimed.ID] == nil {
+		t.Errorf("catalog has %d items after collect, want only the claimed one", len(catalog.items))
+	}
+	if _, err := sessions.uploader.Claim(ctx, 7, []string{unclaimed.ID}, Options{}); !errors.Is(err, ErrMediaNotFound) {
+		t.Errorf("claim collected media: err = %v, want %v", err, ErrMediaNotFound)
+	}
+}
+
+// TestCollectReleasesDetachedMedia tweet yoki xabar o'chirilganda servis media ni
+// ajratadi, Collect uni o'chiradi va egasining kvotasi bo'shaydi
+func TestCollectReleasesDetachedMedia(t *testing.T) {
+	sessions, store, catalog := newTestSessions(t)
+	ctx := context.Background()
+	now := time.Now()
+	sessions.now = func() time.Time { return now }
+	catalog.now = sessions.now
+	video := append(append([]byte{}, mp4Header...), "video data"...)
+	catalog.quota = int64(len(video))
+
+	session, err := sessions.Create(ctx, 7, "video/mp4", int64(len(video)), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	upload(t, store, session.ID, video)
+	m, err := sessions.Complete(ctx, 7, session.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sessions.uploader.Claim(ctx, 7, []string{m.ID}, Options{}); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(ClaimTTL + time.Minute)
+	if _, err := sessions.Collect(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if remaining, _ := catalog.Remaining(ctx, 7); remaining != 0 {
+		t.Fatalf("remaining quota with attached media = %d, want 0", remaining)
+	}
+
+	if err := catalog.Detach(ctx, 7, []string{m.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := sessions.Collect(ctx); err != nil || n != 1 {
+		t.Fatalf("collect detached media = %d, %v, want 1", n, err)
+	}
+	if remaining, _ := catalog.Remaining(ctx, 7); remaining != int64(len(video)) {
+		t.Errorf("remaining quota after release = %d, want %d", remaining, len(video))
+	}
+	if _, err := store.Stat(ctx, objectName(m.ID, ".mp4")); !errors.Is(err, ErrBlobNotFound) {
+		t.Errorf("released media file was not deleted: %v", err)
+	}
+}
@@ -0,0 +1,321 @@
+package media
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"image"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+)
+
+// removeTimeout vaqtinchalik obyektni o'chirish uchun
+const removeTimeout = 10 * time.Second
+
+// Options bitta yuklash uchun cheklovlar va yaratiladigan rendition lar
+type Options struct {
+	// Accept qabul qilinadigan turlar, Accepts ga qarang
+	Accept     []string
+	Renditions []RenditionSpec
+}
+
+// Uploader fayllarni BlobStore ga kontent hashi nomi bilan yuklaydi va ularni
+// Catalog ga yozadi
+type Uploader struct {
+	store   BlobStore
+	catalog Catalog
+}
+
+// NewUploader yangi Uploader yaratadi
+func NewUploader(store BlobStore, catalog Catalog) *Uploader {
+	return &Uploader{store: store, catalog: catalog}
+}
+
+// Upload r ni oxirigacha o'qib saqlaydi va owner uchun biriktirilmagan media
+// yozuvini yaratadi. Rasmlar metadata siz qayta kodlanadi va ulardan
+// opts.Renditions yaratiladi; boshqa turlar o'zgarmasdan oqim bilan yoziladi.
+// Oqim owner ning kvotasidan ortig'i o'qilmasdan to'xtatiladi; rasm kvotadan
+// katta bo'lsa qayta kodlanmaydi.
+func (u *Uploader) Upload(ctx context.Context, owner int32, r io.Reader, opts Options) (*Media, error) {
+	br := bufio.NewReaderSize(r, sniffLen)
+	head, err := br.Peek(sniffLen)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("error reading media: %v", err)
+	}
+	if len(head) == 0 {
+		return nil, ErrEmpty
+	}
+	mediaType, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	t, ok := Lookup(mediaType)
+	if !ok || !Accepts(opts.Accept, mediaType) {
+		return nil, ErrUnsupportedType
+	}
+
+	remaining, err := u.catalog.Remaining(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	if remaining <= 0 {
+		return nil, ErrQuotaExceeded
+	}
+
+	var m *Media
+	if t.Encode != "" {
+		m, err = u.uploadImage(ctx, br, t, opts.Renditions, remaining)
+	} else {
+		m, err = u.uploadStream(ctx, br, t, remaining)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if m.ID, err = newID(); err != nil {
+		return nil, err
+	}
+	// kontent hashi nomidagi obyektni boshqa yozuvlar ham ishlatishi mumkin,
+	// shuning uchun yozuv yaratilmasa ham u o'chirilmaydi
+	if err := u.catalog.Create(ctx, owner, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// uploadStream faylni xotiraga yig'masdan yozadi. Hash fayl to'liq o'qilgandagina
+// ma'lum bo'lgani uchun avval vaqtinchalik nom bilan yoziladi, keyin hash nomiga
+// storage ichida nusxalanadi. Bunday obyekt allaqachon bo'lsa nusxalanmaydi.
+// Video davomiyligi oqimdan yozish bilan bir vaqtda o'qiladi.
+func (u *Uploader) uploadStream(ctx context.Context, r io.Reader, t Type, remaining int64) (*Media, error) {
+	tmp, err := tempName()
+	if err != nil {
+		return nil, err
+	}
+	limit := min(t.MaxSize, remaining)
+	pr, pw := io.Pipe()
+	duration := make(chan int64, 1)
+	go func() {
+		duration <- videoDuration(pr, t.MIME)
+		// qolgani o'qilmasa TeeReader va u bilan birga Put to'xtab qoladi
+		_, _ = io.Copy(io.Discard, pr)
+	}()
+	// chegaradan bitta bayt ortiq o'qiladi, shunda katta fayl aniqlanadi
+	body := &countingReader{r: io.TeeReader(io.LimitReader(r, limit+1), pw), hash: sha256.New()}
+	err = u.store.Put(ctx, tmp, body, -1, t.MIME)
+	pw.Close()
+	durationMS := <-duration
+	if err != nil {
+		return nil, err
+	}
+	defer u.remove(tmp)
+	if body.n > limit {
+		if limit < t.MaxSize {
+			return nil, ErrQuotaExceeded
+		}
+		return nil, ErrTooLarge
+	}
+
+	sum := hex.EncodeToString(body.hash.Sum(nil))
+	name := objectName(sum, t.Ext)
+	_, err = u.store.Stat(ctx, name)
+	if errors.Is(err, ErrBlobNotFound) {
+		err = u.store.Copy(ctx, tmp, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Media{URL: u.store.URL(name), Type: t.MIME, Size: body.n, Hash: sum, DurationMS: durationMS}, nil
+}
+
+// uploadImage rasmni qayta kodlab hash nomi bilan saqlaydi
+func (u *Uploader) uploadImage(ctx context.Context, r io.Reader, t Type, specs []RenditionSpec, remaining int64) (*Media, error) {
+	e, err := readImage(r, t, min(t.MaxSize, remaining))
+	if err != nil {
+		return nil, err
+	}
+	return u.saveImage(ctx, e, e.sum, specs)
+}
+
+// importObject upload sessiyasida storage ga to'g'ridan-to'g'ri yuklangan
+// obyektni tekshiradi va id nomi bilan media ga aylantiradi. Rasmlar multipart
+// dagi kabi qayta kodlanadi; video esa storage ichida nusxalanadi va hashlanmaydi,
+// gateway uning faqat davomiyligi yozilgan joyigacha o'qiydi.
+func (u *Uploader) importObject(ctx context.Context, key, id string, t Type, specs []RenditionSpec) (*Media, error) {
+	body, info, err := u.store.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	br := bufio.NewReaderSize(body, sniffLen)
+	head, err := br.Peek(sniffLen)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("error reading media: %v", err)
+	}
+	if len(head) == 0 {
+		return nil, ErrEmpty
+	}
+	// sessiya ochilganda aytilgan tur fayl boshidan tekshiriladi
+	if mediaType, _, _ := mime.ParseMediaType(http.DetectContentType(head)); mediaType != t.MIME {
+		return nil, ErrUnsupportedType
+	}
+
+	var m *Media
+	if t.Encode != "" {
+		// kvota sessiya ochilganda e'lon qilingan o'lcham bilan tekshirilgan
+		e, err := readImage(br, t, t.MaxSize)
+		if err != nil {
+			return nil, err
+		}
+		m, err = u.saveImage(ctx, e, id, specs)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		name := objectName(id, t.Ext)
+		if err := u.store.Copy(ctx, key, name); err != nil {
+			return nil, err
+		}
+		m = &Media{URL: u.store.URL(name), Type: t.MIME, Size: info.Size, DurationMS: videoDuration(br, t.MIME)}
+	}
+	m.ID = id
+	return m, nil
+}
+
+// encodedImage metadata siz qayta kodlangan rasm
+type encodedImage struct {
+	img  image.Image
+	data []byte
+	t    Type
+	sum  string
+}
+
+// readImage rasmni dekodlash uchun to'liq o'qiydi va uni qayta kodlaydi. limit
+// t.MaxSize (10-15 MB) yoki undan kichik qolgan kvota; limit dan katta fayl
+// dekodlanmaydi. Hash qayta kodlangan fayldan, bir xil rasm har safar bir xil
+// natija beradi.
+func readImage(r io.Reader, t Type, limit int64) (*encodedImage, error) {
+	data, err := io.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("error reading media: %v", err)
+	}
+	if int64(len(data)) > limit {
+		if limit < t.MaxSize {
+			return nil, ErrQuotaExceeded
+		}
+		return nil, ErrTooLarge
+	}
+	img, err := decodeImage(data)
+	if err != nil {
+		return nil, err
+	}
+	stored, _ := Lookup(t.Encode)
+	encoded, err := encodeImage(img, stored)
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(encoded)
+	return &encodedImage{img: img, data: encoded, t: stored, sum: hex.EncodeToString(digest[:])}, nil
+}
+
+// saveImage rasmni va rendition larni base nomi bilan saqlaydi. Size ga
+// rendition lar ham qo'shiladi, kvotaga ular ham hisoblanadi.
+func (u *Uploader) saveImage(ctx context.Context, e *encodedImage, base string, specs []RenditionSpec) (*Media, error) {
+	name := objectName(base, e.t.Ext)
+	if _, err := u.putMissing(ctx, name, e.t.MIME, func() ([]byte, error) { return e.data, nil }); err != nil {
+		return nil, err
+	}
+	b := e.img.Bounds()
+	m := &Media{
+		URL:      u.store.URL(name),
+		Type:     e.t.MIME,
+		Size:     int64(len(e.data)),
+		Hash:     e.sum,
+		Width:    b.Dx(),
+		Height:   b.Dy(),
+		Blurhash: blurhash(e.img),
+	}
+	if err := u.putRenditions(ctx, m, base, e.img, specs); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (u *Uploader) putRenditions(ctx context.Context, m *Media, base string, img image.Image, specs []RenditionSpec) error {
+	t, _ := Lookup(m.Type)
+	for _, spec := range specs {
+		name := renditionName(base, spec.Name, t.Ext)
+		size, err := u.putMissing(ctx, name, t.MIME, func() ([]byte, error) {
+			return encodeImage(resize(img, spec), t)
+		})
+		if err != nil {
+			return err
+		}
+		m.Size += size
+		_, w, h := spec.bounds(m.Width, m.Height)
+		m.Renditions = append(m.Renditions, Rendition{Name: spec.Name, URL: u.store.URL(name), Width: w, Height: h})
+	}
+	return nil
+}
+
+// putMissing obyekt bo'lmasagina uni yaratadi va obyekt o'lchamini qaytaradi
+func (u *Uploader) putMissing(ctx context.Context, name, contentType string, encode func() ([]byte, error)) (int64, error) {
+	info, err := u.store.Stat(ctx, name)
+	if err == nil {
+		return info.Size, nil
+	}
+	if !errors.Is(err, ErrBlobNotFound) {
+		return 0, err
+	}
+	data, err := encode()
+	if err != nil {
+		return 0, err
+	}
+	if err := u.store.Put(ctx, name, bytes.NewReader(data), int64(len(data)), contentType); err != nil {
+		return 0, err
+	}
+	return int64(len(data)), nil
+}
+
+// remove vaqtinchalik obyektni o'chiradi. So'rov bekor qilingan bo'lsa ham
+// o'chirilishi kerak, shuning uchun alohida context bilan.
+func (u *Uploader) remove(name string) {
+	ctx, cancel := context.WithTimeout(context.Background(), removeTimeout)
+	defer cancel()
+	_ = u.store.Delete(ctx, name)
+}
+
+// objectName base kontent hashi yoki sessiya orqali yuklangan media ID si
+func objectName(base, ext string) string {
+	return "media/" + base[:2] + "/" + base + ext
+}
+
+func renditionName(base, rendition, ext string) string {
+	return "media/" + base[:2] + "/" + base + "/" + rendition + ext
+}
+
+func tempName() (string, error) {
+	suffix := make([]byte, 16)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", fmt.Errorf("error generating object name: %v", err)
+	}
+	return "tmp/" + hex.EncodeToString(suffix), nil
+}
+
+// countingReader o'qilgan baytlarni sanaydi va hashlaydi
+type countingReader struct {
+	r    io.Reader
+	hash hash.Hash
+	n    int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	c.hash.Write(p[:n])
+	return n, err
+}
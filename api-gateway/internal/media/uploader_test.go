@@ -0,0 +1,303 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+)
+
+// pngHeader http.DetectContentType image/png deb topadigan eng qisqa boshlanish
+var pngHeader = []byte("\x89PNG\x0D\x0A\x1A\x0A")
+
+// mp4Header http.DetectContentType video/mp4 deb topadigan boshlanish
+var mp4Header = []byte("\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00mp42isom")
+
+const publicURL = "https://localhost:5050/blobs/"
+
+func TestUploaderNamesObjectsByContentHash(t *testing.T) {
+	store := NewMemoryStore(strings.TrimSuffix(publicURL, "/"), "key")
+	catalog := newMemoryCatalog()
+	uploader := NewUploader(store, catalog)
+	ctx := context.Background()
+	file := append(append([]byte{}, mp4Header...), "video data"...)
+
+	first, err := uploader.Upload(ctx, 7, bytes.NewReader(file), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Type != "video/mp4" || first.Size != int64(len(file)) || len(first.Hash) != 64 {
+		t.Fatalf("unexpected media %+v", first)
+	}
+	want := publicURL + "media/" + first.Hash[:2] + "/" + first.Hash + ".mp4"
+	if first.URL != want {
+		t.Fatalf("url = %q, want %q", first.URL, want)
+	}
+
+	// bir xil fayl bir xil obyektga tushadi, vaqtinchalik obyekt qolmaydi
+	second, err := uploader.Upload(ctx, 7, bytes.NewReader(file), Options{Accept: []string{"video/*"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.URL != first.URL {
+		t.Errorf("same content stored as %q and %q", first.URL, second.URL)
+	}
+	if len(store.objects) != 1 {
+		t.Errorf("store has %d objects, want 1", len(store.objects))
+	}
+	// har bir yuklash alohida yozuv va kvotaga hisoblanadi
+	if second.ID == first.ID || len(catalog.items) != 2 {
+		t.Errorf("got ids %q and %q, catalog has %d items", first.ID, second.ID, len(catalog.items))
+	}
+
+	stored, contentType := read(t, store, first.URL)
+	if !bytes.Equal(stored, file) || contentType != "video/mp4" {
+		t.Errorf("stored %q as %s", stored, contentType)
+	}
+}
+
+func TestUploaderRejectsFiles(t *testing.T) {
+	store := NewMemoryStore(strings.TrimSuffix(publicURL, "/"), "key")
+	catalog := newMemoryCatalog()
+	uploader := NewUploader(store, catalog)
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		body   io.Reader
+		accept []string
+		want   error
+	}{
+		{"empty", strings.NewReader(""), nil, ErrEmpty},
+		{"html named like an image", strings.NewReader("<html><script>alert(1)</script>"), nil, ErrUnsupportedType},
+		{"not accepted by the route", bytes.NewReader(mp4Header), []string{"image/*"}, ErrUnsupportedType},
+		{"too large", io.MultiReader(bytes.NewReader(pngHeader), io.LimitReader(zeros{}, 10<<20)), nil, ErrTooLarge},
+		{"broken image", bytes.NewReader(append(append([]byte{}, pngHeader...), "image data"...)), nil, ErrInvalidImage},
+		{"decompression bomb", bytes.NewReader(pngWithSize(t, 20000, 20000)), nil, ErrTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := uploader.Upload(ctx, 7, tt.body, Options{Accept: tt.accept}); !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+			if len(store.objects) != 0 || len(catalog.items) != 0 {
+				t.Errorf("store has %d objects and catalog %d items after a rejected upload", len(store.objects), len(catalog.items))
+			}
+		})
+	}
+}
+
+func TestUploaderCreatesRenditions(t *testing.T) {
+	store := NewMemoryStore(strings.TrimSuffix(publicURL, "/"), "key")
+	uploader := NewUploader(store, newMemoryCatalog())
+	ctx := context.Background()
+	file := encodePNG(t, gradient(1600, 900))
+
+	m, err := uploader.Upload(ctx, 7, bytes.NewReader(file), Options{Renditions: TweetRenditions})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Width != 1600 || m.Height != 900 {
+		t.Fatalf("size = %dx%d, want 1600x900", m.Width, m.Height)
+	}
+	want := map[string][2]int{"small": {680, 382}, "medium": {1200, 675}, "large": {1600, 900}}
+	if len(m.Renditions) != len(want) {
+		t.Fatalf("got %d renditions, want %d", len(m.Renditions), len(want))
+	}
+	for _, r := range m.Renditions {
+		data, contentType := read(t, store, r.URL)
+		config, err := png.DecodeConfig(bytes.NewReader(data))
+		if err != nil || contentType != "image/png" {
+			t.Fatalf("rendition %s: %v, %s", r.Name, err, contentType)
+		}
+		size := want[r.Name]
+		if config.Width != size[0] || config.Height != size[1] || r.Width != size[0] || r.Height != size[1] {
+			t.Errorf("rendition %s is %dx%d (reported %dx%d), want %dx%d",
+				r.Name, config.Width, config.Height, r.Width, r.Height, size[0], size[1])
+		}
+	}
+
+	if len(m.Blurhash) != 28 {
+		t.Errorf("blurhash = %q, want 28 characters for 4x3 components", m.Blurhash)
+	}
+
+	// kvotaga rasm va barcha rendition lar hisoblanadi
+	var stored int64
+	for _, object := range store.objects {
+		stored += int64(len(object.data))
+	}
+	if m.Size != stored {
+		t.Errorf("size = %d, want %d stored bytes", m.Size, stored)
+	}
+}
+
+func TestUploaderEnforcesQuotaForImages(t *testing.T) {
+	store := NewMemoryStore(strings.TrimSuffix(publicURL, "/"), "key")
+	catalog := newMemoryCatalog()
+	uploader := NewUploader(store, catalog)
+	ctx := context.Background()
+	file := encodePNG(t, gradient(1600, 900))
+
+	// qolgan kvotadan katta rasm dekodlanmaydi va saqlanmaydi
+	catalog.quota = int64(len(file)) / 2
+	if _, err := uploader.Upload(ctx, 7, bytes.NewReader(file), Options{Renditions: TweetRenditions}); !errors.Is(err, ErrQuotaExceeded) {
+		t.Fatalf("err = %v, want %v", err, ErrQuotaExceeded)
+	}
+	if len(store.objects) != 0 {
+		t.Errorf("store has %d objects after a rejected upload", len(store.objects))
+	}
+
+	// rasmning o'zi sig'adi, rendition lari bilan sig'maydi
+	catalog.quota = 1 << 30
+	m, err := uploader.Upload(ctx, 8, bytes.NewReader(file), Options{Renditions: TweetRenditions})
+	if err != nil {
+		t.Fatal(err)
+	}
+	catalog.quota = m.Size - 1
+	if _, err := uploader.Upload(ctx, 7, bytes.NewReader(file), Options{Renditions: TweetRenditions}); !errors.Is(err, ErrQuotaExceeded) {
+		t.Fatalf("err = %v, want %v", err, ErrQuotaExceeded)
+	}
+	if len(catalog.items) != 1 {
+		t.Errorf("catalog has %d items, want 1", len(catalog.items))
+	}
+}
+
+func TestBlurhash(t *testing.T) {
+	black := image.NewRGBA(image.Rect(0, 0, 64, 48))
+	for i := 3; i < len(black.Pix); i += 4 {
+		black.Pix[i] = 0xff
+	}
+	if got, want := blurhash(black), "L00000fQfQfQfQfQfQfQfQfQfQfQ"; got != want {
+		t.Errorf("blurhash = %q, want %q", got, want)
+	}
+}
+
+func TestUploaderStripsExifAndAppliesOrientation(t *testing.T) {
+	store := NewMemoryStore(strings.TrimSuffix(publicURL, "/"), "key")
+	uploader := NewUploader(store, newMemoryCatalog())
+	ctx := context.Background()
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, gradient(40, 20), nil); err != nil {
+		t.Fatal(err)
+	}
+	// Orientation=6: kamera rasmni 90° ga burib saqlagan, GPS ham bor deb olamiz
+	file := withExif(buf.Bytes(), exifSegment(6, "GPS 41.2995N 69.2401E"))
+	if exifOrientation(file) != 6 {
+		t.Fatal("test file has no orientation")
+	}
+
+	m, err := uploader.Upload(ctx, 7, bytes.NewReader(file), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Type != "image/jpeg" || m.Width != 20 || m.Height != 40 {
+		t.Fatalf("unexpected media %+v", m)
+	}
+	stored, _ := read(t, store, m.URL)
+	if bytes.Contains(stored, []byte("Exif")) || bytes.Contains(stored, []byte("GPS")) {
+		t.Error("stored image still has EXIF metadata")
+	}
+	config, err := jpeg.DecodeConfig(bytes.NewReader(stored))
+	if err != nil || config.Width != 20 || config.Height != 40 {
+		t.Errorf("stored image is %dx%d (%v), want 20x40", config.Width, config.Height, err)
+	}
+}
+
+func TestRenditionBounds(t *testing.T) {
+	tests := []struct {
+		spec          RenditionSpec
+		width, height int
+		w, h          int
+	}{
+		{RenditionSpec{Size: 680}, 1360, 2720, 340, 680},
+		{RenditionSpec{Size: 680}, 100, 50, 100, 50},
+		{RenditionSpec{Size: 128, Square: true}, 300, 200, 128, 128},
+		{RenditionSpec{Size: 400, Square: true}, 300, 200, 200, 200},
+	}
+	for _, tt := range tests {
+		_, w, h := tt.spec.bounds(tt.width, tt.height)
+		if w != tt.w || h != tt.h {
+			t.Errorf("%+v of %dx%d = %dx%d, want %dx%d", tt.spec, tt.width, tt.height, w, h, tt.w, tt.h)
+		}
+	}
+}
+
+func read(t *testing.T, store BlobStore, url string) ([]byte, string) {
+	t.Helper()
+	body, info, err := store.Get(context.Background(), strings.TrimPrefix(url, publicURL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data, info.ContentType
+}
+
+func gradient(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// pngWithSize faqat sarlavhasi katta o'lchamni e'lon qiladigan PNG
+func pngWithSize(t *testing.T, w, h int) []byte {
+	t.Helper()
+	data := encodePNG(t, gradient(1, 1))
+	// IHDR: 8 bayt imzo, 4 uzunlik, 4 tur, keyin kenglik va balandlik
+	data[16], data[17], data[18], data[19] = byte(w>>24), byte(w>>16), byte(w>>8), byte(w)
+	data[20], data[21], data[22], data[23] = byte(h>>24), byte(h>>16), byte(h>>8), byte(h)
+	binary.BigEndian.PutUint32(data[29:], crc32.ChecksumIEEE(data[12:29]))
+	return data
+}
+
+// exifSegment Orientation va ImageDescription teglari bor big-endian EXIF
+func exifSegment(orientation int, description string) []byte {
+	tiff := []byte{'M', 'M', 0, 42, 0, 0, 0, 8, 0, 2}
+	tiff = append(tiff, 0x01, 0x12, 0, 3, 0, 0, 0, 1, 0, byte(orientation), 0, 0)
+	offset := len(tiff) + 12 + 4
+	tiff = append(tiff, 0x01, 0x0E, 0, 2, 0, 0, 0, byte(len(description)+1), 0, 0, 0, byte(offset))
+	tiff = append(tiff, 0, 0, 0, 0)
+	tiff = append(tiff, description...)
+	tiff = append(tiff, 0)
+	return append([]byte("Exif\x00\x00"), tiff...)
+}
+
+func withExif(jpegData, exif []byte) []byte {
+	length := len(exif) + 2
+	out := append([]byte{}, jpegData[:2]...)
+	out = append(out, 0xFF, 0xE1, byte(length>>8), byte(length))
+	out = append(out, exif...)
+	return append(out, jpegData[2:]...)
+}
+
+type zeros struct{}
+
+func (zeros) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
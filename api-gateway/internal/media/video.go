@@ -0,0 +1,226 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+// maxInfoSize WebM Info elementi uchun chegara, u odatda bir necha yuz bayt
+const maxInfoSize = 64 << 10
+
+var errNoDuration = errors.New("video duration not found")
+
+// videoDuration faylni boshidan ketma-ket o'qib davomiylikni millisekundda
+// qaytaradi. Davomiylik topilgach qolgan qismi o'qilmaydi; MP4 da moov fayl
+// oxirida bo'lsa fayl oxirigacha o'tiladi. Topilmasa 0.
+func videoDuration(r io.Reader, mime string) int64 {
+	var ms int64
+	var err error
+	switch mime {
+	case "video/mp4":
+		ms, err = mp4Duration(r)
+	case "video/webm":
+		ms, err = webmDuration(r)
+	default:
+		return 0
+	}
+	if err != nil {
+		return 0
+	}
+	return ms
+}
+
+// mp4Duration moov/mvhd dagi timescale va duration dan
+func mp4Duration(r io.Reader) (int64, error) {
+	for {
+		typ, size, err := readBox(r)
+		if err != nil {
+			return 0, err
+		}
+		switch typ {
+		case "moov":
+			// moov ichidagi box lar ham shu tarzda o'qiladi
+			continue
+		case "mvhd":
+			if size < 4 || size > 1024 {
+				return 0, errNoDuration
+			}
+			data := make([]byte, size)
+			if _, err := io.ReadFull(r, data); err != nil {
+				return 0, err
+			}
+			return mvhdDuration(data)
+		}
+		if size < 0 {
+			// box fayl oxirigacha davom etadi
+			return 0, errNoDuration
+		}
+		if _, err := io.CopyN(io.Discard, r, size); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// readBox box sarlavhasini o'qiydi va kontent hajmini qaytaradi; -1 fayl oxirigacha
+func readBox(r io.Reader) (string, int64, error) {
+	var header [8]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return "", 0, err
+	}
+	size := int64(binary.BigEndian.Uint32(header[:4]))
+	typ := string(header[4:])
+	switch size {
+	case 0:
+		return typ, -1, nil
+	case 1:
+		var large [8]byte
+		if _, err := io.ReadFull(r, large[:]); err != nil {
+			return "", 0, err
+		}
+		size = int64(binary.BigEndian.Uint64(large[:])) - 16
+	default:
+		size -= 8
+	}
+	if size < 0 {
+		return "", 0, errNoDuration
+	}
+	return typ, size, nil
+}
+
+func mvhdDuration(data []byte) (int64, error) {
+	var timescale uint32
+	var duration uint64
+	// version, flags, keyin yaratilgan va o'zgartirilgan vaqt
+	switch {
+	case data[0] == 0 && len(data) >= 20:
+		timescale = binary.BigEndian.Uint32(data[12:])
+		duration = uint64(binary.BigEndian.Uint32(data[16:]))
+	case data[0] == 1 && len(data) >= 32:
+		timescale = binary.BigEndian.Uint32(data[20:])
+		duration = binary.BigEndian.Uint64(data[24:])
+	default:
+		return 0, errNoDuration
+	}
+	if timescale == 0 {
+		return 0, errNoDuration
+	}
+	return int64(duration * 1000 / uint64(timescale)), nil
+}
+
+// WebM (Matroska) element ID lari
+const (
+	ebmlSegment       = 0x18538067
+	ebmlInfo          = 0x1549A966
+	ebmlCluster       = 0x1F43B675
+	ebmlTimecodeScale = 0x2AD7B1
+	ebmlDuration      = 0x4489
+)
+
+// webmDuration Segment/Info dagi Duration va TimecodeScale dan. Info odatda
+// klasterlardan oldin keladi.
+func webmDuration(r io.Reader) (int64, error) {
+	for {
+		id, err := readVint(r, true)
+		if err != nil {
+			return 0, err
+		}
+		size, err := readVint(r, false)
+		if err != nil {
+			return 0, err
+		}
+		switch id {
+		case ebmlSegment:
+			continue
+		case ebmlCluster:
+			return 0, errNoDuration
+		case ebmlInfo:
+			if size > maxInfoSize {
+				return 0, errNoDuration
+			}
+			data := make([]byte, size)
+			if _, err := io.ReadFull(r, data); err != nil {
+				return 0, err
+			}
+			return infoDuration(data)
+		}
+		if size == unknownSize {
+			return 0, errNoDuration
+		}
+		if _, err := io.CopyN(io.Discard, r, int64(size)); err != nil {
+			return 0, err
+		}
+	}
+}
+
+func infoDuration(data []byte) (int64, error) {
+	r := bytes.NewReader(data)
+	scale := uint64(1000000)
+	duration := -1.0
+	for r.Len() > 0 {
+		id, err := readVint(r, true)
+		if err != nil {
+			return 0, err
+		}
+		size, err := readVint(r, false)
+		if err != nil || size > uint64(r.Len()) {
+			return 0, errNoDuration
+		}
+		value := make([]byte, size)
+		_, _ = r.Read(value)
+		switch {
+		case id == ebmlTimecodeScale && size <= 8:
+			scale = 0
+			for _, b := range value {
+				scale = scale<<8 | uint64(b)
+			}
+		case id == ebmlDuration && size == 4:
+			duration = float64(math.Float32frombits(binary.BigEndian.Uint32(value)))
+		case id == ebmlDuration && size == 8:
+			duration = math.Float64frombits(binary.BigEndian.Uint64(value))
+		}
+	}
+	if duration < 0 {
+		return 0, errNoDuration
+	}
+	// Duration TimecodeScale nanosekund birliklarida
+	return int64(duration * float64(scale) / 1e6), nil
+}
+
+// unknownSize hajmi oldindan noma'lum element (oqim bilan yozilgan fayl)
+const unknownSize = math.MaxUint64
+
+// readVint EBML o'zgaruvchan uzunlikdagi butun son. ID larda uzunlik belgisi
+// qiymatning bir qismi, hajmlarda esa olib tashlanadi.
+func readVint(r io.Reader, keepMarker bool) (uint64, error) {
+	var first [1]byte
+	if _, err := io.ReadFull(r, first[:]); err != nil {
+		return 0, err
+	}
+	length := 1
+	for mask := byte(0x80); length <= 8 && first[0]&mask == 0; mask >>= 1 {
+		length++
+	}
+	if length > 8 {
+		return 0, errNoDuration
+	}
+	value := uint64(first[0])
+	if !keepMarker {
+		value &= uint64(0xFF >> length)
+	}
+	allOnes := value == uint64(0xFF>>length)
+	rest := make([]byte, length-1)
+	if _, err := io.ReadFull(r, rest); err != nil {
+		return 0, err
+	}
+	for _, b := range rest {
+		value = value<<8 | uint64(b)
+		allOnes = allOnes && b == 0xFF
+	}
+	if !keepMarker && allOnes {
+		return unknownSize, nil
+	}
+	return value, nil
+}
@@ -0,0 +1,79 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+	"strings"
+	"testing"
+)
+
+// box MP4 box: 4 bayt hajm, 4 bayt tur va kontent
+func box(typ string, content ...[]byte) []byte {
+	body := bytes.Join(content, nil)
+	header := binary.BigEndian.AppendUint32(nil, uint32(8+len(body)))
+	return append(append(header, typ...), body...)
+}
+
+// mvhd 0-versiya: timescale birlikda duration
+func mvhd(timescale, duration uint32) []byte {
+	data := make([]byte, 100)
+	binary.BigEndian.PutUint32(data[12:], timescale)
+	binary.BigEndian.PutUint32(data[16:], duration)
+	return box("mvhd", data)
+}
+
+// element hajmi 127 baytdan kichik EBML elementi
+func element(id []byte, content ...[]byte) []byte {
+	body := bytes.Join(content, nil)
+	return append(append(append([]byte{}, id...), 0x80|byte(len(body))), body...)
+}
+
+func webm(info []byte) []byte {
+	header := element([]byte{0x1A, 0x45, 0xDF, 0xA3}, element([]byte{0x42, 0x82}, []byte("webm")))
+	// Segment hajmi noma'lum, oqim bilan yozilgan fayllardagi kabi
+	segment := []byte{0x18, 0x53, 0x80, 0x67, 0x01, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+	cluster := element([]byte{0x1F, 0x43, 0xB6, 0x75}, []byte("frames"))
+	return bytes.Join([][]byte{header, segment, info, cluster}, nil)
+}
+
+func TestVideoDuration(t *testing.T) {
+	mdat := box("mdat", bytes.Repeat([]byte{1}, 1<<16))
+	duration := binary.BigEndian.AppendUint64(nil, math.Float64bits(12345))
+	tests := []struct {
+		name string
+		mime string
+		data []byte
+		want int64
+	}{
+		{"mp4", "video/mp4", bytes.Join([][]byte{mp4Header, box("moov", mvhd(1000, 4500)), mdat}, nil), 4500},
+		{"mp4 with moov at the end", "video/mp4", bytes.Join([][]byte{mp4Header, mdat, box("moov", mvhd(90000, 180000))}, nil), 2000},
+		{"mp4 without moov", "video/mp4", bytes.Join([][]byte{mp4Header, mdat}, nil), 0},
+		{"webm", "video/webm", webm(element([]byte{0x15, 0x49, 0xA9, 0x66},
+			element([]byte{0x2A, 0xD7, 0xB1}, []byte{0x0F, 0x42, 0x40}),
+			element([]byte{0x44, 0x89}, duration))), 12345},
+		{"webm without duration", "video/webm", webm(element([]byte{0x15, 0x49, 0xA9, 0x66},
+			element([]byte{0x2A, 0xD7, 0xB1}, []byte{0x0F, 0x42, 0x40}))), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := videoDuration(bytes.NewReader(tt.data), tt.mime); got != tt.want {
+				t.Fatalf("duration = %d, want %d", got, tt.want)
+			}
+
+			// multipart da davomiylik oqimdan, fayl saqlanayotganda o'qiladi
+			store := NewMemoryStore(strings.TrimSuffix(publicURL, "/"), "key")
+			m, err := NewUploader(store, newMemoryCatalog()).Upload(context.Background(), 7, bytes.NewReader(tt.data), Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if m.Type != tt.mime || m.DurationMS != tt.want {
+				t.Errorf("uploaded %s with duration %d, want %s with %d", m.Type, m.DurationMS, tt.mime, tt.want)
+			}
+			if stored, _ := read(t, store, m.URL); !bytes.Equal(stored, tt.data) {
+				t.Error("stored file differs from the upload")
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Package pagination ro'yxat endpointlaridagi ?limit=&cursor= query
+// parametrlarini tekshiradi. Gateway protolarida ular limit va cursor maydonlari
+// (servis protosidagi page_size va page_token bilan bir xil raqamda), keyingi
+// sahifa uchun cursor javobdagi next_page_token dan olinadi. GraphQL esa
+// first/after argumentlarini shu chegaralar bilan tekshiradi.
+package pagination
+
+import (
+	"strconv"
+
+	"api-gateway/internal/apierror"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// MaxLimit servislardagi eng katta page_size bilan bir xil
+	MaxLimit = 100
+	// maxCursorLength dan uzun cursor servisga yuborilmaydi
+	maxCursorLength = 256
+)
+
+// Validate parametrlarni grpc-gateway ga yetmasdan tekshiradi. Noto'g'ri bo'lsa
+// 400 javob yozadi. limit berilmasa servis default ini ishlatadi.
+func Validate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if raw := c.Query("limit"); raw != "" {
+			limit, err := strconv.ParseInt(raw, 10, 32)
+			if err != nil || limit < 1 || limit > MaxLimit {
+				apierror.BadRequest(c, "INVALID_LIMIT", "limit must be between 1 and "+strconv.Itoa(MaxLimit))
+				return
+			}
+		}
+		if len(c.Query("cursor")) > maxCursorLength {
+			apierror.BadRequest(c, "INVALID_CURSOR", "Invalid cursor")
+			return
+		}
+		c.Next()
+	}
+}
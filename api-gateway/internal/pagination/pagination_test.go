@@ -0,0 +1,33 @@
+package pagination
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestValidate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/tweets/saved", Validate(), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	for query, want := range map[string]int{
+		"":                                    http.StatusOK,
+		"?limit=1&cursor=abc":                 http.StatusOK,
+		"?limit=100":                          http.StatusOK,
+		"?limit=0":                            http.StatusBadRequest,
+		"?limit=101":                          http.StatusBadRequest,
+		"?limit=ten":                          http.StatusBadRequest,
+		"?cursor=" + strings.Repeat("a", 257): http.StatusBadRequest,
+		"?cursor=" + strings.Repeat("a", 256): http.StatusOK,
+	} {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/tweets/saved"+query, nil))
+		if rec.Code != want {
+			t.Errorf("%.40s: status %d, want %d", query, rec.Code, want)
+		}
+	}
+}
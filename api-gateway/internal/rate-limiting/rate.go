@@ -1,34 +1,158 @@
 package middleware
 
 import (
+	"fmt"
+	"log/slog"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
+	"api-gateway/internal/apierror"
+	"api-gateway/internal/jwt"
+
 	"github.com/gin-gonic/gin"
-	"golang.org/x/time/rate"
+	"github.com/go-redis/redis/v8"
 )
 
+// gcraScript GCRA (generic cell rate algorithm) bo'yicha so'rovni tekshiradi.
+// Kalitda keyingi "teoretik kelish vaqti" (TAT) saqlanadi, vaqt Redisdan olinadi
+// shuning uchun bir nechta gateway nusxasi bir xil hisobni ko'radi.
+//
+// KEYS[1] - kalit, ARGV[1] - limit, ARGV[2] - oyna (ms).
+// Qaytaradi: {ruxsat (1/0), qolgan so'rovlar, retry_after ms, reset ms}
+var gcraScript = redis.NewScript(`
+local limit = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local interval = window / limit
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+
+local tat = tonumber(redis.call('GET', KEYS[1]) or now)
+if tat < now then
+	tat = now
+end
+
+local new_tat = tat + interval
+local allow_at = new_tat - window
+if allow_at > now then
+	return {0, 0, math.ceil(allow_at - now), math.ceil(tat - now)}
+end
+
+redis.call('SET', KEYS[1], tostring(new_tat), 'PX', math.ceil(new_tat - now))
+local remaining = math.floor((now + window - new_tat) / interval)
+return {1, remaining, 0, math.ceil(new_tat - now)}
+`)
+
+// Policy - oyna ichida ruxsat etilgan so'rovlar soni
+type Policy struct {
+	Limit  int
+	Window time.Duration
+}
+
+// ParsePolicy "5/1m" ko'rinishidagi qatorni o'qiydi
+func ParsePolicy(value string) (Policy, error) {
+	limitStr, windowStr, ok := strings.Cut(strings.TrimSpace(value), "/")
+	if !ok {
+		return Policy{}, fmt.Errorf("invalid rate limit policy %q, expected <limit>/<window>", value)
+	}
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil || limit <= 0 {
+		return Policy{}, fmt.Errorf("invalid rate limit %q", limitStr)
+	}
+	window, err := time.ParseDuration(windowStr)
+	if err != nil || window <= 0 {
+		return Policy{}, fmt.Errorf("invalid rate limit window %q", windowStr)
+	}
+	return Policy{Limit: limit, Window: window}, nil
+}
+
+// ParseRoutePolicies "POST /users/login=5/1m;POST /users/register=3/1h" ko'rinishidagi
+// qatorni route -> Policy ga aylantiradi
+func ParseRoutePolicies(value string) (map[string]Policy, error) {
+	policies := make(map[string]Policy)
+	for _, entry := range strings.Split(value, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		route, policyStr, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid route policy %q, expected <METHOD> <path>=<limit>/<window>", entry)
+		}
+		policy, err := ParsePolicy(policyStr)
+		if err != nil {
+			return nil, err
+		}
+		policies[strings.Join(strings.Fields(route), " ")] = policy
+	}
+	return policies, nil
+}
+
+// unmatchedRoute hech bir route ga mos kelmagan so'rovlar hisobi
+const unmatchedRoute = "unmatched"
+
 type RateLimiter struct {
-	RateLimit int
-	Window    time.Duration
+	client  *redis.Client
+	Default Policy
+	Routes  map[string]Policy
 }
 
-func NewRateLimiter(rateLimit int, window time.Duration) *RateLimiter {
+func NewRateLimiter(client *redis.Client, defaultPolicy Policy, routes map[string]Policy) *RateLimiter {
+	if routes == nil {
+		routes = make(map[string]Policy)
+	}
 	return &RateLimiter{
-		RateLimit: rateLimit,
-		Window:    window,
+		client:  client,
+		Default: defaultPolicy,
+		Routes:  routes,
 	}
 }
 
+// Limit har bir foydalanuvchi (token bo'lmasa IP) va route uchun alohida hisob yuritadi.
+// jwt.Identify dan keyin ulanishi kerak. Topilmagan yo'llar uchun router.NoRoute ga ulanadi.
 func (rl *RateLimiter) Limit() gin.HandlerFunc {
-	limiter := rate.NewLimiter(rate.Every(rl.Window), rl.RateLimit)
-
 	return func(c *gin.Context) {
-		// Rate limiting logic
-		if !limiter.Allow() {
-			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "rate limit exceeded"})
+		route := c.Request.Method + " " + c.FullPath()
+		if c.FullPath() == "" {
+			// topilmagan yo'llar bitta hisobda, aks holda tasodifiy URL lar
+			// Redisda cheksiz kalit yaratadi
+			route = unmatchedRoute
+		}
+		policy, ok := rl.Routes[route]
+		if !ok {
+			policy = rl.Default
+		}
+
+		subject := "ip:" + c.ClientIP()
+		if principal, ok := jwt.GetPrincipal(c); ok {
+			subject = "user:" + strconv.Itoa(int(principal.UserID))
+		}
+		key := "ratelimit:" + route + ":" + subject
+
+		result, err := gcraScript.Run(c.Request.Context(), rl.client, []string{key}, policy.Limit, policy.Window.Milliseconds()).Int64Slice()
+		if err != nil {
+			// Redis ishlamasa so'rovlarni to'xtatmaymiz
+			slog.ErrorContext(c.Request.Context(), "rate limiter failed", "error", err)
+			c.Next()
+			return
+		}
+		allowed, remaining, retryAfter, reset := result[0] == 1, result[1], result[2], result[3]
+
+		c.Header("RateLimit-Policy", fmt.Sprintf("%d;w=%d", policy.Limit, int64(policy.Window.Seconds())))
+		c.Header("RateLimit-Limit", strconv.Itoa(policy.Limit))
+		c.Header("RateLimit-Remaining", strconv.FormatInt(remaining, 10))
+		c.Header("RateLimit-Reset", strconv.FormatInt(ceilSeconds(reset), 10))
+
+		if !allowed {
+			c.Header("Retry-After", strconv.FormatInt(ceilSeconds(retryAfter), 10))
+			apierror.Abort(c, http.StatusTooManyRequests, "RATE_LIMITED", "rate limit exceeded")
 			return
 		}
 		c.Next()
 	}
 }
+
+func ceilSeconds(ms int64) int64 {
+	return (ms + 999) / 1000
+}
@@ -0,0 +1,148 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"api-gateway/internal/jwt"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+)
+
+func newTestRouter(t *testing.T, defaultPolicy Policy, routes map[string]Policy) (*gin.Engine, *miniredis.Miniredis) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	server := miniredis.RunT(t)
+	server.SetTime(time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC))
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	router := gin.New()
+	// Identify o'rnida: X-Test-User sarlavhasi foydalanuvchini bildiradi
+	router.Use(func(c *gin.Context) {
+		if c.GetHeader("X-Test-User") == "7" {
+			c.Set(jwt.PrincipalKey, jwt.Principal{UserID: 7})
+		}
+	})
+	// router.go dagidek: har bir route ga va NoRoute ga alohida ulanadi
+	limit := NewRateLimiter(client, defaultPolicy, routes).Limit()
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.GET("/tweets/:id", limit, ok)
+	router.POST("/users/login", limit, ok)
+	router.NoRoute(limit)
+	return router, server
+}
+
+func do(router *gin.Engine, method, path, user string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	req.RemoteAddr = "203.0.113.1:1234"
+	if user != "" {
+		req.Header.Set("X-Test-User", user)
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestLimitAllowsBurstThenRejects(t *testing.T) {
+	router, server := newTestRouter(t, Policy{Limit: 3, Window: time.Minute}, nil)
+
+	for i, remaining := range []string{"2", "1", "0"} {
+		rec := do(router, http.MethodGet, "/tweets/1", "")
+		if rec.Code != http.StatusOK || rec.Header().Get("RateLimit-Remaining") != remaining {
+			t.Fatalf("request %d: status %d, remaining %s, want 200 and %s", i+1, rec.Code, rec.Header().Get("RateLimit-Remaining"), remaining)
+		}
+	}
+	rec := do(router, http.MethodGet, "/tweets/1", "")
+	if rec.Code != http.StatusTooManyRequests || rec.Header().Get("Retry-After") != "20" {
+		t.Fatalf("over limit: status %d, Retry-After %q, want 429 and 20", rec.Code, rec.Header().Get("Retry-After"))
+	}
+	if got := rec.Header().Get("RateLimit-Policy"); got != "3;w=60" {
+		t.Errorf("RateLimit-Policy = %q", got)
+	}
+
+	// GCRA bir oynani kutmaydi: har 20 soniyada bitta so'rov qaytadi
+	server.SetTime(time.Date(2026, 10, 17, 12, 0, 20, 0, time.UTC))
+	server.FastForward(20 * time.Second)
+	if rec := do(router, http.MethodGet, "/tweets/1", ""); rec.Code != http.StatusOK {
+		t.Errorf("after 20s: status %d, want 200", rec.Code)
+	}
+	if rec := do(router, http.MethodGet, "/tweets/1", ""); rec.Code != http.StatusTooManyRequests {
+		t.Errorf("second request after 20s: status %d, want 429", rec.Code)
+	}
+}
+
+func TestLimitKeys(t *testing.T) {
+	router, server := newTestRouter(t, Policy{Limit: 1, Window: time.Minute}, map[string]Policy{
+		"POST /users/login": {Limit: 2, Window: time.Minute},
+	})
+
+	// route shabloni bo'yicha, foydalanuvchi va IP alohida hisoblanadi
+	if rec := do(router, http.MethodGet, "/tweets/1", ""); rec.Code != http.StatusOK {
+		t.Fatalf("ip: status %d", rec.Code)
+	}
+	if rec := do(router, http.MethodGet, "/tweets/2", ""); rec.Code != http.StatusTooManyRequests {
+		t.Errorf("same route template from the same ip: status %d, want 429", rec.Code)
+	}
+	if rec := do(router, http.MethodGet, "/tweets/1", "7"); rec.Code != http.StatusOK {
+		t.Errorf("user from the same ip: status %d, want 200", rec.Code)
+	}
+	for i := 0; i < 2; i++ {
+		if rec := do(router, http.MethodPost, "/users/login", ""); rec.Code != http.StatusOK {
+			t.Errorf("login %d with route policy: status %d, want 200", i+1, rec.Code)
+		}
+	}
+
+	// topilmagan yo'llar bitta kalitga tushadi
+	for _, path := range []string{"/random-1", "/random-2", "/wp-admin.php"} {
+		do(router, http.MethodGet, path, "")
+	}
+	do(router, "PROPFIND", "/random-3", "")
+	if rec := do(router, http.MethodGet, "/random-4", ""); rec.Code != http.StatusTooManyRequests {
+		t.Errorf("unmatched path over limit: status %d, want 429", rec.Code)
+	}
+	var unmatched []string
+	for _, key := range server.Keys() {
+		if strings.Contains(key, unmatchedRoute) {
+			unmatched = append(unmatched, key)
+		}
+		if strings.Contains(key, "random") || strings.Contains(key, "PROPFIND") {
+			t.Errorf("key %q is derived from an unmatched path", key)
+		}
+	}
+	if len(unmatched) != 1 || unmatched[0] != "ratelimit:unmatched:ip:203.0.113.1" {
+		t.Errorf("unmatched keys = %v", unmatched)
+	}
+}
+
+func TestLimitFailsOpen(t *testing.T) {
+	router, server := newTestRouter(t, Policy{Limit: 1, Window: time.Minute}, nil)
+	server.Close()
+
+	for i := 0; i < 3; i++ {
+		if rec := do(router, http.MethodGet, "/tweets/1", ""); rec.Code != http.StatusOK {
+			t.Fatalf("request %d without redis: status %d, want 200", i+1, rec.Code)
+		}
+	}
+}
+
+func TestParseRoutePolicies(t *testing.T) {
+	policies, err := ParseRoutePolicies(" POST  /users/login=5/1m; POST /users/register=3/1h;")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(policies) != 2 || policies["POST /users/login"] != (Policy{5, time.Minute}) || policies["POST /users/register"] != (Policy{3, time.Hour}) {
+		t.Errorf("policies = %v", policies)
+	}
+
+	for _, value := range []string{"POST /users/login", "POST /users/login=5", "POST /users/login=0/1m", "POST /users/login=5/0s", "POST /users/login=x/1m"} {
+		if _, err := ParseRoutePolicies(value); err == nil {
+			t.Errorf("ParseRoutePolicies(%q) accepted", value)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package redis
+
+import (
+	"api-gateway/config"
+	"log/slog"
+	"pkg/logger"
+
+	"github.com/go-redis/redis/v8"
+)
+
+func NewRedisClient() *redis.Client {
+	conf, err := config.LoadConfig()
+	if err != nil {
+		logger.Fatal("failed to load config", "error", err)
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr: conf.REDIS_HOST + ":" + conf.REDIS_PORT,
+	})
+
+	slog.Info("connected to redis")
+	return client
+}
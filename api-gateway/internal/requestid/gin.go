@@ -0,0 +1,27 @@
+// Package requestid gin so'rovlariga X-Request-ID ni biriktiradi. ID ni context
+// va gRPC metadata orqali uzatish umumiy pkg/requestid da.
+package requestid
+
+import (
+	"pkg/requestid"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GinKey gin context dagi kalit
+const GinKey = "request_id"
+
+// Middleware so'rovdagi X-Request-ID ni qabul qiladi yoki yangisini yaratadi,
+// javobga qaytaradi va handlerlar gRPC ga uzatadigan context ga qo'yadi
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(requestid.Header)
+		if !requestid.Valid(id) {
+			id = requestid.New()
+		}
+		c.Set(GinKey, id)
+		c.Header(requestid.Header, id)
+		c.Request = c.Request.WithContext(requestid.NewContext(c.Request.Context(), id))
+		c.Next()
+	}
+}
@@ -0,0 +1,41 @@
+package rest
+
+import (
+	"api-gateway/internal/apierror"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// form qo'lda yozilgan handlerlar kabi application/x-www-form-urlencoded va
+// multipart form body larni ham qabul qiladi: form maydonlari proto maydonlariga
+// yozilib body JSON ga almashtiriladi. Fayl qismlari faqat upload routelarida o'qiladi.
+func form(input protoreflect.MessageDescriptor) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var err error
+		switch c.ContentType() {
+		case gin.MIMEPOSTForm:
+			err = c.Request.ParseForm()
+		case gin.MIMEMultipartPOSTForm:
+			if err = c.Request.ParseMultipartForm(maxFormValue); err == nil {
+				c.Request.MultipartForm.RemoveAll()
+			}
+		default:
+			return
+		}
+		if err != nil {
+			apierror.BadRequest(c, "INVALID_REQUEST", "Invalid form")
+			return
+		}
+
+		msg := dynamicpb.NewMessage(input)
+		if err := runtime.PopulateQueryParameters(msg, c.Request.PostForm, utilities.NewDoubleArray(nil)); err != nil {
+			apierror.BadRequest(c, "INVALID_REQUEST", "Invalid request")
+			return
+		}
+		setBody(c, msg)
+	}
+}
@@ -0,0 +1,62 @@
+package rest
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	userproto "api-gateway/protos/user-proto"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestFormBody(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	var got userproto.UpdateUserRequest
+	router.PUT("/users/:user_id", form((&userproto.UpdateUserRequest{}).ProtoReflect().Descriptor()), func(c *gin.Context) {
+		got.Reset()
+		body, _ := io.ReadAll(c.Request.Body)
+		if err := protojson.Unmarshal(body, &got); err != nil {
+			t.Errorf("body %s: %v", body, err)
+		}
+		c.Status(http.StatusOK)
+	})
+
+	multipartBody := &bytes.Buffer{}
+	writer := multipart.NewWriter(multipartBody)
+	writer.WriteField("bio", "hello")
+	writer.WriteField("is_private", "true")
+	writer.Close()
+
+	for name, req := range map[string]struct {
+		contentType string
+		body        io.Reader
+	}{
+		"urlencoded": {"application/x-www-form-urlencoded", strings.NewReader(url.Values{"bio": {"hello"}, "is_private": {"true"}}.Encode())},
+		"multipart":  {writer.FormDataContentType(), multipartBody},
+		"json":       {"application/json", strings.NewReader(`{"bio":"hello","is_private":true}`)},
+	} {
+		r := httptest.NewRequest(http.MethodPut, "/users/7", req.body)
+		r.Header.Set("Content-Type", req.contentType)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, r)
+		if rec.Code != http.StatusOK || got.Bio != "hello" || !got.IsPrivate {
+			t.Errorf("%s: status %d, request %v", name, rec.Code, &got)
+		}
+	}
+
+	r := httptest.NewRequest(http.MethodPut, "/users/7", strings.NewReader("is_private=maybe"))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, r)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("invalid form value: status %d, want 400", rec.Code)
+	}
+}
@@ -0,0 +1,97 @@
+package rest
+
+import (
+	"context"
+
+	"api-gateway/internal/jwt"
+	gatewayproto "api-gateway/protos/gateway-proto"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// principalConn (gateway.principal) belgilangan maydonlarni token egasi bilan
+// to'ldiradi. Client body yoki query da boshqa foydalanuvchini yuborsa so'rov
+// PermissionDenied bilan qaytadi: avval handlerlar bu maydonlarni tokendan
+// o'zi qo'yardi, endi ular generatsiya qilingan bind kodidan keladi.
+type principalConn struct {
+	grpc.ClientConnInterface
+}
+
+func (c principalConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	if msg, ok := args.(proto.Message); ok {
+		if err := bindPrincipal(ctx, msg.ProtoReflect()); err != nil {
+			return err
+		}
+	}
+	return c.ClientConnInterface.Invoke(ctx, method, args, reply, opts...)
+}
+
+func bindPrincipal(ctx context.Context, msg protoreflect.Message) error {
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		kind, _ := proto.GetExtension(field.Options(), gatewayproto.E_Principal).(gatewayproto.Principal)
+		if kind == gatewayproto.Principal_PRINCIPAL_UNSPECIFIED {
+			continue
+		}
+
+		// public routelarda token bo'lmasligi mumkin, maydon bo'sh qoladi
+		principal, ok := jwt.PrincipalFromContext(ctx)
+		if !ok {
+			msg.Clear(field)
+			continue
+		}
+
+		var value protoreflect.Value
+		switch kind {
+		case gatewayproto.Principal_PRINCIPAL_USER_ID:
+			switch field.Kind() {
+			case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+				value = protoreflect.ValueOfInt64(int64(principal.UserID))
+			default:
+				value = protoreflect.ValueOfInt32(principal.UserID)
+			}
+		case gatewayproto.Principal_PRINCIPAL_USERNAME:
+			value = protoreflect.ValueOfString(principal.Username)
+		case gatewayproto.Principal_PRINCIPAL_SESSION_ID:
+			value = protoreflect.ValueOfString(principal.SessionID)
+		default:
+			continue
+		}
+
+		if msg.Has(field) && !sameValue(msg.Get(field), value) {
+			return principalMismatch(string(field.Name()))
+		}
+		msg.Set(field, value)
+	}
+	return nil
+}
+
+// sameValue principal maydonlari faqat int32, int64 yoki string bo'ladi
+func sameValue(a, b protoreflect.Value) bool {
+	switch v := b.Interface().(type) {
+	case int32:
+		return a.Int() == int64(v)
+	case int64:
+		return a.Int() == v
+	case string:
+		return a.String() == v
+	}
+	return false
+}
+
+func principalMismatch(field string) error {
+	st := status.New(codes.PermissionDenied, "You can only act as yourself")
+	if withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   "PRINCIPAL_MISMATCH",
+		Metadata: map[string]string{"field": field},
+	}); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
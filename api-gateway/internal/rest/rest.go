@@ -0,0 +1,173 @@
+// Package rest gateway protolaridagi google.api.http annotatsiyalaridan REST
+// routelarini yaratadi. So'rovni proto ga bog'lash va javobni JSON ga aylantirish
+// grpc-gateway generatsiya qilgan *.pb.gw.go kodida; auth, rol, media upload,
+// rate limit va idempotency gin middleware lari har bir route oldida turadi.
+//
+// Yangi endpoint qo'shish uchun handler yozilmaydi: RPC ga (google.api.http) va
+// kerak bo'lsa (gateway.route) option lari, tokendan olinadigan maydonlarga
+// (gateway.principal) qo'shiladi va `make proto` ishga tushiriladi.
+package rest
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"api-gateway/internal/apierror"
+	"api-gateway/internal/jwt"
+	"api-gateway/internal/pagination"
+	commentproto "api-gateway/protos/comment-proto"
+	directproto "api-gateway/protos/direct-proto"
+	likeproto "api-gateway/protos/like-proto"
+	notificationproto "api-gateway/protos/notification-proto"
+	tweetproto "api-gateway/protos/tweet-proto"
+	userproto "api-gateway/protos/user-proto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// OpenAPI `make proto` protoc-gen-openapiv2 bilan generatsiya qiladigan hujjat
+//
+//go:embed openapi.swagger.json
+var OpenAPI []byte
+
+// Conns downstream servislarga ulanishlar
+type Conns struct {
+	User         grpc.ClientConnInterface
+	Tweet        grpc.ClientConnInterface
+	Like         grpc.ClientConnInterface
+	Comment      grpc.ClientConnInterface
+	Direct       grpc.ClientConnInterface
+	Notification grpc.ClientConnInterface
+}
+
+// Gateway generatsiya qilingan handlerlar va protolardan o'qilgan routelar
+type Gateway struct {
+	mux      *runtime.ServeMux
+	routes   []Route
+	uploader Uploader
+	claimer  Claimer
+}
+
+type ginContextKey struct{}
+
+// New handlerlarni ro'yxatdan o'tkazadi va routelarni protolardan o'qiydi.
+// Clientlar principalConn orqali ishlaydi, shuning uchun (gateway.principal)
+// maydonlari har doim token egasi bilan to'ldiriladi.
+func New(ctx context.Context, conns Conns, uploader Uploader, claimer Claimer) (*Gateway, error) {
+	mux := runtime.NewServeMux(
+		// javob maydonlari avvalgi handlerlardagi kabi snake_case
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{UseProtoNames: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		}),
+		runtime.WithMetadata(forwardPrincipal),
+		runtime.WithIncomingHeaderMatcher(dropHeader),
+		runtime.WithOutgoingHeaderMatcher(dropHeader),
+		runtime.WithOutgoingTrailerMatcher(dropHeader),
+		runtime.WithErrorHandler(handleError),
+	)
+
+	if err := userproto.RegisterUserServiceHandlerClient(ctx, mux, userproto.NewUserServiceClient(principalConn{conns.User})); err != nil {
+		return nil, err
+	}
+	if err := tweetproto.RegisterTweetServiceHandlerClient(ctx, mux, tweetproto.NewTweetServiceClient(principalConn{conns.Tweet})); err != nil {
+		return nil, err
+	}
+	if err := likeproto.RegisterLikeServiceHandlerClient(ctx, mux, likeproto.NewLikeServiceClient(principalConn{conns.Like})); err != nil {
+		return nil, err
+	}
+	if err := commentproto.RegisterCommentServiceHandlerClient(ctx, mux, commentproto.NewCommentServiceClient(principalConn{conns.Comment})); err != nil {
+		return nil, err
+	}
+	if err := directproto.RegisterDirectServiceHandlerClient(ctx, mux, directproto.NewDirectServiceClient(principalConn{conns.Direct})); err != nil {
+		return nil, err
+	}
+	if err := notificationproto.RegisterWebhookServiceHandlerClient(ctx, mux, notificationproto.NewWebhookServiceClient(principalConn{conns.Notification})); err != nil {
+		return nil, err
+	}
+
+	routes, err := Routes(
+		userproto.File_protos_user_proto_user_proto,
+		tweetproto.File_protos_tweet_proto_tweet_proto,
+		likeproto.File_protos_like_proto_like_proto,
+		commentproto.File_protos_comment_proto_comment_proto,
+		directproto.File_protos_direct_proto_direct_proto,
+		notificationproto.File_protos_notification_proto_webhook_proto,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Gateway{mux: mux, routes: routes, uploader: uploader, claimer: claimer}, nil
+}
+
+// Register routelarni router ga qo'shadi. middleware hamma routelar oldida
+// turadi (Identify, rate limit, idempotency); Protected, RequireRole va media
+// upload har bir RPC ning (gateway.route) sozlamalaridan qo'shiladi. Ro'yxat
+// routelarida ?limit=&cursor= tekshiriladi, body li routelar form ham qabul qiladi.
+func (g *Gateway) Register(router gin.IRouter, middleware ...gin.HandlerFunc) {
+	for _, route := range g.routes {
+		handlers := append([]gin.HandlerFunc{}, middleware...)
+		if !route.Options.GetPublic() {
+			handlers = append(handlers, jwt.Protected())
+		}
+		if roles := route.Options.GetRoles(); len(roles) > 0 {
+			handlers = append(handlers, jwt.RequireRole(roles...))
+		}
+		if route.RPC.Input().Fields().ByName("cursor") != nil {
+			handlers = append(handlers, pagination.Validate())
+		}
+		if upload := route.Options.GetUpload(); upload != nil {
+			handlers = append(handlers, g.upload(route.RPC.Input(), upload))
+		} else if route.Body != "" {
+			handlers = append(handlers, form(route.RPC.Input()))
+		}
+		handlers = append(handlers, g.serve)
+
+		router.Handle(route.Method, route.Path, handlers...)
+		slog.Debug("registered route", "route", route.Method+" "+route.Path, "rpc", string(route.RPC.FullName()))
+	}
+}
+
+// ServeOpenAPI generatsiya qilingan OpenAPI hujjatini qaytaradi
+func ServeOpenAPI(c *gin.Context) {
+	c.Data(http.StatusOK, "application/json", OpenAPI)
+}
+
+func (g *Gateway) serve(c *gin.Context) {
+	// token jwt.Identify da tekshirilgan, servislarga faqat x-user-* metadata boradi
+	c.Request.Header.Del("Authorization")
+	ctx := context.WithValue(c.Request.Context(), ginContextKey{}, c)
+	g.mux.ServeHTTP(c.Writer, c.Request.WithContext(ctx))
+}
+
+// forwardPrincipal jwt.Identify qo'shgan x-user-* metadata ni saqlaydi:
+// grpc-gateway outgoing metadata ni so'rov headerlaridan qaytadan yig'adi
+func forwardPrincipal(_ context.Context, r *http.Request) metadata.MD {
+	md, _ := metadata.FromOutgoingContext(r.Context())
+	return md
+}
+
+// dropHeader HTTP headerlarni gRPC metadata ga va aksincha o'tkazmaydi
+func dropHeader(string) (string, bool) {
+	return "", false
+}
+
+// handleError xatolarni qo'lda yozilgan handlerlardagi apierror shaklida qaytaradi
+func handleError(ctx context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, _ *http.Request, err error) {
+	if c, ok := ctx.Value(ginContextKey{}).(*gin.Context); ok {
+		apierror.FromError(c, err)
+		return
+	}
+	httpStatus, resp := apierror.Convert(ctx, err)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,95 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	gatewayproto "api-gateway/protos/gateway-proto"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Route bitta google.api.http binding
+type Route struct {
+	Method string
+	// Path gin formatida: /tweets/{tweet_id} -> /tweets/:tweet_id
+	Path    string
+	RPC     protoreflect.MethodDescriptor
+	Options *gatewayproto.Route
+	// Body binding dagi body: "*" yoki bo'sh
+	Body string
+}
+
+// Routes fayllardagi servislarning http annotatsiyalarini o'qiydi. Annotatsiyasiz
+// RPC lar (masalan GetUserByEmail) faqat gRPC orqali chaqiriladi.
+func Routes(files ...protoreflect.FileDescriptor) ([]Route, error) {
+	var routes []Route
+	for _, file := range files {
+		services := file.Services()
+		for i := 0; i < services.Len(); i++ {
+			methods := services.Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				method := methods.Get(j)
+				rule, ok := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+				if !ok || rule == nil {
+					continue
+				}
+				options, _ := proto.GetExtension(method.Options(), gatewayproto.E_Route).(*gatewayproto.Route)
+
+				for _, binding := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+					route, err := newRoute(method, binding, options)
+					if err != nil {
+						return nil, err
+					}
+					routes = append(routes, route)
+				}
+			}
+		}
+	}
+	return routes, nil
+}
+
+func newRoute(method protoreflect.MethodDescriptor, rule *annotations.HttpRule, options *gatewayproto.Route) (Route, error) {
+	route := Route{RPC: method, Options: options, Body: rule.GetBody()}
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		route.Method, route.Path = http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Put:
+		route.Method, route.Path = http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Post:
+		route.Method, route.Path = http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Delete:
+		route.Method, route.Path = http.MethodDelete, pattern.Delete
+	case *annotations.HttpRule_Patch:
+		route.Method, route.Path = http.MethodPatch, pattern.Patch
+	default:
+		return Route{}, fmt.Errorf("error unsupported http rule for %s", method.FullName())
+	}
+
+	path, err := ginPath(route.Path)
+	if err != nil {
+		return Route{}, fmt.Errorf("error parsing path of %s: %v", method.FullName(), err)
+	}
+	route.Path = path
+	return route, nil
+}
+
+// ginPath {field} o'zgaruvchilarini :field ga almashtiradi. {field=pattern} va
+// nested maydonlar gin da yo'q, ular ishlatilmaydi.
+func ginPath(template string) (string, error) {
+	segments := strings.Split(template, "/")
+	for i, segment := range segments {
+		if !strings.HasPrefix(segment, "{") {
+			continue
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(segment, "{"), "}")
+		if name == "" || strings.ContainsAny(name, "{}=.*") {
+			return "", fmt.Errorf("unsupported path variable %q", segment)
+		}
+		segments[i] = ":" + name
+	}
+	return strings.Join(segments, "/"), nil
+}
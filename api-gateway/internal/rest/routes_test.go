@@ -0,0 +1,161 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	commentproto "api-gateway/protos/comment-proto"
+	directproto "api-gateway/protos/direct-proto"
+	likeproto "api-gateway/protos/like-proto"
+	notificationproto "api-gateway/protos/notification-proto"
+	tweetproto "api-gateway/protos/tweet-proto"
+	userproto "api-gateway/protos/user-proto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestAdminRoutesRequireRoles(t *testing.T) {
+	routes, err := Routes(
+		userproto.File_protos_user_proto_user_proto,
+		tweetproto.File_protos_tweet_proto_tweet_proto,
+		likeproto.File_protos_like_proto_like_proto,
+		commentproto.File_protos_comment_proto_comment_proto,
+		directproto.File_protos_direct_proto_direct_proto,
+		notificationproto.File_protos_notification_proto_webhook_proto,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string][]string{
+		"GET /admin/users":                      {"moderator", "admin"},
+		"PUT /admin/users/:user_id/role":        {"admin"},
+		"POST /admin/users/:user_id/reset":      {"admin"},
+		"PUT /admin/users/:user_id/media-quota": {"admin"},
+		"DELETE /admin/tweets/:tweet_id":        {"moderator", "admin"},
+		"DELETE /admin/comments/:id":            {"moderator", "admin"},
+	}
+	for _, route := range routes {
+		key := route.Method + " " + route.Path
+		roles := route.Options.GetRoles()
+		if strings.HasPrefix(route.Path, "/admin/") {
+			if route.Options.GetPublic() || !slices.Equal(roles, want[key]) {
+				t.Errorf("%s: public %v, roles %v, want %v", key, route.Options.GetPublic(), roles, want[key])
+			}
+			delete(want, key)
+		} else if len(roles) > 0 {
+			t.Errorf("%s outside /admin requires roles %v", key, roles)
+		}
+	}
+	for key := range want {
+		t.Errorf("admin route %s is not registered", key)
+	}
+}
+
+func TestRoutesRegisterOnGin(t *testing.T) {
+	routes, err := Routes(
+		userproto.File_protos_user_proto_user_proto,
+		tweetproto.File_protos_tweet_proto_tweet_proto,
+		likeproto.File_protos_like_proto_like_proto,
+		commentproto.File_protos_comment_proto_comment_proto,
+		directproto.File_protos_direct_proto_direct_proto,
+		notificationproto.File_protos_notification_proto_webhook_proto,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// gin bir segmentdagi turli nomli wildcardlarda panic qiladi
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	registered := make(map[string]*Route)
+	for i, route := range routes {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Errorf("%s %s: %v", route.Method, route.Path, r)
+				}
+			}()
+			router.Handle(route.Method, route.Path, func(*gin.Context) {})
+		}()
+		registered[route.Method+" "+route.Path] = &routes[i]
+	}
+
+	// qo'lda yozilgan handlerlar davridagi yo'llar additional_bindings orqali saqlanadi
+	for _, key := range []string{
+		"PUT /users/:user_id", "DELETE /users/:user_id", "PUT /users/:user_id/password",
+		"POST /users/:user_id/avatar", "PUT /users/:user_id/avatar", "DELETE /users/:user_id/avatar",
+		"PUT /users/:user_id/follow", "PUT /users/:user_id/unfollow",
+		"PUT /users/:user_id/follower", "PUT /users/:user_id/unfollower",
+		"POST /likes/tweet", "DELETE /likes/tweet", "POST /likes/comment", "DELETE /likes/comment",
+	} {
+		if _, ok := registered[key]; !ok {
+			t.Errorf("legacy route %s is not registered", key)
+		}
+	}
+
+	for _, key := range []string{"POST /comments/like/:comment_id", "DELETE /comments/like/:comment_id"} {
+		route, ok := registered[key]
+		if !ok {
+			t.Errorf("%s is not registered", key)
+			continue
+		}
+		if route.Options.GetPublic() {
+			t.Errorf("%s is public", key)
+		}
+	}
+}
+
+// recordConn chaqirilgan RPC va uning so'rovini yozib oladi
+type recordConn struct {
+	method string
+	req    proto.Message
+}
+
+func (c *recordConn) Invoke(_ context.Context, method string, args, _ any, _ ...grpc.CallOption) error {
+	c.method, c.req = method, args.(proto.Message)
+	return nil
+}
+
+func (c *recordConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, nil
+}
+
+func TestLegacyBindingsDoNotShadowMe(t *testing.T) {
+	conn := &recordConn{}
+	mux := runtime.NewServeMux()
+	if err := userproto.RegisterUserServiceHandlerClient(context.Background(), mux, userproto.NewUserServiceClient(conn)); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		method, path, rpc string
+		userID            int32
+	}{
+		{http.MethodPut, "/users/me", "UpdateUser", 0},
+		{http.MethodPut, "/users/7", "UpdateUser", 7},
+		{http.MethodDelete, "/users/me", "DeleteUser", 0},
+		{http.MethodPut, "/users/me/password", "ChangePassword", 0},
+		{http.MethodPut, "/users/7/password", "ChangePassword", 7},
+		{http.MethodDelete, "/users/me/avatar", "RemoveAvatar", 0},
+		{http.MethodPut, "/users/7/follow", "AddFollowing", 7},
+	} {
+		conn.method, conn.req = "", nil
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(tc.method, tc.path, strings.NewReader("{}")))
+		if rec.Code != http.StatusOK || !strings.HasSuffix(conn.method, "/"+tc.rpc) {
+			t.Errorf("%s %s: status %d, rpc %q, want %s", tc.method, tc.path, rec.Code, conn.method, tc.rpc)
+			continue
+		}
+		if got := conn.req.(interface{ GetUserId() int32 }).GetUserId(); got != tc.userID {
+			t.Errorf("%s %s: user_id %d, want %d", tc.method, tc.path, got, tc.userID)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"api-gateway/internal/apierror"
+	"api-gateway/internal/jwt"
+	"api-gateway/internal/media"
+	gatewayproto "api-gateway/protos/gateway-proto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// Uploader media faylni oqim bilan saqlaydi va owner uchun media yozuvini yaratadi
+type Uploader interface {
+	Upload(ctx context.Context, owner int32, r io.Reader, opts media.Options) (*media.Media, error)
+}
+
+// Claimer owner yuklagan media ID larini so'rovga biriktiradi
+type Claimer interface {
+	Claim(ctx context.Context, owner int32, ids []string, opts media.Options) (*media.Claim, error)
+}
+
+// renditions (gateway.route).upload.renditions dagi profil
+var renditions = map[gatewayproto.Renditions][]media.RenditionSpec{
+	gatewayproto.Renditions_RENDITIONS_AVATAR: media.AvatarRenditions,
+	gatewayproto.Renditions_RENDITIONS_TWEET:  media.TweetRenditions,
+}
+
+// maxFormValue multipart formdagi oddiy maydon uchun chegara, fayllarning
+// chegarasi media turiga qarab
+const maxFormValue = 1 << 20
+
+// upload multipart formni RPC so'rovining JSON body siga aylantiradi: oddiy form
+// maydonlari proto maydonlariga yoziladi, upload.form_field dagi fayllar esa
+// saqlanadi. Form qismlari kelish tartibida o'qiladi, fayl diskka yoki xotiraga
+// yig'ilmaydi (Idempotency-Key li so'rov bundan mustasno, uning tanasi fingerprint
+// uchun oldindan o'qiladi). Yuklangan fayllar upload.media_ids_field dagi ID lar (upload
+// sessiyasida yuklangan media) bilan birga so'rovga biriktiriladi va ularning
+// ID lari upload.media_ids_field ga yoziladi. upload.field berilgan bo'lsa ID lar
+// o'rniga URL lar shu maydonga yoziladi; JSON body dagi upload.field tozalanadi,
+// client o'zi URL yubora olmaydi.
+func (g *Gateway) upload(input protoreflect.MessageDescriptor, upload *gatewayproto.Upload) gin.HandlerFunc {
+	field := input.Fields().ByName(protoreflect.Name(upload.GetField()))
+	idsField := input.Fields().ByName(protoreflect.Name(upload.GetMediaIdsField()))
+	opts := media.Options{Accept: upload.GetAccept(), Renditions: renditions[upload.GetRenditions()]}
+	return func(c *gin.Context) {
+		msg := dynamicpb.NewMessage(input)
+		owner := jwt.CurrentUser(c).UserID
+		var ids []string
+
+		if c.ContentType() != gin.MIMEMultipartPOSTForm {
+			body, err := io.ReadAll(c.Request.Body)
+			if err != nil {
+				apierror.BadRequest(c, "INVALID_REQUEST", "Invalid request")
+				return
+			}
+			if len(bytes.TrimSpace(body)) > 0 {
+				if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(body, msg); err != nil {
+					apierror.BadRequest(c, "INVALID_REQUEST", "Invalid request")
+					return
+				}
+			}
+			if field != nil {
+				msg.Clear(field)
+			}
+		} else {
+			var ok bool
+			if ids, ok = g.readForm(c, msg, owner, upload, opts); !ok {
+				return
+			}
+		}
+
+		// yuklangan fayllar ham biriktiriladi, aks holda ular GC qilinadi
+		var claimed []*media.Media
+		var claim *media.Claim
+		if ids = append(ids, stringValues(msg, idsField)...); len(ids) > 0 {
+			var err error
+			claim, err = g.claimer.Claim(c.Request.Context(), owner, ids, opts)
+			if err != nil {
+				media.Abort(c, err)
+				return
+			}
+			claimed = claim.Media()
+		}
+		if idsField != nil {
+			msg.Clear(idsField)
+		}
+		for _, m := range claimed {
+			if field != nil {
+				appendString(msg, field, m.URL)
+			} else if idsField != nil {
+				appendString(msg, idsField, m.ID)
+			}
+		}
+		setBody(c, msg)
+		if claim == nil {
+			return
+		}
+		if c.IsAborted() {
+			claim.Release(c.Request.Context())
+			return
+		}
+
+		c.Next()
+		// 4xx da tweet yoki DM yaratilmagani aniq, media ni yana biriktirish mumkin.
+		// 5xx da natija noma'lum: servis yozib ulgurgan bo'lishi mumkin, shuning
+		// uchun media GC o'chirmasligi uchun biriktirilgan qoladi.
+		if status := c.Writer.Status(); status >= http.StatusBadRequest && status < http.StatusInternalServerError {
+			claim.Release(c.Request.Context())
+		}
+	}
+}
+
+// appendString repeated maydonga qo'shadi, oddiy maydonni almashtiradi
+func appendString(msg *dynamicpb.Message, field protoreflect.FieldDescriptor, value string) {
+	if field.IsList() {
+		msg.Mutable(field).List().Append(protoreflect.ValueOfString(value))
+	} else {
+		msg.Set(field, protoreflect.ValueOfString(value))
+	}
+}
+
+// readForm multipart form qismlarini o'qiydi: oddiy maydonlar msg ga yoziladi,
+// upload.form_field dagi fayllar owner nomidan saqlanib ID lari qaytariladi
+func (g *Gateway) readForm(c *gin.Context, msg *dynamicpb.Message, owner int32, upload *gatewayproto.Upload, opts media.Options) ([]string, bool) {
+	reader, err := c.Request.MultipartReader()
+	if err != nil {
+		apierror.BadRequest(c, "INVALID_REQUEST", "Invalid multipart form")
+		return nil, false
+	}
+	values := make(map[string][]string)
+	var ids []string
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			apierror.BadRequest(c, "INVALID_REQUEST", "Invalid multipart form")
+			return nil, false
+		}
+
+		name := part.FormName()
+		switch {
+		case part.FileName() == "":
+			value, err := io.ReadAll(io.LimitReader(part, maxFormValue+1))
+			if err != nil || len(value) > maxFormValue {
+				apierror.BadRequest(c, "INVALID_REQUEST", "Invalid multipart form")
+				return nil, false
+			}
+			if upload.GetField() == "" || name != upload.GetField() {
+				values[name] = append(values[name], string(value))
+			}
+		case name == upload.GetFormField():
+			stored, err := g.uploader.Upload(c.Request.Context(), owner, part, opts)
+			if err != nil {
+				media.Abort(c, err)
+				return nil, false
+			}
+			ids = append(ids, stored.ID)
+		}
+		// boshqa fayllar o'qilmaydi, NextPart ularni o'tkazib yuboradi
+		part.Close()
+	}
+
+	if err := runtime.PopulateQueryParameters(msg, values, utilities.NewDoubleArray(nil)); err != nil {
+		apierror.BadRequest(c, "INVALID_REQUEST", "Invalid request")
+		return nil, false
+	}
+	return ids, true
+}
+
+// stringValues string yoki repeated string maydonning bo'sh bo'lmagan qiymatlari
+func stringValues(msg *dynamicpb.Message, field protoreflect.FieldDescriptor) []string {
+	if field == nil || !msg.Has(field) {
+		return nil
+	}
+	if !field.IsList() {
+		return []string{msg.Get(field).String()}
+	}
+	list := msg.Get(field).List()
+	values := make([]string, 0, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		if value := list.Get(i).String(); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// setBody so'rov body sini grpc-gateway o'qiydigan JSON bilan almashtiradi
+func setBody(c *gin.Context, msg protoreflect.ProtoMessage) {
+	body, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		_ = c.Error(err)
+		apierror.Abort(c, http.StatusInternalServerError, "INTERNAL", "Failed to encode request")
+		return
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	c.Request.ContentLength = int64(len(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Content-Length", strconv.Itoa(len(body)))
+}
@@ -2,10 +2,11 @@ package router
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"pkg/tracing"
 	"syscall"
 	"time"
 
@@ -13,171 +14,151 @@ import (
 	commentclients "api-gateway/internal/clients/comment_clients"
 	directclients "api-gateway/internal/clients/direct_clients"
 	likeclients "api-gateway/internal/clients/like_clients"
+	notificationclients "api-gateway/internal/clients/notification_clients"
 	tweetclients "api-gateway/internal/clients/tweet_clients"
 	userclients "api-gateway/internal/clients/user_clients"
-	commenthandler "api-gateway/internal/handlers/comment-handlers"
-	directhandler "api-gateway/internal/handlers/direct-handlers"
-	likehandler "api-gateway/internal/handlers/like-handlers"
-	tweethandler "api-gateway/internal/handlers/tweet-handlers"
-	userhandler "api-gateway/internal/handlers/user-handlers"
+	detailhandler "api-gateway/internal/handlers/detail-handlers"
 
+	"api-gateway/internal/apierror"
+	"api-gateway/internal/graphql"
+	"api-gateway/internal/health"
+	"api-gateway/internal/idempotency"
 	"api-gateway/internal/jwt"
+	httplogger "api-gateway/internal/logger"
+	"api-gateway/internal/media"
+	httpmetrics "api-gateway/internal/metrics"
+	"api-gateway/internal/redis"
+	"api-gateway/internal/requestid"
+	"api-gateway/internal/rest"
 
 	middleware "api-gateway/internal/rate-limiting"
 
+	"pkg/logger"
+	"pkg/metrics"
+
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 )
 
 func Router() *http.Server {
-	userhandler := &userhandler.UserHandler{UserService: userclients.DialUserGrpc()}
-	tweetclient := tweetclients.DialTweetGrpc()
-	tweethandler := &tweethandler.TweetHandler{TweetService: tweetclient}
-
-	likeclient := likeclients.DialLikeGrpc()
-	likehandler := &likehandler.LikeHandler{LikeService: likeclient}
-
-	commentclient := commentclients.DialCommentGrpc()
-	commenthandler := &commenthandler.CommentHandler{CommentService: commentclient}
-
-	directclient := directclients.DialDirectGrpc()
-	directhandler := &directhandler.DirectHandler{DirectService: directclient}
-
-	// Rate limiter o‘rnatish
-	userRateLimiter := middleware.NewRateLimiter(2, time.Minute)
-	tweetRateLimiter := middleware.NewRateLimiter(2, time.Minute)
-	likeRateLimiter := middleware.NewRateLimiter(2, time.Minute)
-	commentRateLimiter := middleware.NewRateLimiter(2, time.Minute)
-	directRateLimiter := middleware.NewRateLimiter(2, time.Minute)
-
-	router := gin.Default()
-	gin.SetMode(gin.ReleaseMode)
-	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
-	log.Println("Registered route: GET /swagger/*any")
-
-	// userRoutes
-	userRoutes := router.Group("/users")
-	userRoutes.Use(userRateLimiter.Limit())
-	{
-		userRoutes.POST("/register", userhandler.Register)
-		log.Println("Registered route: POST /users/register")
-		userRoutes.POST("/login", userhandler.Login)
-		log.Println("Registered route: POST /users/login")
-		userRoutes.GET("/:id", jwt.Protected(), userhandler.GetUser)
-		log.Println("Registered route: GET /users/:id")
-		userRoutes.PUT("/:id", jwt.Protected(), userhandler.UpdateUser)
-		log.Println("Registered route: PUT /users/:id")
-		userRoutes.DELETE("/:id", jwt.Protected(), userhandler.DeleteUser)
-		log.Println("Registered route: DELETE /users/:id")
-		userRoutes.PUT("/:id/password", jwt.Protected(), userhandler.ChangePassword)
-		log.Println("Registered route: PUT /users/:id/password")
-		userRoutes.PUT("/:id/avatar", jwt.Protected(), userhandler.UpdateAvatar)
-		log.Println("Registered route: PUT /users/:id/avatar")
-		userRoutes.POST("/:id/avatar", jwt.Protected(), userhandler.AddAvatar)
-		log.Println("Registered route: POST /users/:id/avatar")
-		userRoutes.DELETE("/:id/avatar", jwt.Protected(), userhandler.DeleteAvatar)
-		log.Println("Registered route: DELETE /users/:id/avatar")
-		userRoutes.PUT("/:id/follow", jwt.Protected(), userhandler.AddFollowing)
-		log.Println("Registered route: PUT /users/:id/follow")
-		userRoutes.PUT("/:id/unfollow", jwt.Protected(), userhandler.RemoveFollowing)
-		log.Println("Registered route: PUT /users/:id/unfollow")
-		userRoutes.PUT("/:id/follower", jwt.Protected(), userhandler.AddFollower)
-		log.Println("Registered route: PUT /users/:id/follower")
-		userRoutes.PUT("/:id/unfollower", jwt.Protected(), userhandler.RemoveFollower)
-		log.Println("Registered route: PUT /users/:id/unfollower")
+	conf, err := config.LoadConfig()
+	if err != nil {
+		logger.Fatal("failed to load config", "error", err)
 	}
+	logger.Init(conf.SERVER_NAME, conf.LOG_LEVEL)
+	// ichki xato tafsilotlari faqat dev muhitida javobga qo'shiladi
+	apierror.SetDevMode(conf.SERVER_ENV == "dev")
 
-	// tweetRoutes
-	tweetRoutes := router.Group("/tweets")
-	tweetRoutes.Use(tweetRateLimiter.Limit())
-	{
-		tweetRoutes.POST("", jwt.Protected(), tweethandler.CreateTweet)
-		log.Println("Registered route: POST /tweets")
-		tweetRoutes.GET("/user/:user_id", jwt.Protected(), tweethandler.GetTweetsByUser)
-		log.Println("Registered route: GET /tweets/user/:user_id")
-		tweetRoutes.GET("/:id", jwt.Protected(), tweethandler.GetTweetByID)
-		log.Println("Registered route: GET /tweets/:id")
-		tweetRoutes.PUT("/:id", jwt.Protected(), tweethandler.UpdateTweet)
-		log.Println("Registered route: PUT /tweets/:id")
-		tweetRoutes.DELETE("/:id", jwt.Protected(), tweethandler.DeleteTweet)
-		log.Println("Registered route: DELETE /tweets/:id")
-		tweetRoutes.PUT("/:id/like", jwt.Protected(), tweethandler.AddLike)
-		log.Println("Registered route: PUT /tweets/:id/like")
-		tweetRoutes.DELETE("/:id/like", jwt.Protected(), tweethandler.RemoveLike)
-		log.Println("Registered route: DELETE /tweets/:id/like")
-		tweetRoutes.POST("/:id/comment", jwt.Protected(), tweethandler.AddComment)
-		log.Println("Registered route: POST /tweets/:id/comment")
-		tweetRoutes.DELETE("/:id/comment/:comment_id", jwt.Protected(), tweethandler.RemoveComment)
-		log.Println("Registered route: DELETE /tweets/:id/comment/:comment_id")
-		tweetRoutes.PUT("/:id/share", jwt.Protected(), tweethandler.AddShare)
-		log.Println("Registered route: PUT /tweets/:id/share")
-		tweetRoutes.PUT("/:id/save", jwt.Protected(), tweethandler.SaveTweet)
-		log.Println("Registered route: PUT /tweets/:id/save")
-		tweetRoutes.DELETE("/:id/save", jwt.Protected(), tweethandler.RemoveSave)
-		log.Println("Registered route: DELETE /tweets/:id/save")
+	shutdownTracing, err := tracing.Init(context.Background(), conf.Tracing())
+	if err != nil {
+		logger.Fatal("failed to set up tracing", "error", err)
 	}
 
-	// likeRoutes
-	likeRoutes := router.Group("/likes")
-	likeRoutes.Use(likeRateLimiter.Limit())
-	{
-		likeRoutes.POST("/tweet", jwt.Protected(), likehandler.CreateLikeTweet)
-		log.Println("Registered route: POST /likes/tweet")
-		likeRoutes.DELETE("/tweet", jwt.Protected(), likehandler.DeleteLikeTweet)
-		log.Println("Registered route: DELETE /likes/tweet")
-		likeRoutes.GET("/tweet/:tweet_id", jwt.Protected(), likehandler.GetLikesTweet)
-		log.Println("Registered route: GET /likes/tweet/:tweet_id")
-		likeRoutes.GET("/tweet/user/:user_id", jwt.Protected(), likehandler.GetLikeTweetByUser)
-		log.Println("Registered route: GET /likes/tweet/user/:user_id")
-		likeRoutes.POST("/comment", jwt.Protected(), likehandler.CreateLikeComment)
-		log.Println("Registered route: POST /likes/comment")
-		likeRoutes.DELETE("/comment", jwt.Protected(), likehandler.DeleteLikeComment)
-		log.Println("Registered route: DELETE /likes/comment")
-		likeRoutes.GET("/comment/:comment_id", jwt.Protected(), likehandler.GetLikesComment)
-		log.Println("Registered route: GET /likes/comment/:comment_id")
-		likeRoutes.GET("/comment/user/:user_id", jwt.Protected(), likehandler.GetLikeCommentByUser)
-		log.Println("Registered route: GET /likes/comment/user/:user_id")
-	}
+	userclient, userconn := userclients.DialUserGrpc()
+	tweetclient, tweetconn := tweetclients.DialTweetGrpc()
+	likeclient, likeconn := likeclients.DialLikeGrpc()
+	commentclient, commentconn := commentclients.DialCommentGrpc()
+	directclient, directconn := directclients.DialDirectGrpc()
+	_, notificationconn := notificationclients.DialNotificationGrpc()
 
-	// commentRoutes
-	commentRoutes := router.Group("/comments")
-	commentRoutes.Use(commentRateLimiter.Limit())
-	{
-		commentRoutes.POST("", jwt.Protected(), commenthandler.CreateComment)
-		log.Println("Registered route: POST /comments")
-		commentRoutes.GET("/tweet/:tweet_id", jwt.Protected(), commenthandler.GetCommentsByTweetID)
-		log.Println("Registered route: GET /comments/tweet/:tweet_id")
-		commentRoutes.GET("/:id", jwt.Protected(), commenthandler.GetComment)
-		log.Println("Registered route: GET /comments/:id")
-		commentRoutes.DELETE("/:id", jwt.Protected(), commenthandler.DeleteComment)
-		log.Println("Registered route: DELETE /comments/:id")
+	// REST routelar protolardagi google.api.http annotatsiyalaridan
+	blobs, err := media.NewBlobStore(conf.Blob())
+	if err != nil {
+		logger.Fatal("failed to set up media storage", "error", err)
 	}
-
-	// directRoutes
-	directRoutes := router.Group("/directs")
-	directRoutes.Use(directRateLimiter.Limit())
-	{
-		directRoutes.POST("", jwt.Protected(), directhandler.CreateDirectMessage)
-		log.Println("Registered route: POST /directs")
-		directRoutes.GET("", jwt.Protected(), directhandler.GetDirectMessages)
-		log.Println("Registered route: GET /directs")
-		directRoutes.GET("/:id", jwt.Protected(), directhandler.GetDirectMessageByID)
-		log.Println("Registered route: GET /directs/:id")
-		directRoutes.DELETE("/:id", jwt.Protected(), directhandler.DeleteDirectMessage)
-		log.Println("Registered route: DELETE /directs/:id")
+	uploader := media.NewUploader(blobs, media.NewCatalog(userclient))
+	// Sessiyalar, rate limit hisoblari va upload sessiyalari uchun Redis
+	redisClient := redis.NewRedisClient()
+	// tugatilmagan upload sessiyalari va biriktirilmagan media GC qilinadi
+	mediaSessions := media.NewSessions(redisClient, uploader)
+	go mediaSessions.Run(context.Background())
+	gateway, err := rest.New(context.Background(), rest.Conns{
+		User:         userconn,
+		Tweet:        tweetconn,
+		Like:         likeconn,
+		Comment:      commentconn,
+		Direct:       directconn,
+		Notification: notificationconn,
+	}, uploader, uploader)
+	if err != nil {
+		logger.Fatal("failed to set up REST gateway", "error", err)
 	}
+	detailhandler := detailhandler.NewDetailHandler(userclient, tweetclient, commentclient, likeclient)
+
+	graphqlhandler := graphql.NewHandler(graphql.Clients{
+		User:    userclient,
+		Tweet:   tweetclient,
+		Like:    likeclient,
+		Comment: commentclient,
+		Direct:  directclient,
+	})
+
+	// Tokenlarni user-service JWKS kalitlari bilan tekshirish
+	jwt.SetKeySet(jwt.NewKeySet(userclient))
+	jwt.SetSessionStore(redisClient)
+
+	// /readyz Redis va har bir downstream servisning grpc.health.v1 holatini tekshiradi
+	readiness := health.NewReadiness(redisClient)
+	readiness.AddService("user-service", userconn)
+	readiness.AddService("tweet-service", tweetconn)
+	readiness.AddService("like-service", likeconn)
+	readiness.AddService("comment-service", commentconn)
+	readiness.AddService("direct-service", directconn)
+	readiness.AddService("notification-service", notificationconn)
 
-	conf, err := config.LoadConfig()
+	// Rate limiter o‘rnatish
+	defaultPolicy, err := middleware.ParsePolicy(conf.RATE_LIMIT_DEFAULT)
+	if err != nil {
+		logger.Fatal("failed to parse RATE_LIMIT_DEFAULT", "error", err)
+	}
+	routePolicies, err := middleware.ParseRoutePolicies(conf.RATE_LIMIT_ROUTES)
 	if err != nil {
-		log.Fatalf("Failed to load config: %v", err)
+		logger.Fatal("failed to parse RATE_LIMIT_ROUTES", "error", err)
 	}
+	rateLimiter := middleware.NewRateLimiter(redisClient, defaultPolicy, routePolicies)
+	// Idempotency-Key bilan qayta yuborilgan mutatsiyalar saqlangan javobni oladi
+	idempotencyStore := idempotency.NewStore(redisClient)
 
-	router = gin.Default()
+	router := gin.New()
+	router.Use(otelgin.Middleware(conf.SERVER_NAME), httpmetrics.Middleware(), requestid.Middleware(), httplogger.Middleware(), gin.Recovery())
 	gin.SetMode(gin.ReleaseMode)
-	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	router.GET("/metrics", gin.WrapH(metrics.Handler()))
+	slog.Debug("registered route", "route", "GET /metrics")
+	router.GET("/healthz", readiness.Liveness)
+	slog.Debug("registered route", "route", "GET /healthz")
+	router.GET("/readyz", readiness.Readiness)
+	slog.Debug("registered route", "route", "GET /readyz")
+	router.GET("/openapi.json", rest.ServeOpenAPI)
+	slog.Debug("registered route", "route", "GET /openapi.json")
+	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler, ginSwagger.URL("/openapi.json")))
+	slog.Debug("registered route", "route", "GET /swagger/*any")
+
+	gateway.Register(router, jwt.Identify(), rateLimiter.Limit(), idempotencyStore.Middleware())
+	// topilmagan yo'llarni skanerlash ham limitga tushadi (hammasi bitta "unmatched" hisobida)
+	router.NoRoute(rateLimiter.Limit())
+
+	// local va memory backend fayllari gateway orqali beriladi
+	if serveBlobs, ok := media.BlobHandler(blobs); ok {
+		router.GET("/blobs/*key", serveBlobs)
+		router.HEAD("/blobs/*key", serveBlobs)
+		router.PUT("/blobs/*key", rateLimiter.Limit(), serveBlobs)
+		slog.Debug("registered route", "route", "GET|HEAD|PUT /blobs/*key")
+	}
+	// katta fayllar storage ga presigned URL orqali, gateway dan o'tmasdan yuklanadi
+	router.POST("/media/uploads", jwt.Identify(), rateLimiter.Limit(), jwt.Protected(), mediaSessions.CreateHandler)
+	slog.Debug("registered route", "route", "POST /media/uploads")
+	router.POST("/media/uploads/:id/complete", jwt.Identify(), rateLimiter.Limit(), jwt.Protected(), mediaSessions.CompleteHandler)
+	slog.Debug("registered route", "route", "POST /media/uploads/:id/complete")
+
+	// tweet sahifasi bir nechta servisdan yig'iladi, u protoda yo'q
+	router.GET("/tweets/:tweet_id/detail", jwt.Identify(), rateLimiter.Limit(), jwt.Protected(), detailhandler.GetTweetDetail)
+	slog.Debug("registered route", "route", "GET /tweets/:tweet_id/detail")
 
-	// ... (existing route setup code)
+	// graphql REST bilan bir xil principal va rate limit dan o'tadi
+	router.POST("/graphql", jwt.Identify(), rateLimiter.Limit(), jwt.Protected(), graphqlhandler.Serve)
+	slog.Debug("registered route", "route", "POST /graphql")
 
 	server := &http.Server{
 		Addr:     "localhost:" + conf.SERVER_PORT, // Remove "localhost" to bind to all interfaces
@@ -186,50 +167,54 @@ func Router() *http.Server {
 
 	// Start the HTTPS server in a goroutine
 	go func() {
-		log.Printf("Starting HTTPS server on port %s", conf.SERVER_PORT)
+		slog.Info("https server started", "port", conf.SERVER_PORT)
 		if err := server.ListenAndServeTLS("./tls/items.pem", "./tls/items-key.pem"); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("Failed to run HTTPS server: %v", err)
+			logger.Fatal("failed to run HTTPS server", "error", err)
 		}
 	}()
 
 	// Setup graceful shutdown
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
-	log.Println("Shutting down server...")
-
+	GracefulShutdown(server, readiness)
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
-	if err := server.Shutdown(ctx); err != nil {
-		log.Fatal("Server forced to shutdown:", err)
+	if err := shutdownTracing(ctx); err != nil {
+		slog.Error("failed to flush traces", "error", err)
 	}
 
-	log.Println("Server exiting")
+	slog.Info("server stopped")
 	return server
 }
 
-func GracefulShutdown(srv *http.Server, logger *log.Logger) {
+// drainDelay - /readyz 503 qaytargandan keyin load balancer gateway ni chiqarib olishi uchun kutish vaqti
+const drainDelay = 5 * time.Second
+
+// GracefulShutdown signalni kutadi, gateway ni not-ready deb belgilaydi va
+// drainDelay dan keyin ochiq so'rovlar tugashini kutib serverni to'xtatadi
+func GracefulShutdown(srv *http.Server, readiness *health.Readiness) {
 	shutdownCh := make(chan os.Signal, 1)
 	signal.Notify(shutdownCh, os.Interrupt, syscall.SIGTERM)
 
 	<-shutdownCh
-	logger.Println("Shutdown signal received, initiating graceful shutdown...")
+	slog.Info("shutdown signal received, draining", "delay", drainDelay)
+
+	readiness.SetDraining()
+	time.Sleep(drainDelay)
 
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
 	if err := srv.Shutdown(shutdownCtx); err != nil {
-		logger.Printf("Server shutdown encountered an error: %v", err)
+		slog.Error("server shutdown failed", "error", err)
 	} else {
-		logger.Println("Server gracefully stopped")
+		slog.Info("server gracefully stopped")
 	}
 
 	select {
 		case <-shutdownCtx.Done():
 			if shutdownCtx.Err() == context.DeadlineExceeded {
-				logger.Println("Shutdown deadline exceeded, forcing server to stop")
+				slog.Warn("shutdown deadline exceeded, forcing server to stop")
 			}
 		default:
-			logger.Println("Shutdown completed within the timeout period")
+			slog.Info("shutdown completed within the timeout period")
 	}
 }
@@ -0,0 +1,432 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.28.2
+// source: protos/gateway-proto/gateway.proto
+
+package gateway_proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Renditions names a set of image sizes.
+type Renditions int32
+
+const (
+	Renditions_RENDITIONS_NONE Renditions = 0
+	// Square avatars of 48, 128 and 400 px.
+	Renditions_RENDITIONS_AVATAR Renditions = 1
+	// Small, medium and large images that keep the original aspect ratio.
+	Renditions_RENDITIONS_TWEET Renditions = 2
+)
+
+// Enum value maps for Renditions.
+var (
+	Renditions_name = map[int32]string{
+		0: "RENDITIONS_NONE",
+		1: "RENDITIONS_AVATAR",
+		2: "RENDITIONS_TWEET",
+	}
+	Renditions_value = map[string]int32{
+		"RENDITIONS_NONE":   0,
+		"RENDITIONS_AVATAR": 1,
+		"RENDITIONS_TWEET":  2,
+	}
+)
+
+func (x Renditions) Enum() *Renditions {
+	p := new(Renditions)
+	*p = x
+	return p
+}
+
+func (x Renditions) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Renditions) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_gateway_proto_gateway_proto_enumTypes[0].Descriptor()
+}
+
+func (Renditions) Type() protoreflect.EnumType {
+	return &file_protos_gateway_proto_gateway_proto_enumTypes[0]
+}
+
+func (x Renditions) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Renditions.Descriptor instead.
+func (Renditions) EnumDescriptor() ([]byte, []int) {
+	return file_protos_gateway_proto_gateway_proto_rawDescGZIP(), []int{0}
+}
+
+// Principal names a value of the caller's access token.
+type Principal int32
+
+const (
+	Principal_PRINCIPAL_UNSPECIFIED Principal = 0
+	Principal_PRINCIPAL_USER_ID     Principal = 1
+	Principal_PRINCIPAL_USERNAME    Principal = 2
+	Principal_PRINCIPAL_SESSION_ID  Principal = 3
+)
+
+// Enum value maps for Principal.
+var (
+	Principal_name = map[int32]string{
+		0: "PRINCIPAL_UNSPECIFIED",
+		1: "PRINCIPAL_USER_ID",
+		2: "PRINCIPAL_USERNAME",
+		3: "PRINCIPAL_SESSION_ID",
+	}
+	Principal_value = map[string]int32{
+		"PRINCIPAL_UNSPECIFIED": 0,
+		"PRINCIPAL_USER_ID":     1,
+		"PRINCIPAL_USERNAME":    2,
+		"PRINCIPAL_SESSION_ID":  3,
+	}
+)
+
+func (x Principal) Enum() *Principal {
+	p := new(Principal)
+	*p = x
+	return p
+}
+
+func (x Principal) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Principal) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_gateway_proto_gateway_proto_enumTypes[1].Descriptor()
+}
+
+func (Principal) Type() protoreflect.EnumType {
+	return &file_protos_gateway_proto_gateway_proto_enumTypes[1]
+}
+
+func (x Principal) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Principal.Descriptor instead.
+func (Principal) EnumDescriptor() ([]byte, []int) {
+	return file_protos_gateway_proto_gateway_proto_rawDescGZIP(), []int{1}
+}
+
+// Route options are read by the gateway when it registers the REST routes
+// described by google.api.http annotations.
+type Route struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Public routes do not require an access token.
+	Public bool `protobuf:"varint,1,opt,name=public,proto3" json:"public,omitempty"`
+	// When set, the caller's role must be one of these.
+	Roles []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	// Multipart uploads accepted by the route.
+	Upload *Upload `protobuf:"bytes,3,opt,name=upload,proto3" json:"upload,omitempty"`
+}
+
+func (x *Route) Reset() {
+	*x = Route{}
+	mi := &file_protos_gateway_proto_gateway_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Route) ProtoMessage() {}
+
+func (x *Route) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_gateway_proto_gateway_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Route.ProtoReflect.Descriptor instead.
+func (*Route) Descriptor() ([]byte, []int) {
+	return file_protos_gateway_proto_gateway_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Route) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *Route) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *Route) GetUpload() *Upload {
+	if x != nil {
+		return x.Upload
+	}
+	return nil
+}
+
+// Upload stores the files of a multipart form field in object storage and
+// records them with UserService.CreateMedia, counting them against the
+// caller's media quota.
+type Upload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FormField string `protobuf:"bytes,1,opt,name=form_field,json=formField,proto3" json:"form_field,omitempty"`
+	// A string or repeated string field that receives the URLs of the files.
+	// Clients cannot set it directly. Optional when media_ids_field is set.
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	// Accepted media types, either exact ("image/png") or a family ("image/*").
+	// Empty accepts every type the gateway allows.
+	Accept []string `protobuf:"bytes,3,rep,name=accept,proto3" json:"accept,omitempty"`
+	// Resized copies generated for uploaded images.
+	Renditions Renditions `protobuf:"varint,4,opt,name=renditions,proto3,enum=gateway.Renditions" json:"renditions,omitempty"`
+	// A string or repeated string field with media IDs from upload sessions
+	// (POST /media/uploads). The gateway attaches them to the caller. When
+	// field is set their URLs are written there and this field is not sent to
+	// the service; otherwise the IDs of the uploaded files are added to it and
+	// the service resolves them with UserService.GetMediaByIds.
+	MediaIdsField string `protobuf:"bytes,5,opt,name=media_ids_field,json=mediaIdsField,proto3" json:"media_ids_field,omitempty"`
+}
+
+func (x *Upload) Reset() {
+	*x = Upload{}
+	mi := &file_protos_gateway_proto_gateway_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Upload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Upload) ProtoMessage() {}
+
+func (x *Upload) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_gateway_proto_gateway_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Upload.ProtoReflect.Descriptor instead.
+func (*Upload) Descriptor() ([]byte, []int) {
+	return file_protos_gateway_proto_gateway_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Upload) GetFormField() string {
+	if x != nil {
+		return x.FormField
+	}
+	return ""
+}
+
+func (x *Upload) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Upload) GetAccept() []string {
+	if x != nil {
+		return x.Accept
+	}
+	return nil
+}
+
+func (x *Upload) GetRenditions() Renditions {
+	if x != nil {
+		return x.Renditions
+	}
+	return Renditions_RENDITIONS_NONE
+}
+
+func (x *Upload) GetMediaIdsField() string {
+	if x != nil {
+		return x.MediaIdsField
+	}
+	return ""
+}
+
+var file_protos_gateway_proto_gateway_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*Route)(nil),
+		Field:         50001,
+		Name:          "gateway.route",
+		Tag:           "bytes,50001,opt,name=route",
+		Filename:      "protos/gateway-proto/gateway.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*Principal)(nil),
+		Field:         50001,
+		Name:          "gateway.principal",
+		Tag:           "varint,50001,opt,name=principal,enum=gateway.Principal",
+		Filename:      "protos/gateway-proto/gateway.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// optional gateway.Route route = 50001;
+	E_Route = &file_protos_gateway_proto_gateway_proto_extTypes[0]
+)
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// The gateway sets the field from the access token. A request that sends a
+	// different value is rejected.
+	//
+	// optional gateway.Principal principal = 50001;
+	E_Principal = &file_protos_gateway_proto_gateway_proto_extTypes[1]
+)
+
+var File_protos_gateway_proto_gateway_proto protoreflect.FileDescriptor
+
+var file_protos_gateway_proto_gateway_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x1a, 0x20, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x5e, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x06, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x22,
+	0xb2, 0x01, 0x0a, 0x06, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x6f,
+	0x72, 0x6d, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x66, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x33, 0x0a, 0x0a, 0x72, 0x65, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x0a, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x69, 0x64, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x64, 0x73, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x2a, 0x4e, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x53,
+	0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x4e, 0x44, 0x49,
+	0x54, 0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x41, 0x56, 0x41, 0x54, 0x41, 0x52, 0x10, 0x01, 0x12, 0x14,
+	0x0a, 0x10, 0x52, 0x45, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x54, 0x57, 0x45,
+	0x45, 0x54, 0x10, 0x02, 0x2a, 0x6f, 0x0a, 0x09, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61,
+	0x6c, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x52, 0x49, 0x4e, 0x43, 0x49, 0x50, 0x41, 0x4c, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11,
+	0x50, 0x52, 0x49, 0x4e, 0x43, 0x49, 0x50, 0x41, 0x4c, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49,
+	0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x52, 0x49, 0x4e, 0x43, 0x49, 0x50, 0x41, 0x4c,
+	0x5f, 0x55, 0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x50,
+	0x52, 0x49, 0x4e, 0x43, 0x49, 0x50, 0x41, 0x4c, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e,
+	0x5f, 0x49, 0x44, 0x10, 0x03, 0x3a, 0x46, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x1e,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd1,
+	0x86, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x3a, 0x51, 0x0a,
+	0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd1, 0x86, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x50, 0x72, 0x69, 0x6e,
+	0x63, 0x69, 0x70, 0x61, 0x6c, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x42, 0x22, 0x5a, 0x20, 0x61, 0x70, 0x69, 0x2d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2d, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protos_gateway_proto_gateway_proto_rawDescOnce sync.Once
+	file_protos_gateway_proto_gateway_proto_rawDescData = file_protos_gateway_proto_gateway_proto_rawDesc
+)
+
+func file_protos_gateway_proto_gateway_proto_rawDescGZIP() []byte {
+	file_protos_gateway_proto_gateway_proto_rawDescOnce.Do(func() {
+		file_protos_gateway_proto_gateway_proto_rawDescData = protoimpl.X.CompressGZIP(file_protos_gateway_proto_gateway_proto_rawDescData)
+	})
+	return file_protos_gateway_proto_gateway_proto_rawDescData
+}
+
+var file_protos_gateway_proto_gateway_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_protos_gateway_proto_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_protos_gateway_proto_gateway_proto_goTypes = []any{
+	(Renditions)(0),                    // 0: gateway.Renditions
+	(Principal)(0),                     // 1: gateway.Principal
+	(*Route)(nil),                      // 2: gateway.Route
+	(*Upload)(nil),                     // 3: gateway.Upload
+	(*descriptorpb.MethodOptions)(nil), // 4: google.protobuf.MethodOptions
+	(*descriptorpb.FieldOptions)(nil),  // 5: google.protobuf.FieldOptions
+}
+var file_protos_gateway_proto_gateway_proto_depIdxs = []int32{
+	3, // 0: gateway.Route.upload:type_name -> gateway.Upload
+	0, // 1: gateway.Upload.renditions:type_name -> gateway.Renditions
+	4, // 2: gateway.route:extendee -> google.protobuf.MethodOptions
+	5, // 3: gateway.principal:extendee -> google.protobuf.FieldOptions
+	2, // 4: gateway.route:type_name -> gateway.Route
+	1, // 5: gateway.principal:type_name -> gateway.Principal
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	4, // [4:6] is the sub-list for extension type_name
+	2, // [2:4] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_protos_gateway_proto_gateway_proto_init() }
+func file_protos_gateway_proto_gateway_proto_init() {
+	if File_protos_gateway_proto_gateway_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protos_gateway_proto_gateway_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   2,
+			NumExtensions: 2,
+			NumServices:   0,
+		},
+		GoTypes:           file_protos_gateway_proto_gateway_proto_goTypes,
+		DependencyIndexes: file_protos_gateway_proto_gateway_proto_depIdxs,
+		EnumInfos:         file_protos_gateway_proto_gateway_proto_enumTypes,
+		MessageInfos:      file_protos_gateway_proto_gateway_proto_msgTypes,
+		ExtensionInfos:    file_protos_gateway_proto_gateway_proto_extTypes,
+	}.Build()
+	File_protos_gateway_proto_gateway_proto = out.File
+	file_protos_gateway_proto_gateway_proto_rawDesc = nil
+	file_protos_gateway_proto_gateway_proto_goTypes = nil
+	file_protos_gateway_proto_gateway_proto_depIdxs = nil
+}
@@ -19,20 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TweetService_CreateTweet_FullMethodName     = "/TweetService/CreateTweet"
-	TweetService_GetTweetsByUser_FullMethodName = "/TweetService/GetTweetsByUser"
-	TweetService_UpdateTweet_FullMethodName     = "/TweetService/UpdateTweet"
-	TweetService_DeleteTweet_FullMethodName     = "/TweetService/DeleteTweet"
-	TweetService_AddLike_FullMethodName         = "/TweetService/AddLike"
-	TweetService_RemoveLike_FullMethodName      = "/TweetService/RemoveLike"
-	TweetService_AddComment_FullMethodName      = "/TweetService/AddComment"
-	TweetService_RemoveComment_FullMethodName   = "/TweetService/RemoveComment"
-	TweetService_AddShare_FullMethodName        = "/TweetService/AddShare"
-	TweetService_SaveTweet_FullMethodName       = "/TweetService/SaveTweet"
-	TweetService_RemoveSave_FullMethodName      = "/TweetService/RemoveSave"
-	TweetService_GetTweetByID_FullMethodName    = "/TweetService/GetTweetByID"
-	TweetService_GetSavedTweets_FullMethodName  = "/TweetService/GetSavedTweets"
-	TweetService_GetLikedTweets_FullMethodName  = "/TweetService/GetLikedTweets"
+	TweetService_CreateTweet_FullMethodName      = "/TweetService/CreateTweet"
+	TweetService_GetTweetsByUser_FullMethodName  = "/TweetService/GetTweetsByUser"
+	TweetService_UpdateTweet_FullMethodName      = "/TweetService/UpdateTweet"
+	TweetService_DeleteTweet_FullMethodName      = "/TweetService/DeleteTweet"
+	TweetService_AddLike_FullMethodName          = "/TweetService/AddLike"
+	TweetService_RemoveLike_FullMethodName       = "/TweetService/RemoveLike"
+	TweetService_AddComment_FullMethodName       = "/TweetService/AddComment"
+	TweetService_RemoveComment_FullMethodName    = "/TweetService/RemoveComment"
+	TweetService_AddShare_FullMethodName         = "/TweetService/AddShare"
+	TweetService_SaveTweet_FullMethodName        = "/TweetService/SaveTweet"
+	TweetService_RemoveSave_FullMethodName       = "/TweetService/RemoveSave"
+	TweetService_GetTweetByID_FullMethodName     = "/TweetService/GetTweetByID"
+	TweetService_GetTweetsByIds_FullMethodName   = "/TweetService/GetTweetsByIds"
+	TweetService_GetSavedTweets_FullMethodName   = "/TweetService/GetSavedTweets"
+	TweetService_GetLikedTweets_FullMethodName   = "/TweetService/GetLikedTweets"
+	TweetService_AdminDeleteTweet_FullMethodName = "/TweetService/AdminDeleteTweet"
 )
 
 // TweetServiceClient is the client API for TweetService service.
@@ -51,8 +53,10 @@ type TweetServiceClient interface {
 	SaveTweet(ctx context.Context, in *SaveTweetRequest, opts ...grpc.CallOption) (*SaveTweetResponse, error)
 	RemoveSave(ctx context.Context, in *RemoveSaveRequest, opts ...grpc.CallOption) (*RemoveSaveResponse, error)
 	GetTweetByID(ctx context.Context, in *GetTweetByIDRequest, opts ...grpc.CallOption) (*GetTweetByIDResponse, error)
+	GetTweetsByIds(ctx context.Context, in *GetTweetsByIdsRequest, opts ...grpc.CallOption) (*GetTweetsByIdsResponse, error)
 	GetSavedTweets(ctx context.Context, in *GetSavedTweetsRequest, opts ...grpc.CallOption) (*GetSavedTweetsResponse, error)
 	GetLikedTweets(ctx context.Context, in *GetLikedTweetsRequest, opts ...grpc.CallOption) (*GetLikedTweetsResponse, error)
+	AdminDeleteTweet(ctx context.Context, in *AdminDeleteTweetRequest, opts ...grpc.CallOption) (*AdminDeleteTweetResponse, error)
 }
 
 type tweetServiceClient struct {
@@ -183,6 +187,16 @@ func (c *tweetServiceClient) GetTweetByID(ctx context.Context, in *GetTweetByIDR
 	return out, nil
 }
 
+func (c *tweetServiceClient) GetTweetsByIds(ctx context.Context, in *GetTweetsByIdsRequest, opts ...grpc.CallOption) (*GetTweetsByIdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTweetsByIdsResponse)
+	err := c.cc.Invoke(ctx, TweetService_GetTweetsByIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tweetServiceClient) GetSavedTweets(ctx context.Context, in *GetSavedTweetsRequest, opts ...grpc.CallOption) (*GetSavedTweetsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetSavedTweetsResponse)
@@ -203,6 +217,16 @@ func (c *tweetServiceClient) GetLikedTweets(ctx context.Context, in *GetLikedTwe
 	return out, nil
 }
 
+func (c *tweetServiceClient) AdminDeleteTweet(ctx context.Context, in *AdminDeleteTweetRequest, opts ...grpc.CallOption) (*AdminDeleteTweetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminDeleteTweetResponse)
+	err := c.cc.Invoke(ctx, TweetService_AdminDeleteTweet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TweetServiceServer is the server API for TweetService service.
 // All implementations must embed UnimplementedTweetServiceServer
 // for forward compatibility.
@@ -219,8 +243,10 @@ type TweetServiceServer interface {
 	SaveTweet(context.Context, *SaveTweetRequest) (*SaveTweetResponse, error)
 	RemoveSave(context.Context, *RemoveSaveRequest) (*RemoveSaveResponse, error)
 	GetTweetByID(context.Context, *GetTweetByIDRequest) (*GetTweetByIDResponse, error)
+	GetTweetsByIds(context.Context, *GetTweetsByIdsRequest) (*GetTweetsByIdsResponse, error)
 	GetSavedTweets(context.Context, *GetSavedTweetsRequest) (*GetSavedTweetsResponse, error)
 	GetLikedTweets(context.Context, *GetLikedTweetsRequest) (*GetLikedTweetsResponse, error)
+	AdminDeleteTweet(context.Context, *AdminDeleteTweetRequest) (*AdminDeleteTweetResponse, error)
 	mustEmbedUnimplementedTweetServiceServer()
 }
 
@@ -267,12 +293,18 @@ func (UnimplementedTweetServiceServer) RemoveSave(context.Context, *RemoveSaveRe
 func (UnimplementedTweetServiceServer) GetTweetByID(context.Context, *GetTweetByIDRequest) (*GetTweetByIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTweetByID not implemented")
 }
+func (UnimplementedTweetServiceServer) GetTweetsByIds(context.Context, *GetTweetsByIdsRequest) (*GetTweetsByIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTweetsByIds not implemented")
+}
 func (UnimplementedTweetServiceServer) GetSavedTweets(context.Context, *GetSavedTweetsRequest) (*GetSavedTweetsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSavedTweets not implemented")
 }
 func (UnimplementedTweetServiceServer) GetLikedTweets(context.Context, *GetLikedTweetsRequest) (*GetLikedTweetsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLikedTweets not implemented")
 }
+func (UnimplementedTweetServiceServer) AdminDeleteTweet(context.Context, *AdminDeleteTweetRequest) (*AdminDeleteTweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDeleteTweet not implemented")
+}
 func (UnimplementedTweetServiceServer) mustEmbedUnimplementedTweetServiceServer() {}
 func (UnimplementedTweetServiceServer) testEmbeddedByValue()                      {}
 
@@ -510,6 +542,24 @@ func _TweetService_GetTweetByID_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TweetService_GetTweetsByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTweetsByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TweetServiceServer).GetTweetsByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TweetService_GetTweetsByIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TweetServiceServer).GetTweetsByIds(ctx, req.(*GetTweetsByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TweetService_GetSavedTweets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSavedTweetsRequest)
 	if err := dec(in); err != nil {
@@ -546,6 +596,24 @@ func _TweetService_GetLikedTweets_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TweetService_AdminDeleteTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDeleteTweetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TweetServiceServer).AdminDeleteTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TweetService_AdminDeleteTweet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TweetServiceServer).AdminDeleteTweet(ctx, req.(*AdminDeleteTweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TweetService_ServiceDesc is the grpc.ServiceDesc for TweetService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -601,6 +669,10 @@ var TweetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTweetByID",
 			Handler:    _TweetService_GetTweetByID_Handler,
 		},
+		{
+			MethodName: "GetTweetsByIds",
+			Handler:    _TweetService_GetTweetsByIds_Handler,
+		},
 		{
 			MethodName: "GetSavedTweets",
 			Handler:    _TweetService_GetSavedTweets_Handler,
@@ -609,6 +681,10 @@ var TweetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLikedTweets",
 			Handler:    _TweetService_GetLikedTweets_Handler,
 		},
+		{
+			MethodName: "AdminDeleteTweet",
+			Handler:    _TweetService_AdminDeleteTweet_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/tweet-proto/tweet.proto",
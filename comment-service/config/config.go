@@ -1,6 +1,9 @@
 package config
 
 import (
+	"pkg/mtls"
+	"pkg/tracing"
+
 	"github.com/spf13/viper"
 )
 
@@ -8,6 +11,17 @@ type Config struct {
 	SERVER_PORT          string `mapstructure:"SERVER_PORT"`
 	SERVER_NAME          string `mapstructure:"SERVER_NAME"`
 	SERVER_ENV           string `mapstructure:"SERVER_ENV"`
+	LOG_LEVEL            string `mapstructure:"LOG_LEVEL"`
+	METRICS_PORT         string `mapstructure:"METRICS_PORT"`
+	GRPC_TLS_MODE        string `mapstructure:"GRPC_TLS_MODE"`
+	GRPC_TLS_CA          string `mapstructure:"GRPC_TLS_CA"`
+	GRPC_TLS_CERT        string `mapstructure:"GRPC_TLS_CERT"`
+	GRPC_TLS_KEY         string `mapstructure:"GRPC_TLS_KEY"`
+	GRPC_ALLOWED_CALLERS string `mapstructure:"GRPC_ALLOWED_CALLERS"`
+	OTEL_TRACES_EXPORTER string `mapstructure:"OTEL_TRACES_EXPORTER"`
+	OTEL_EXPORTER_OTLP_ENDPOINT string `mapstructure:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+	OTEL_EXPORTER_OTLP_INSECURE bool `mapstructure:"OTEL_EXPORTER_OTLP_INSECURE"`
+	OTEL_TRACES_SAMPLER_ARG string `mapstructure:"OTEL_TRACES_SAMPLER_ARG"`
 	DB_HOST              string `mapstructure:"DB_HOST"`
 	DB_PORT              string `mapstructure:"DB_PORT"`
 	DB_USER              string `mapstructure:"DB_USER"`
@@ -43,3 +57,26 @@ func LoadConfig() (Config, error) {
 
 	return config, nil
 }
+
+// MTLS servislararo gRPC ulanishlari uchun TLS sozlamalari
+func (c Config) MTLS() mtls.Config {
+	return mtls.Config{
+		Mode:           c.GRPC_TLS_MODE,
+		CAFile:         c.GRPC_TLS_CA,
+		CertFile:       c.GRPC_TLS_CERT,
+		KeyFile:        c.GRPC_TLS_KEY,
+		AllowedCallers: mtls.ParseIdentities(c.GRPC_ALLOWED_CALLERS),
+	}
+}
+
+// Tracing OpenTelemetry exporter sozlamalari
+func (c Config) Tracing() tracing.Config {
+	return tracing.Config{
+		ServiceName: c.SERVER_NAME,
+		Environment: c.SERVER_ENV,
+		Exporter:    c.OTEL_TRACES_EXPORTER,
+		Endpoint:    c.OTEL_EXPORTER_OTLP_ENDPOINT,
+		Insecure:    c.OTEL_EXPORTER_OTLP_INSECURE,
+		SampleRatio: c.OTEL_TRACES_SAMPLER_ARG,
+	}
+}
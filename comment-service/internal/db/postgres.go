@@ -4,9 +4,11 @@ import (
 	"database/sql"
 	"comment-service/config"
 	"fmt"
-	"log"
+	"log/slog"
 
+	"github.com/XSAM/otelsql"
 	_ "github.com/lib/pq"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
 
 func NewPostgresDB() (*sql.DB, error) {
@@ -16,7 +18,7 @@ func NewPostgresDB() (*sql.DB, error) {
 	}
 
 	psqlInfo := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable", config.DB_HOST, config.DB_PORT, config.DB_USER, config.DB_PASSWORD, config.DB_NAME)
-	db, err := sql.Open("postgres", psqlInfo)
+	db, err := otelsql.Open("postgres", psqlInfo, otelsql.WithAttributes(semconv.DBSystemPostgreSQL))
 	if err != nil {
 		return nil, err
 	}
@@ -25,6 +27,6 @@ func NewPostgresDB() (*sql.DB, error) {
 		return nil, err
 	}
 
-	log.Println("Connected to PostgreSQL")
+	slog.Info("connected to postgres")
 	return db, nil
 }
@@ -5,6 +5,10 @@ import (
 	"comment-service/internal/service"
 	pb "comment-service/pkg/proto"
 	"context"
+	"log/slog"
+	"pkg/grpcerr"
+	"pkg/pagination"
+	"pkg/principal"
 )
 
 type CommentHandler struct {
@@ -17,6 +21,9 @@ func NewCommentHandler(service service.CommentService) *CommentHandler {
 }
 
 func (h *CommentHandler) CreateComment(ctx context.Context, req *pb.CreateCommentRequest) (*pb.CreateCommentResponse, error) {
+	if err := checkCaller(ctx, req.UserId); err != nil {
+		return nil, err
+	}
 	comment, err := h.service.CreateComment(ctx, &models.Comment{
 		UserID:  int64(req.UserId),
 		TweetID: int64(req.TweetId),
@@ -39,7 +46,12 @@ func (h *CommentHandler) CreateComment(ctx context.Context, req *pb.CreateCommen
 }
 
 func (h *CommentHandler) GetCommentsByTweetID(ctx context.Context, req *pb.GetCommentsByTweetIDRequest) (*pb.GetCommentsByTweetIDResponse, error) {
-	comments, err := h.service.GetCommentsByTweetID(ctx, int64(req.TweetId))
+	page, err := pagination.New(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	viewerID, _ := principal.CallerID(ctx)
+	comments, next, err := h.service.GetCommentsByTweetID(ctx, int64(req.TweetId), viewerID, page)
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +68,8 @@ func (h *CommentHandler) GetCommentsByTweetID(ctx context.Context, req *pb.GetCo
 		})
 	}
 	return &pb.GetCommentsByTweetIDResponse{
-		Comments: pbComments,
+		Comments:      pbComments,
+		NextPageToken: next,
 	}, nil
 }
 
@@ -83,6 +96,9 @@ func (h *CommentHandler) RemoveLikeFromComment(ctx context.Context, req *pb.Remo
 }
 
 func (h *CommentHandler) DeleteComment(ctx context.Context, req *pb.DeleteCommentRequest) (*pb.DeleteCommentResponse, error) {
+	if err := checkCaller(ctx, req.UserId); err != nil {
+		return nil, err
+	}
 	err := h.service.DeleteComment(ctx, int64(req.UserId), int64(req.TweetId), int64(req.Id))
 	if err != nil {
 		return nil, err
@@ -110,3 +126,35 @@ func (h *CommentHandler) GetComment(ctx context.Context, req *pb.GetCommentReque
 		},
 	}, nil
 }
+
+func (h *CommentHandler) AdminDeleteComment(ctx context.Context, req *pb.AdminDeleteCommentRequest) (*pb.AdminDeleteCommentResponse, error) {
+	if role := principal.CallerRole(ctx); role != "moderator" && role != "admin" {
+		return nil, grpcerr.ErrPermissionDenied
+	}
+
+	err := h.service.AdminDeleteComment(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	callerID, _ := principal.CallerID(ctx)
+	slog.InfoContext(ctx, "comment deleted by moderator", "comment_id", req.Id, "moderator_id", callerID, "reason", req.Reason)
+
+	return &pb.AdminDeleteCommentResponse{
+		Success: true,
+		Message: "Comment deleted successfully",
+	}, nil
+}
+
+// checkCaller comment faqat gateway uzatgan foydalanuvchi nomidan yozilishi
+// va o'chirilishini tekshiradi. x-user-id bo'lmasa so'rov rad etiladi.
+func checkCaller(ctx context.Context, userID int64) error {
+	callerID, ok := principal.CallerID(ctx)
+	if !ok {
+		return service.ErrUnauthenticated
+	}
+	if callerID != userID {
+		return service.ErrNotCommentOwner
+	}
+	return nil
+}
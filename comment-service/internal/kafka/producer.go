@@ -1,11 +1,15 @@
 package kafka
 
 import (
+	"comment-service/config"
 	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
+	"pkg/metrics"
+	"pkg/requestid"
+	"pkg/tracing"
 	"strconv"
-	"comment-service/config"
 
 	"github.com/segmentio/kafka-go"
 )
@@ -30,7 +34,7 @@ func PublishCommentNotification(ctx context.Context, tweetOwnerID int32, tweetID
 
 	defer func() {
 		if err := producer.Close(); err != nil {
-			fmt.Printf("error closing kafka writer: %v\n", err)
+			slog.ErrorContext(ctx, "failed to close kafka writer", "error", err)
 		}
 	}()
 
@@ -51,7 +55,14 @@ func PublishCommentNotification(ctx context.Context, tweetOwnerID int32, tweetID
 		Key:   []byte(strconv.Itoa(int(notification.UserID))), 
 		Value: valueBytes,
 	}
-	if err := producer.WriteMessages(ctx, msg); err != nil {
+	if id := requestid.FromContext(ctx); id != "" {
+		msg.Headers = append(msg.Headers, kafka.Header{Key: requestid.Header, Value: []byte(id)})
+	}
+	ctx, span := tracing.StartProducerSpan(ctx, producer.Topic, &msg)
+	err = producer.WriteMessages(ctx, msg)
+	tracing.End(span, err)
+	metrics.KafkaProduced(producer.Topic, err)
+	if err != nil {
 		return fmt.Errorf("error writing message to kafka: %v", err)
 	}
 
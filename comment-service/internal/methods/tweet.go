@@ -1,24 +1,41 @@
 package methods
 
 import (
-	grp "comment-service/pkg/proto"
 	"comment-service/config"
-	"log"
+	grp "comment-service/pkg/proto"
+	"pkg/grpcclient"
+	"pkg/logger"
+	"pkg/metrics"
+	"pkg/mtls"
+	"pkg/requestid"
+	"sync"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
-func ConnectTweet() grp.TweetServiceClient {
-	conf, err := config.LoadConfig()
-	if err != nil {
-		log.Fatalf("Failed to load config: %v", err)
-	}
-	conn, err := grpc.NewClient(conf.TWEET_SERVER_NAME+":"+conf.TWEET_SERVER_PORT, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		log.Fatal("error connect tweet micro...", err)
-	}
+var (
+	tweetOnce   sync.Once
+	tweetClient grp.TweetServiceClient
+)
 
-	client := grp.NewTweetServiceClient(conn)
-	return client
+// ConnectTweet tweet-service uchun umumiy clientni qaytaradi.
+// Ulanish birinchi chaqiruvda bir marta ochiladi va keyin qayta ishlatiladi.
+func ConnectTweet() grp.TweetServiceClient {
+	tweetOnce.Do(func() {
+		conf, err := config.LoadConfig()
+		if err != nil {
+			logger.Fatal("failed to load config", "error", err)
+		}
+		creds, err := mtls.ClientCredentials(conf.MTLS(), conf.TWEET_SERVER_NAME)
+		if err != nil {
+			logger.Fatal("failed to load TLS credentials", "error", err)
+		}
+		conn, err := grpcclient.Dial(conf.TWEET_SERVER_NAME+":"+conf.TWEET_SERVER_PORT, creds, grpcclient.DefaultOptions,
+			grpc.WithChainUnaryInterceptor(requestid.UnaryClientInterceptor(), logger.UnaryClientInterceptor(), metrics.UnaryClientInterceptor()))
+		if err != nil {
+			logger.Fatal("failed to connect to tweet service", "error", err)
+		}
+		tweetClient = grp.NewTweetServiceClient(conn)
+	})
+	return tweetClient
 }
@@ -0,0 +1,13 @@
+package service
+
+import "pkg/grpcerr"
+
+// Servis xatolari. Reason qiymatlari gateway javobida "code" bo'lib chiqadi.
+var (
+	ErrUnauthenticated = grpcerr.Unauthenticated("UNAUTHENTICATED", "caller is not authenticated")
+	ErrCommentNotFound = grpcerr.NotFound("COMMENT_NOT_FOUND", "comment not found")
+	ErrNotCommentOwner = grpcerr.PermissionDenied("NOT_COMMENT_OWNER", "this is not your comment")
+	ErrNotTweetOwner   = grpcerr.PermissionDenied("NOT_TWEET_OWNER", "this is not your tweet")
+	ErrPrivateAccount  = grpcerr.PermissionDenied("PRIVATE_ACCOUNT", "this user is private and you are not following")
+	ErrBlockedByUser   = grpcerr.PermissionDenied("BLOCKED_BY_USER", "this user has blocked you")
+)
@@ -8,9 +8,11 @@ import (
 	"comment-service/utils"
 	"context"
 	"database/sql"
-	"errors"
+	"pkg/pagination"
 
 	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type CommentService struct {
@@ -29,23 +31,16 @@ func (s *CommentService) CreateComment(ctx context.Context, comment *models.Comm
 		RETURNING *
 	`
 	tweets := methods.ConnectTweet()
-	_, err := tweets.GetTweetByID(ctx, &proto.GetTweetByIDRequest{TweetId: int32(comment.TweetID)})
+	tweet, err := tweets.GetTweetByID(ctx, &proto.GetTweetByIDRequest{TweetId: int32(comment.TweetID)})
 	if err != nil {
 		return nil, err
 	}
-	users := methods.ConnectUser()
-	user, err := users.GetUser(ctx, &proto.GetUserRequest{UserId: int32(comment.UserID)})
+	owner, err := getOwner(ctx, tweet.Tweet.UserId, int32(comment.UserID))
 	if err != nil {
-		return nil, err				
-	}
-
-	if user.User.IsPrivate {
-		if !utils.InSlice(user.User.Followers, int32(comment.UserID)) {
-			return nil, errors.New("this user is private and you are not following")
-		}
+		return nil, err
 	}
-	if !utils.InSlice(user.User.BlockedUsers, int32(comment.UserID)) {
-		return nil, errors.New("this user has blocked you")
+	if err := checkViewer(owner, int32(comment.UserID)); err != nil {
+		return nil, err
 	}
 	var createdComment models.Comment
 	err = s.db.QueryRowContext(ctx, query, comment.UserID, comment.TweetID, comment.Content).Scan(&createdComment.ID, &createdComment.UserID, &createdComment.TweetID, &createdComment.Content, &createdComment.CreatedAt, &createdComment.Likes)
@@ -60,53 +55,52 @@ func (s *CommentService) CreateComment(ctx context.Context, comment *models.Comm
 	if err != nil {
 		return nil, err
 	}
-	err = kafka.PublishCommentNotification(ctx, int32(comment.UserID), int32(comment.TweetID), int32(comment.UserID), comment.Content)
+	err = kafka.PublishCommentNotification(ctx, tweet.GetTweet().GetUserId(), int32(comment.TweetID), int32(comment.UserID), comment.Content)
 	if err != nil {
 		return nil, err
 	}
 	return &createdComment, nil
 }
 
-func (s *CommentService) GetCommentsByTweetID(ctx context.Context, tweetID int64) ([]*models.Comment, error) {
+func (s *CommentService) GetCommentsByTweetID(ctx context.Context, tweetID, viewerID int64, page pagination.Page) ([]*models.Comment, string, error) {
 	query := `
-		SELECT * FROM comments WHERE tweet_id = $1
+		SELECT id, user_id, tweet_id, content, created_at FROM comments
+		WHERE tweet_id = $1 AND (created_at, id) < ($2, $3)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $4
 	`
 	tweets := methods.ConnectTweet()
 	tweet, err := tweets.GetTweetByID(ctx, &proto.GetTweetByIDRequest{TweetId: int32(tweetID)})
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	userID := tweet.Tweet.UserId
-	users := methods.ConnectUser()
-	user, err := users.GetUser(ctx, &proto.GetUserRequest{UserId: int32(userID)})
+	owner, err := getOwner(ctx, tweet.Tweet.UserId, int32(viewerID))
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	if user.User.IsPrivate {
-		if !utils.InSlice(user.User.Followers, int32(userID)) {
-			return nil, errors.New("this user is private and you are not following")
-		}
+	if err := checkViewer(owner, int32(viewerID)); err != nil {
+		return nil, "", err
 	}
-	if !utils.InSlice(user.User.BlockedUsers, int32(userID)) {
-		return nil, errors.New("this user has blocked you")
-	}
-	rows, err := s.db.QueryContext(ctx, query, tweetID)
+	rows, err := s.db.QueryContext(ctx, query, tweetID, page.After.CreatedAt, page.After.ID, page.Limit())
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	defer rows.Close()
 
 	var comments []*models.Comment
 	for rows.Next() {
 		var comment models.Comment
-		err := rows.Scan(&comment.ID, &comment.UserID, &comment.TweetID, &comment.Content)
+		err := rows.Scan(&comment.ID, &comment.UserID, &comment.TweetID, &comment.Content, &comment.CreatedAt)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		comments = append(comments, &comment)
 	}
 
-	return comments, nil
+	comments, next := pagination.Trim(comments, page, func(comment *models.Comment) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: comment.CreatedAt, ID: comment.ID}
+	})
+	return comments, next, nil
 }
 
 func (s *CommentService) DeleteComment(ctx context.Context, id int64, userID int64, tweetID int64) error {
@@ -115,6 +109,9 @@ func (s *CommentService) DeleteComment(ctx context.Context, id int64, userID int
 	`
 	var comment models.Comment
 	err := s.db.QueryRowContext(ctx, query, id).Scan(&comment.ID, &comment.UserID, &comment.TweetID, &comment.Content, &comment.CreatedAt, &comment.Likes)
+	if err == sql.ErrNoRows {
+		return ErrCommentNotFound
+	}
 	if err != nil {
 		return err
 	}
@@ -125,9 +122,9 @@ func (s *CommentService) DeleteComment(ctx context.Context, id int64, userID int
 		return err
 	}
 	if userID != comment.UserID {
-		return errors.New("this is not your comment")
+		return ErrNotCommentOwner
 	} else if userID != int64(tweet.Tweet.UserId) {
-		return errors.New("this is not your tweet")
+		return ErrNotTweetOwner
 	}
 
 	deleteQuery := `
@@ -149,12 +146,44 @@ func (s *CommentService) DeleteComment(ctx context.Context, id int64, userID int
 	return nil
 }
 
+// AdminDeleteComment egasini tekshirmasdan kommentni o'chiradi (moderatorlar uchun)
+func (s *CommentService) AdminDeleteComment(ctx context.Context, id int64) error {
+	var comment models.Comment
+	err := s.db.QueryRowContext(ctx, `SELECT id, user_id, tweet_id FROM comments WHERE id = $1`, id).Scan(&comment.ID, &comment.UserID, &comment.TweetID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrCommentNotFound
+		}
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, `DELETE FROM comments WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	tweets := methods.ConnectTweet()
+	_, err = tweets.RemoveComment(ctx, &proto.RemoveCommentRequest{
+		UserId:    int32(comment.UserID),
+		TweetId:   int32(comment.TweetID),
+		CommentId: int32(comment.ID),
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (s *CommentService) LikeComment(ctx context.Context, userID int64, commentID int64) (bool, error) {
 	query := `
 		SELECT * FROM comments WHERE id = $1 RETURNING *
 	`
 	var comment models.Comment
 	err := s.db.QueryRowContext(ctx, query, commentID).Scan(&comment.ID, &comment.UserID, &comment.TweetID, &comment.Content, &comment.CreatedAt, &comment.Likes)
+	if err == sql.ErrNoRows {
+		return false, ErrCommentNotFound
+	}
 	if err != nil {
 		return false, err
 	}
@@ -179,6 +208,9 @@ func (s *CommentService) RemoveLikeFromComment(ctx context.Context, userID int64
 	`
 	var comment models.Comment
 	err := s.db.QueryRowContext(ctx, query, commentID).Scan(&comment.ID, &comment.UserID, &comment.TweetID, &comment.Content, &comment.CreatedAt, &comment.Likes)
+	if err == sql.ErrNoRows {
+		return false, ErrCommentNotFound
+	}
 	if err != nil {
 		return false, err
 	}
@@ -203,22 +235,53 @@ func (s *CommentService) GetCommentByID(ctx context.Context, id int64, userID in
 	`
 	var comment models.Comment
 	err := s.db.QueryRowContext(ctx, query, id).Scan(&comment.ID, &comment.UserID, &comment.TweetID, &comment.Content, &comment.CreatedAt, &comment.Likes)
+	if err == sql.ErrNoRows {
+		return nil, ErrCommentNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
 	comment.LikesCount = int32(len(comment.Likes))
-	users := methods.ConnectUser()
-	user, err := users.GetUser(ctx, &proto.GetUserRequest{UserId: int32(userID)})
+	author, err := getOwner(ctx, int32(comment.UserID), int32(userID))
 	if err != nil {
 		return nil, err
 	}
-	if user.User.IsPrivate {
-		if !utils.InSlice(user.User.Followers, int32(userID)) {
-			return nil, errors.New("this user is private and you are not following")
+	if err := checkViewer(author, int32(userID)); err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// getOwner tweet yoki comment egasini viewerID nomidan oladi. user-service
+// GetUser javobida blocked_users bo'lmaydi: viewerID ni bloklagan foydalanuvchini
+// u topilmagan deb qaytaradi, shuning uchun blok shu yerda ErrBlockedByUser ga aylanadi.
+func getOwner(ctx context.Context, ownerID, viewerID int32) (*proto.User, error) {
+	users := methods.ConnectUser()
+	resp, err := users.GetUser(ctx, &proto.GetUserRequest{UserId: ownerID, ViewerId: viewerID})
+	if status.Code(err) == codes.NotFound && viewerID != 0 {
+		// ega mavjud bo'lsa, u viewerID ni bloklagan
+		if _, lookupErr := users.GetUser(ctx, &proto.GetUserRequest{UserId: ownerID}); lookupErr == nil {
+			return nil, ErrBlockedByUser
 		}
 	}
-	if !utils.InSlice(user.User.BlockedUsers, int32(userID)) {
-		return nil, errors.New("this user has blocked you")
+	if err != nil {
+		return nil, err
 	}
-	return &comment, nil
+	return resp.User, nil
+}
+
+// checkViewer viewerID owner ning tweet va commentlarini ko'ra olishini
+// tekshiradi: owner uni bloklamagan va akkaunt yopiq bo'lsa unga obuna bo'lgan
+// bo'lishi kerak. viewerID 0 ichki so'rovlar uchun, ular tekshirilmaydi.
+func checkViewer(owner *proto.User, viewerID int32) error {
+	if viewerID == 0 || viewerID == owner.Id {
+		return nil
+	}
+	if utils.InSlice(owner.BlockedUsers, viewerID) {
+		return ErrBlockedByUser
+	}
+	if owner.IsPrivate && !utils.InSlice(owner.Followers, viewerID) {
+		return ErrPrivateAccount
+	}
+	return nil
 }
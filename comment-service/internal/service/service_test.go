@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"comment-service/internal/methods"
+	"comment-service/pkg/proto"
+	"comment-service/utils"
+	"pkg/grpcerr"
+
+	"google.golang.org/grpc"
+)
+
+func TestCheckViewer(t *testing.T) {
+	tests := []struct {
+		name   string
+		owner  *proto.User
+		viewer int32
+		want   error
+	}{
+		{"viewer is not blocked", &proto.User{Id: 1, BlockedUsers: []int32{3}}, 2, nil},
+		{"internal lookup", &proto.User{Id: 1, BlockedUsers: []int32{2}, IsPrivate: true}, 0, nil},
+		{"owner", &proto.User{Id: 1, IsPrivate: true}, 1, nil},
+		{"follower of a private account", &proto.User{Id: 1, IsPrivate: true, Followers: []int32{2}}, 2, nil},
+		{"viewer is blocked", &proto.User{Id: 1, BlockedUsers: []int32{3, 2}}, 2, ErrBlockedByUser},
+		{"private account", &proto.User{Id: 1, IsPrivate: true, Followers: []int32{3}}, 2, ErrPrivateAccount},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkViewer(tt.owner, tt.viewer); !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+// fakeUsers user-service kabi: viewer ni bloklagan foydalanuvchi topilmaydi
+type fakeUsers struct {
+	proto.UserServiceClient
+	user *proto.User
+}
+
+func (f *fakeUsers) GetUser(ctx context.Context, req *proto.GetUserRequest, opts ...grpc.CallOption) (*proto.GetUserResponse, error) {
+	if req.UserId != f.user.Id || (req.ViewerId != 0 && utils.InSlice(f.user.BlockedUsers, req.ViewerId)) {
+		return nil, grpcerr.NotFound("USER_NOT_FOUND", "user not found")
+	}
+	// blocked_users javobga chiqmaydi
+	return &proto.GetUserResponse{User: &proto.User{Id: f.user.Id, IsPrivate: f.user.IsPrivate}}, nil
+}
+
+func TestGetOwner(t *testing.T) {
+	methods.SetUserClient(&fakeUsers{user: &proto.User{Id: 1, BlockedUsers: []int32{2}}})
+	ctx := context.Background()
+
+	if owner, err := getOwner(ctx, 1, 3); err != nil || owner.Id != 1 {
+		t.Errorf("not blocked: %v, %v", owner, err)
+	}
+	if _, err := getOwner(ctx, 1, 2); !errors.Is(err, ErrBlockedByUser) {
+		t.Errorf("blocked viewer: err = %v, want %v", err, ErrBlockedByUser)
+	}
+	if _, err := getOwner(ctx, 1, 0); err != nil {
+		t.Errorf("internal lookup: %v", err)
+	}
+	if _, err := getOwner(ctx, 5, 2); errors.Is(err, ErrBlockedByUser) || err == nil {
+		t.Errorf("missing owner: err = %v, want not found", err)
+	}
+}
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_comments_tweet_created_at ON comments (tweet_id, created_at DESC, id DESC);
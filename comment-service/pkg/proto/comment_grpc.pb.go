@@ -25,6 +25,7 @@ const (
 	CommentService_GetCommentsByTweetID_FullMethodName  = "/CommentService/GetCommentsByTweetID"
 	CommentService_LikeComment_FullMethodName           = "/CommentService/LikeComment"
 	CommentService_RemoveLikeFromComment_FullMethodName = "/CommentService/RemoveLikeFromComment"
+	CommentService_AdminDeleteComment_FullMethodName    = "/CommentService/AdminDeleteComment"
 )
 
 // CommentServiceClient is the client API for CommentService service.
@@ -37,6 +38,7 @@ type CommentServiceClient interface {
 	GetCommentsByTweetID(ctx context.Context, in *GetCommentsByTweetIDRequest, opts ...grpc.CallOption) (*GetCommentsByTweetIDResponse, error)
 	LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentResponse, error)
 	RemoveLikeFromComment(ctx context.Context, in *RemoveLikeFromCommentRequest, opts ...grpc.CallOption) (*RemoveLikeFromCommentResponse, error)
+	AdminDeleteComment(ctx context.Context, in *AdminDeleteCommentRequest, opts ...grpc.CallOption) (*AdminDeleteCommentResponse, error)
 }
 
 type commentServiceClient struct {
@@ -107,6 +109,16 @@ func (c *commentServiceClient) RemoveLikeFromComment(ctx context.Context, in *Re
 	return out, nil
 }
 
+func (c *commentServiceClient) AdminDeleteComment(ctx context.Context, in *AdminDeleteCommentRequest, opts ...grpc.CallOption) (*AdminDeleteCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminDeleteCommentResponse)
+	err := c.cc.Invoke(ctx, CommentService_AdminDeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommentServiceServer is the server API for CommentService service.
 // All implementations must embed UnimplementedCommentServiceServer
 // for forward compatibility.
@@ -117,6 +129,7 @@ type CommentServiceServer interface {
 	GetCommentsByTweetID(context.Context, *GetCommentsByTweetIDRequest) (*GetCommentsByTweetIDResponse, error)
 	LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentResponse, error)
 	RemoveLikeFromComment(context.Context, *RemoveLikeFromCommentRequest) (*RemoveLikeFromCommentResponse, error)
+	AdminDeleteComment(context.Context, *AdminDeleteCommentRequest) (*AdminDeleteCommentResponse, error)
 	mustEmbedUnimplementedCommentServiceServer()
 }
 
@@ -145,6 +158,9 @@ func (UnimplementedCommentServiceServer) LikeComment(context.Context, *LikeComme
 func (UnimplementedCommentServiceServer) RemoveLikeFromComment(context.Context, *RemoveLikeFromCommentRequest) (*RemoveLikeFromCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveLikeFromComment not implemented")
 }
+func (UnimplementedCommentServiceServer) AdminDeleteComment(context.Context, *AdminDeleteCommentRequest) (*AdminDeleteCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDeleteComment not implemented")
+}
 func (UnimplementedCommentServiceServer) mustEmbedUnimplementedCommentServiceServer() {}
 func (UnimplementedCommentServiceServer) testEmbeddedByValue()                        {}
 
@@ -274,6 +290,24 @@ func _CommentService_RemoveLikeFromComment_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommentService_AdminDeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).AdminDeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_AdminDeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).AdminDeleteComment(ctx, req.(*AdminDeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CommentService_ServiceDesc is the grpc.ServiceDesc for CommentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,6 +339,10 @@ var CommentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveLikeFromComment",
 			Handler:    _CommentService_RemoveLikeFromComment_Handler,
 		},
+		{
+			MethodName: "AdminDeleteComment",
+			Handler:    _CommentService_AdminDeleteComment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/proto/comment.proto",
@@ -43,6 +43,8 @@ type Tweet struct {
 	SaveCount    int32                  `protobuf:"varint,15,opt,name=save_count,json=saveCount,proto3" json:"save_count,omitempty"`
 	IsSaved      bool                   `protobuf:"varint,16,opt,name=is_saved,json=isSaved,proto3" json:"is_saved,omitempty"`
 	IsLiked      bool                   `protobuf:"varint,17,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`
+	// Attached media; media holds their URLs.
+	Attachments []*TweetMedia `protobuf:"bytes,18,rep,name=attachments,proto3" json:"attachments,omitempty"`
 }
 
 func (x *Tweet) Reset() {
@@ -196,6 +198,126 @@ func (x *Tweet) GetIsLiked() bool {
 	return false
 }
 
+func (x *Tweet) GetAttachments() []*TweetMedia {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// TweetMedia is the media record of an attached file, see UserService.GetMedia.
+type TweetMedia struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url        string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	MimeType   string `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Width      int32  `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Height     int32  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	DurationMs int64  `protobuf:"varint,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Blurhash   string `protobuf:"bytes,7,opt,name=blurhash,proto3" json:"blurhash,omitempty"`
+	AltText    string `protobuf:"bytes,8,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	// Rendition name (e.g. "small" or "128") to URL.
+	Renditions map[string]string `protobuf:"bytes,9,rep,name=renditions,proto3" json:"renditions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *TweetMedia) Reset() {
+	*x = TweetMedia{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_tweet_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TweetMedia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TweetMedia) ProtoMessage() {}
+
+func (x *TweetMedia) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tweet_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TweetMedia.ProtoReflect.Descriptor instead.
+func (*TweetMedia) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TweetMedia) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TweetMedia) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *TweetMedia) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *TweetMedia) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *TweetMedia) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *TweetMedia) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *TweetMedia) GetBlurhash() string {
+	if x != nil {
+		return x.Blurhash
+	}
+	return ""
+}
+
+func (x *TweetMedia) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *TweetMedia) GetRenditions() map[string]string {
+	if x != nil {
+		return x.Renditions
+	}
+	return nil
+}
+
 type GetSavedTweetsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -207,7 +329,7 @@ type GetSavedTweetsRequest struct {
 func (x *GetSavedTweetsRequest) Reset() {
 	*x = GetSavedTweetsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[1]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -220,7 +342,7 @@ func (x *GetSavedTweetsRequest) String() string {
 func (*GetSavedTweetsRequest) ProtoMessage() {}
 
 func (x *GetSavedTweetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[1]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -233,7 +355,7 @@ func (x *GetSavedTweetsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSavedTweetsRequest.ProtoReflect.Descriptor instead.
 func (*GetSavedTweetsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{1}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GetSavedTweetsRequest) GetUserId() int32 {
@@ -256,7 +378,7 @@ type GetSavedTweetsResponse struct {
 func (x *GetSavedTweetsResponse) Reset() {
 	*x = GetSavedTweetsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[2]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -269,7 +391,7 @@ func (x *GetSavedTweetsResponse) String() string {
 func (*GetSavedTweetsResponse) ProtoMessage() {}
 
 func (x *GetSavedTweetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[2]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -282,7 +404,7 @@ func (x *GetSavedTweetsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSavedTweetsResponse.ProtoReflect.Descriptor instead.
 func (*GetSavedTweetsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{2}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetSavedTweetsResponse) GetTweets() []*Tweet {
@@ -317,7 +439,7 @@ type GetLikedTweetsRequest struct {
 func (x *GetLikedTweetsRequest) Reset() {
 	*x = GetLikedTweetsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[3]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -330,7 +452,7 @@ func (x *GetLikedTweetsRequest) String() string {
 func (*GetLikedTweetsRequest) ProtoMessage() {}
 
 func (x *GetLikedTweetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[3]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,7 +465,7 @@ func (x *GetLikedTweetsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLikedTweetsRequest.ProtoReflect.Descriptor instead.
 func (*GetLikedTweetsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{3}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetLikedTweetsRequest) GetUserId() int32 {
@@ -366,7 +488,7 @@ type GetLikedTweetsResponse struct {
 func (x *GetLikedTweetsResponse) Reset() {
 	*x = GetLikedTweetsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[4]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -379,7 +501,7 @@ func (x *GetLikedTweetsResponse) String() string {
 func (*GetLikedTweetsResponse) ProtoMessage() {}
 
 func (x *GetLikedTweetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[4]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -392,7 +514,7 @@ func (x *GetLikedTweetsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLikedTweetsResponse.ProtoReflect.Descriptor instead.
 func (*GetLikedTweetsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{4}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetLikedTweetsResponse) GetTweets() []*Tweet {
@@ -421,16 +543,18 @@ type CreateTweetRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content  string   `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	UserId   int32    `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	UserId  int32  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Deprecated: Marked as deprecated in pkg/proto/tweet.proto.
 	Media    []string `protobuf:"bytes,3,rep,name=media,proto3" json:"media,omitempty"`
 	Username string   `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	MediaIds []string `protobuf:"bytes,5,rep,name=media_ids,json=mediaIds,proto3" json:"media_ids,omitempty"`
 }
 
 func (x *CreateTweetRequest) Reset() {
 	*x = CreateTweetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[5]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -443,7 +567,7 @@ func (x *CreateTweetRequest) String() string {
 func (*CreateTweetRequest) ProtoMessage() {}
 
 func (x *CreateTweetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[5]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -456,7 +580,7 @@ func (x *CreateTweetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTweetRequest.ProtoReflect.Descriptor instead.
 func (*CreateTweetRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{5}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateTweetRequest) GetContent() string {
@@ -473,6 +597,7 @@ func (x *CreateTweetRequest) GetUserId() int32 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in pkg/proto/tweet.proto.
 func (x *CreateTweetRequest) GetMedia() []string {
 	if x != nil {
 		return x.Media
@@ -487,6 +612,13 @@ func (x *CreateTweetRequest) GetUsername() string {
 	return ""
 }
 
+func (x *CreateTweetRequest) GetMediaIds() []string {
+	if x != nil {
+		return x.MediaIds
+	}
+	return nil
+}
+
 type CreateTweetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -499,7 +631,7 @@ type CreateTweetResponse struct {
 func (x *CreateTweetResponse) Reset() {
 	*x = CreateTweetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[6]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -512,7 +644,7 @@ func (x *CreateTweetResponse) String() string {
 func (*CreateTweetResponse) ProtoMessage() {}
 
 func (x *CreateTweetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[6]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,7 +657,7 @@ func (x *CreateTweetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTweetResponse.ProtoReflect.Descriptor instead.
 func (*CreateTweetResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{6}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateTweetResponse) GetSuccess() bool {
@@ -553,7 +685,7 @@ type GetTweetsRequest struct {
 func (x *GetTweetsRequest) Reset() {
 	*x = GetTweetsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[7]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -566,7 +698,7 @@ func (x *GetTweetsRequest) String() string {
 func (*GetTweetsRequest) ProtoMessage() {}
 
 func (x *GetTweetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[7]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,7 +711,7 @@ func (x *GetTweetsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTweetsRequest.ProtoReflect.Descriptor instead.
 func (*GetTweetsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{7}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetTweetsRequest) GetUserId() int32 {
@@ -602,7 +734,7 @@ type GetTweetsResponse struct {
 func (x *GetTweetsResponse) Reset() {
 	*x = GetTweetsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[8]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -615,7 +747,7 @@ func (x *GetTweetsResponse) String() string {
 func (*GetTweetsResponse) ProtoMessage() {}
 
 func (x *GetTweetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[8]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -628,7 +760,7 @@ func (x *GetTweetsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTweetsResponse.ProtoReflect.Descriptor instead.
 func (*GetTweetsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{8}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetTweetsResponse) GetTweets() []*Tweet {
@@ -663,7 +795,7 @@ type GetTweetsByUserRequest struct {
 func (x *GetTweetsByUserRequest) Reset() {
 	*x = GetTweetsByUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[9]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -676,7 +808,7 @@ func (x *GetTweetsByUserRequest) String() string {
 func (*GetTweetsByUserRequest) ProtoMessage() {}
 
 func (x *GetTweetsByUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[9]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -689,7 +821,7 @@ func (x *GetTweetsByUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTweetsByUserRequest.ProtoReflect.Descriptor instead.
 func (*GetTweetsByUserRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{9}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetTweetsByUserRequest) GetUserId() int32 {
@@ -712,7 +844,7 @@ type GetTweetsByUserResponse struct {
 func (x *GetTweetsByUserResponse) Reset() {
 	*x = GetTweetsByUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[10]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -725,7 +857,7 @@ func (x *GetTweetsByUserResponse) String() string {
 func (*GetTweetsByUserResponse) ProtoMessage() {}
 
 func (x *GetTweetsByUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[10]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -738,7 +870,7 @@ func (x *GetTweetsByUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTweetsByUserResponse.ProtoReflect.Descriptor instead.
 func (*GetTweetsByUserResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{10}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetTweetsByUserResponse) GetTweets() []*Tweet {
@@ -774,7 +906,7 @@ type UpdateTweetRequest struct {
 func (x *UpdateTweetRequest) Reset() {
 	*x = UpdateTweetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[11]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -787,7 +919,7 @@ func (x *UpdateTweetRequest) String() string {
 func (*UpdateTweetRequest) ProtoMessage() {}
 
 func (x *UpdateTweetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[11]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -800,7 +932,7 @@ func (x *UpdateTweetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTweetRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTweetRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{11}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateTweetRequest) GetTweetId() int32 {
@@ -829,7 +961,7 @@ type UpdateTweetResponse struct {
 func (x *UpdateTweetResponse) Reset() {
 	*x = UpdateTweetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[12]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -842,7 +974,7 @@ func (x *UpdateTweetResponse) String() string {
 func (*UpdateTweetResponse) ProtoMessage() {}
 
 func (x *UpdateTweetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[12]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -855,7 +987,7 @@ func (x *UpdateTweetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTweetResponse.ProtoReflect.Descriptor instead.
 func (*UpdateTweetResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{12}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateTweetResponse) GetSuccess() bool {
@@ -884,7 +1016,7 @@ type AddLikeRequest struct {
 func (x *AddLikeRequest) Reset() {
 	*x = AddLikeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[13]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -897,7 +1029,7 @@ func (x *AddLikeRequest) String() string {
 func (*AddLikeRequest) ProtoMessage() {}
 
 func (x *AddLikeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[13]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -910,7 +1042,7 @@ func (x *AddLikeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddLikeRequest.ProtoReflect.Descriptor instead.
 func (*AddLikeRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{13}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AddLikeRequest) GetTweetId() int32 {
@@ -939,7 +1071,7 @@ type AddLikeResponse struct {
 func (x *AddLikeResponse) Reset() {
 	*x = AddLikeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[14]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -952,7 +1084,7 @@ func (x *AddLikeResponse) String() string {
 func (*AddLikeResponse) ProtoMessage() {}
 
 func (x *AddLikeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[14]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,7 +1097,7 @@ func (x *AddLikeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddLikeResponse.ProtoReflect.Descriptor instead.
 func (*AddLikeResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{14}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AddLikeResponse) GetSuccess() bool {
@@ -994,7 +1126,7 @@ type RemoveLikeRequest struct {
 func (x *RemoveLikeRequest) Reset() {
 	*x = RemoveLikeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[15]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1007,7 +1139,7 @@ func (x *RemoveLikeRequest) String() string {
 func (*RemoveLikeRequest) ProtoMessage() {}
 
 func (x *RemoveLikeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[15]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1020,7 +1152,7 @@ func (x *RemoveLikeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveLikeRequest.ProtoReflect.Descriptor instead.
 func (*RemoveLikeRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{15}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *RemoveLikeRequest) GetTweetId() int32 {
@@ -1049,7 +1181,7 @@ type RemoveLikeResponse struct {
 func (x *RemoveLikeResponse) Reset() {
 	*x = RemoveLikeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[16]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1062,7 +1194,7 @@ func (x *RemoveLikeResponse) String() string {
 func (*RemoveLikeResponse) ProtoMessage() {}
 
 func (x *RemoveLikeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[16]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1075,7 +1207,7 @@ func (x *RemoveLikeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveLikeResponse.ProtoReflect.Descriptor instead.
 func (*RemoveLikeResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{16}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *RemoveLikeResponse) GetSuccess() bool {
@@ -1105,7 +1237,7 @@ type AddCommentRequest struct {
 func (x *AddCommentRequest) Reset() {
 	*x = AddCommentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[17]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1118,7 +1250,7 @@ func (x *AddCommentRequest) String() string {
 func (*AddCommentRequest) ProtoMessage() {}
 
 func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[17]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1131,7 +1263,7 @@ func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddCommentRequest.ProtoReflect.Descriptor instead.
 func (*AddCommentRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{17}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *AddCommentRequest) GetTweetId() int32 {
@@ -1167,7 +1299,7 @@ type AddCommentResponse struct {
 func (x *AddCommentResponse) Reset() {
 	*x = AddCommentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[18]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1180,7 +1312,7 @@ func (x *AddCommentResponse) String() string {
 func (*AddCommentResponse) ProtoMessage() {}
 
 func (x *AddCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[18]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1193,7 +1325,7 @@ func (x *AddCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddCommentResponse.ProtoReflect.Descriptor instead.
 func (*AddCommentResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{18}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *AddCommentResponse) GetSuccess() bool {
@@ -1223,7 +1355,7 @@ type RemoveCommentRequest struct {
 func (x *RemoveCommentRequest) Reset() {
 	*x = RemoveCommentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[19]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1236,7 +1368,7 @@ func (x *RemoveCommentRequest) String() string {
 func (*RemoveCommentRequest) ProtoMessage() {}
 
 func (x *RemoveCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[19]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1249,7 +1381,7 @@ func (x *RemoveCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveCommentRequest.ProtoReflect.Descriptor instead.
 func (*RemoveCommentRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{19}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *RemoveCommentRequest) GetTweetId() int32 {
@@ -1285,7 +1417,7 @@ type RemoveCommentResponse struct {
 func (x *RemoveCommentResponse) Reset() {
 	*x = RemoveCommentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[20]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1298,7 +1430,7 @@ func (x *RemoveCommentResponse) String() string {
 func (*RemoveCommentResponse) ProtoMessage() {}
 
 func (x *RemoveCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[20]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1311,7 +1443,7 @@ func (x *RemoveCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveCommentResponse.ProtoReflect.Descriptor instead.
 func (*RemoveCommentResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{20}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *RemoveCommentResponse) GetSuccess() bool {
@@ -1340,7 +1472,7 @@ type AddShareRequest struct {
 func (x *AddShareRequest) Reset() {
 	*x = AddShareRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[21]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1353,7 +1485,7 @@ func (x *AddShareRequest) String() string {
 func (*AddShareRequest) ProtoMessage() {}
 
 func (x *AddShareRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[21]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1366,7 +1498,7 @@ func (x *AddShareRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddShareRequest.ProtoReflect.Descriptor instead.
 func (*AddShareRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{21}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *AddShareRequest) GetTweetId() int32 {
@@ -1395,7 +1527,7 @@ type AddShareResponse struct {
 func (x *AddShareResponse) Reset() {
 	*x = AddShareResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[22]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1408,7 +1540,7 @@ func (x *AddShareResponse) String() string {
 func (*AddShareResponse) ProtoMessage() {}
 
 func (x *AddShareResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[22]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1421,7 +1553,7 @@ func (x *AddShareResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddShareResponse.ProtoReflect.Descriptor instead.
 func (*AddShareResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{22}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *AddShareResponse) GetSuccess() bool {
@@ -1450,7 +1582,7 @@ type SaveTweetRequest struct {
 func (x *SaveTweetRequest) Reset() {
 	*x = SaveTweetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[23]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1463,7 +1595,7 @@ func (x *SaveTweetRequest) String() string {
 func (*SaveTweetRequest) ProtoMessage() {}
 
 func (x *SaveTweetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[23]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1476,7 +1608,7 @@ func (x *SaveTweetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveTweetRequest.ProtoReflect.Descriptor instead.
 func (*SaveTweetRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{23}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *SaveTweetRequest) GetTweetId() int32 {
@@ -1505,7 +1637,7 @@ type SaveTweetResponse struct {
 func (x *SaveTweetResponse) Reset() {
 	*x = SaveTweetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[24]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1518,7 +1650,7 @@ func (x *SaveTweetResponse) String() string {
 func (*SaveTweetResponse) ProtoMessage() {}
 
 func (x *SaveTweetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[24]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1531,7 +1663,7 @@ func (x *SaveTweetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveTweetResponse.ProtoReflect.Descriptor instead.
 func (*SaveTweetResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{24}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *SaveTweetResponse) GetSuccess() bool {
@@ -1560,7 +1692,7 @@ type RemoveSaveRequest struct {
 func (x *RemoveSaveRequest) Reset() {
 	*x = RemoveSaveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[25]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1573,7 +1705,7 @@ func (x *RemoveSaveRequest) String() string {
 func (*RemoveSaveRequest) ProtoMessage() {}
 
 func (x *RemoveSaveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[25]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1586,7 +1718,7 @@ func (x *RemoveSaveRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveSaveRequest.ProtoReflect.Descriptor instead.
 func (*RemoveSaveRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{25}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *RemoveSaveRequest) GetTweetId() int32 {
@@ -1615,7 +1747,7 @@ type RemoveSaveResponse struct {
 func (x *RemoveSaveResponse) Reset() {
 	*x = RemoveSaveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[26]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1628,7 +1760,7 @@ func (x *RemoveSaveResponse) String() string {
 func (*RemoveSaveResponse) ProtoMessage() {}
 
 func (x *RemoveSaveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[26]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1641,7 +1773,7 @@ func (x *RemoveSaveResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveSaveResponse.ProtoReflect.Descriptor instead.
 func (*RemoveSaveResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{26}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *RemoveSaveResponse) GetSuccess() bool {
@@ -1670,7 +1802,7 @@ type DeleteTweetRequest struct {
 func (x *DeleteTweetRequest) Reset() {
 	*x = DeleteTweetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[27]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1683,7 +1815,7 @@ func (x *DeleteTweetRequest) String() string {
 func (*DeleteTweetRequest) ProtoMessage() {}
 
 func (x *DeleteTweetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[27]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1696,7 +1828,7 @@ func (x *DeleteTweetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTweetRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTweetRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{27}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *DeleteTweetRequest) GetTweetId() int32 {
@@ -1725,7 +1857,7 @@ type DeleteTweetResponse struct {
 func (x *DeleteTweetResponse) Reset() {
 	*x = DeleteTweetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[28]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1738,7 +1870,7 @@ func (x *DeleteTweetResponse) String() string {
 func (*DeleteTweetResponse) ProtoMessage() {}
 
 func (x *DeleteTweetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[28]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1751,7 +1883,7 @@ func (x *DeleteTweetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTweetResponse.ProtoReflect.Descriptor instead.
 func (*DeleteTweetResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{28}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *DeleteTweetResponse) GetSuccess() bool {
@@ -1779,7 +1911,7 @@ type GetTweetByIDRequest struct {
 func (x *GetTweetByIDRequest) Reset() {
 	*x = GetTweetByIDRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[29]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1792,7 +1924,7 @@ func (x *GetTweetByIDRequest) String() string {
 func (*GetTweetByIDRequest) ProtoMessage() {}
 
 func (x *GetTweetByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[29]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1805,7 +1937,7 @@ func (x *GetTweetByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTweetByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetTweetByIDRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{29}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetTweetByIDRequest) GetTweetId() int32 {
@@ -1828,7 +1960,7 @@ type GetTweetByIDResponse struct {
 func (x *GetTweetByIDResponse) Reset() {
 	*x = GetTweetByIDResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[30]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1841,7 +1973,7 @@ func (x *GetTweetByIDResponse) String() string {
 func (*GetTweetByIDResponse) ProtoMessage() {}
 
 func (x *GetTweetByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[30]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1854,7 +1986,7 @@ func (x *GetTweetByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTweetByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetTweetByIDResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{30}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetTweetByIDResponse) GetTweet() *Tweet {
@@ -1878,13 +2010,134 @@ func (x *GetTweetByIDResponse) GetMessage() string {
 	return ""
 }
 
+type GetTweetsByIdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TweetIds []int32 `protobuf:"varint,1,rep,packed,name=tweet_ids,json=tweetIds,proto3" json:"tweet_ids,omitempty"`
+	// Caller; 0 skips the block and privacy checks for internal lookups.
+	ViewerId int32 `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+}
+
+func (x *GetTweetsByIdsRequest) Reset() {
+	*x = GetTweetsByIdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_tweet_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTweetsByIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTweetsByIdsRequest) ProtoMessage() {}
+
+func (x *GetTweetsByIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tweet_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTweetsByIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetTweetsByIdsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetTweetsByIdsRequest) GetTweetIds() []int32 {
+	if x != nil {
+		return x.TweetIds
+	}
+	return nil
+}
+
+func (x *GetTweetsByIdsRequest) GetViewerId() int32 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+// Tweets in the order of the request; unknown tweets and tweets the viewer may
+// not see are skipped.
+type GetTweetsByIdsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Tweets  []*Tweet `protobuf:"bytes,3,rep,name=tweets,proto3" json:"tweets,omitempty"`
+}
+
+func (x *GetTweetsByIdsResponse) Reset() {
+	*x = GetTweetsByIdsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_tweet_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTweetsByIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTweetsByIdsResponse) ProtoMessage() {}
+
+func (x *GetTweetsByIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tweet_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTweetsByIdsResponse.ProtoReflect.Descriptor instead.
+func (*GetTweetsByIdsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetTweetsByIdsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetTweetsByIdsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetTweetsByIdsResponse) GetTweets() []*Tweet {
+	if x != nil {
+		return x.Tweets
+	}
+	return nil
+}
+
 var File_pkg_proto_tweet_proto protoreflect.FileDescriptor
 
 var file_pkg_proto_tweet_proto_rawDesc = []byte{
 	0x0a, 0x15, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x77, 0x65, 0x65,
 	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf2, 0x03, 0x0a, 0x05, 0x54, 0x77, 0x65,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa1, 0x04, 0x0a, 0x05, 0x54, 0x77, 0x65,
 	0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07,
@@ -1915,214 +2168,256 @@ var file_pkg_proto_tweet_proto_rawDesc = []byte{
 	0x76, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x73, 0x61,
 	0x76, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x53, 0x61, 0x76,
 	0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x11,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0x30, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x6c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x74, 0x77, 0x65,
-	0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54, 0x77, 0x65, 0x65,
-	0x74, 0x52, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x30, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x6c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x74, 0x77, 0x65,
-	0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54, 0x77, 0x65, 0x65,
-	0x74, 0x52, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x79, 0x0a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x2d, 0x0a,
+	0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52,
+	0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xcd, 0x02, 0x0a,
+	0x0a, 0x54, 0x77, 0x65, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6c, 0x75,
+	0x72, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6c, 0x75,
+	0x72, 0x68, 0x61, 0x73, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c, 0x74, 0x5f, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x74, 0x54, 0x65, 0x78, 0x74,
+	0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69,
+	0x61, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0a, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3d, 0x0a,
+	0x0f, 0x52, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x30, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6c,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x74, 0x77, 0x65, 0x65,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74,
+	0x52, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x30, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6c,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x74, 0x77, 0x65, 0x65,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74,
+	0x52, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9a, 0x01, 0x0a,
 	0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a,
 	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x1a, 0x0a, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x2b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0x67, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x06, 0x74,
-	0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x31, 0x0a, 0x16, 0x47, 0x65, 0x74,
-	0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x17,
-	0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52,
-	0x06, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x49, 0x0a, 0x12, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x49, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x44, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x4c, 0x69,
-	0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x47,
-	0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x66, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49,
-	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x12, 0x41, 0x64, 0x64,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x69, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74,
-	0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74,
-	0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x4b,
-	0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x45, 0x0a, 0x0f, 0x41,
-	0x64, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
-	0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0x46, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x46, 0x0a, 0x10, 0x53, 0x61,
-	0x76, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
-	0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0x47, 0x0a, 0x11, 0x53, 0x61, 0x76, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x47, 0x0a, 0x11, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x61,
-	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x48,
-	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x64, 0x73, 0x22, 0x49, 0x0a, 0x13, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x2b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x67, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x06,
+	0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x31, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6d, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x74, 0x77, 0x65, 0x65,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74,
+	0x52, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x49, 0x0a, 0x12,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x49, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x44, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12,
 	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x30, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x42,
-	0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77,
-	0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x77,
-	0x65, 0x65, 0x74, 0x49, 0x64, 0x22, 0x68, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65,
-	0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
-	0x05, 0x74, 0x77, 0x65, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54,
-	0x77, 0x65, 0x65, 0x74, 0x52, 0x05, 0x74, 0x77, 0x65, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x4c,
+	0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
 	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32,
-	0x8f, 0x07, 0x0a, 0x0c, 0x54, 0x77, 0x65, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x3a, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12,
-	0x13, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09,
-	0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x54,
-	0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x42,
-	0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74,
-	0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
-	0x2e, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x13, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x13, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x77,
-	0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x2e, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x0f, 0x2e,
-	0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
-	0x2e, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x6b, 0x65,
-	0x12, 0x12, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x6b,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x41,
-	0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x2e, 0x41, 0x64, 0x64, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
-	0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x53, 0x68, 0x61,
-	0x72, 0x65, 0x12, 0x10, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x61, 0x76,
-	0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x11, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x54, 0x77, 0x65,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x53, 0x61, 0x76, 0x65,
-	0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x37, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x61, 0x76, 0x65, 0x12, 0x12, 0x2e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x47, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x66, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x12, 0x41, 0x64,
+	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x69, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22,
+	0x4b, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x45, 0x0a, 0x0f,
+	0x41, 0x64, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x46, 0x0a, 0x10, 0x53,
+	0x61, 0x76, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x11, 0x53, 0x61, 0x76, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x47, 0x0a, 0x11,
 	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54,
-	0x77, 0x65, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x77,
-	0x65, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
-	0x2e, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x61,
-	0x76, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x61, 0x76, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x16,
-	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65,
-	0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x42, 0x0c, 0x5a, 0x0a, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53,
+	0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x48, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x13, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x30, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74,
+	0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74,
+	0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x22, 0x68, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65,
+	0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
+	0x0a, 0x05, 0x74, 0x77, 0x65, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
+	0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x05, 0x74, 0x77, 0x65, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x51, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x42, 0x79, 0x49,
+	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x77, 0x65,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x74, 0x77,
+	0x65, 0x65, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x6c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73,
+	0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1e, 0x0a, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x06, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74,
+	0x73, 0x32, 0xd4, 0x07, 0x0a, 0x0c, 0x54, 0x77, 0x65, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65,
+	0x74, 0x12, 0x13, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34,
+	0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74,
+	0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65,
+	0x65, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x13, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x13, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x12,
+	0x0f, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69,
+	0x6b, 0x65, 0x12, 0x12, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c,
+	0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a,
+	0x0a, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x2e, 0x41, 0x64,
+	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x12, 0x10, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x53,
+	0x61, 0x76, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x11, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x54,
+	0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x53, 0x61,
+	0x76, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x37, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x61, 0x76, 0x65, 0x12,
+	0x12, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x61, 0x76, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x77, 0x65, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x12, 0x16, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x42,
+	0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73,
+	0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x61,
+	0x76, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x54,
+	0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64,
+	0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0c, 0x5a, 0x0a, 0x70, 0x6b, 0x67, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2137,83 +2432,92 @@ func file_pkg_proto_tweet_proto_rawDescGZIP() []byte {
 	return file_pkg_proto_tweet_proto_rawDescData
 }
 
-var file_pkg_proto_tweet_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_pkg_proto_tweet_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_pkg_proto_tweet_proto_goTypes = []any{
 	(*Tweet)(nil),                   // 0: Tweet
-	(*GetSavedTweetsRequest)(nil),   // 1: GetSavedTweetsRequest
-	(*GetSavedTweetsResponse)(nil),  // 2: GetSavedTweetsResponse
-	(*GetLikedTweetsRequest)(nil),   // 3: GetLikedTweetsRequest
-	(*GetLikedTweetsResponse)(nil),  // 4: GetLikedTweetsResponse
-	(*CreateTweetRequest)(nil),      // 5: CreateTweetRequest
-	(*CreateTweetResponse)(nil),     // 6: CreateTweetResponse
-	(*GetTweetsRequest)(nil),        // 7: GetTweetsRequest
-	(*GetTweetsResponse)(nil),       // 8: GetTweetsResponse
-	(*GetTweetsByUserRequest)(nil),  // 9: GetTweetsByUserRequest
-	(*GetTweetsByUserResponse)(nil), // 10: GetTweetsByUserResponse
-	(*UpdateTweetRequest)(nil),      // 11: UpdateTweetRequest
-	(*UpdateTweetResponse)(nil),     // 12: UpdateTweetResponse
-	(*AddLikeRequest)(nil),          // 13: AddLikeRequest
-	(*AddLikeResponse)(nil),         // 14: AddLikeResponse
-	(*RemoveLikeRequest)(nil),       // 15: RemoveLikeRequest
-	(*RemoveLikeResponse)(nil),      // 16: RemoveLikeResponse
-	(*AddCommentRequest)(nil),       // 17: AddCommentRequest
-	(*AddCommentResponse)(nil),      // 18: AddCommentResponse
-	(*RemoveCommentRequest)(nil),    // 19: RemoveCommentRequest
-	(*RemoveCommentResponse)(nil),   // 20: RemoveCommentResponse
-	(*AddShareRequest)(nil),         // 21: AddShareRequest
-	(*AddShareResponse)(nil),        // 22: AddShareResponse
-	(*SaveTweetRequest)(nil),        // 23: SaveTweetRequest
-	(*SaveTweetResponse)(nil),       // 24: SaveTweetResponse
-	(*RemoveSaveRequest)(nil),       // 25: RemoveSaveRequest
-	(*RemoveSaveResponse)(nil),      // 26: RemoveSaveResponse
-	(*DeleteTweetRequest)(nil),      // 27: DeleteTweetRequest
-	(*DeleteTweetResponse)(nil),     // 28: DeleteTweetResponse
-	(*GetTweetByIDRequest)(nil),     // 29: GetTweetByIDRequest
-	(*GetTweetByIDResponse)(nil),    // 30: GetTweetByIDResponse
-	(*timestamppb.Timestamp)(nil),   // 31: google.protobuf.Timestamp
+	(*TweetMedia)(nil),              // 1: TweetMedia
+	(*GetSavedTweetsRequest)(nil),   // 2: GetSavedTweetsRequest
+	(*GetSavedTweetsResponse)(nil),  // 3: GetSavedTweetsResponse
+	(*GetLikedTweetsRequest)(nil),   // 4: GetLikedTweetsRequest
+	(*GetLikedTweetsResponse)(nil),  // 5: GetLikedTweetsResponse
+	(*CreateTweetRequest)(nil),      // 6: CreateTweetRequest
+	(*CreateTweetResponse)(nil),     // 7: CreateTweetResponse
+	(*GetTweetsRequest)(nil),        // 8: GetTweetsRequest
+	(*GetTweetsResponse)(nil),       // 9: GetTweetsResponse
+	(*GetTweetsByUserRequest)(nil),  // 10: GetTweetsByUserRequest
+	(*GetTweetsByUserResponse)(nil), // 11: GetTweetsByUserResponse
+	(*UpdateTweetRequest)(nil),      // 12: UpdateTweetRequest
+	(*UpdateTweetResponse)(nil),     // 13: UpdateTweetResponse
+	(*AddLikeRequest)(nil),          // 14: AddLikeRequest
+	(*AddLikeResponse)(nil),         // 15: AddLikeResponse
+	(*RemoveLikeRequest)(nil),       // 16: RemoveLikeRequest
+	(*RemoveLikeResponse)(nil),      // 17: RemoveLikeResponse
+	(*AddCommentRequest)(nil),       // 18: AddCommentRequest
+	(*AddCommentResponse)(nil),      // 19: AddCommentResponse
+	(*RemoveCommentRequest)(nil),    // 20: RemoveCommentRequest
+	(*RemoveCommentResponse)(nil),   // 21: RemoveCommentResponse
+	(*AddShareRequest)(nil),         // 22: AddShareRequest
+	(*AddShareResponse)(nil),        // 23: AddShareResponse
+	(*SaveTweetRequest)(nil),        // 24: SaveTweetRequest
+	(*SaveTweetResponse)(nil),       // 25: SaveTweetResponse
+	(*RemoveSaveRequest)(nil),       // 26: RemoveSaveRequest
+	(*RemoveSaveResponse)(nil),      // 27: RemoveSaveResponse
+	(*DeleteTweetRequest)(nil),      // 28: DeleteTweetRequest
+	(*DeleteTweetResponse)(nil),     // 29: DeleteTweetResponse
+	(*GetTweetByIDRequest)(nil),     // 30: GetTweetByIDRequest
+	(*GetTweetByIDResponse)(nil),    // 31: GetTweetByIDResponse
+	(*GetTweetsByIdsRequest)(nil),   // 32: GetTweetsByIdsRequest
+	(*GetTweetsByIdsResponse)(nil),  // 33: GetTweetsByIdsResponse
+	nil,                             // 34: TweetMedia.RenditionsEntry
+	(*timestamppb.Timestamp)(nil),   // 35: google.protobuf.Timestamp
 }
 var file_pkg_proto_tweet_proto_depIdxs = []int32{
-	31, // 0: Tweet.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 1: GetSavedTweetsResponse.tweets:type_name -> Tweet
-	0,  // 2: GetLikedTweetsResponse.tweets:type_name -> Tweet
-	0,  // 3: GetTweetsResponse.tweets:type_name -> Tweet
-	0,  // 4: GetTweetsByUserResponse.tweets:type_name -> Tweet
-	0,  // 5: GetTweetByIDResponse.tweet:type_name -> Tweet
-	5,  // 6: TweetService.CreateTweet:input_type -> CreateTweetRequest
-	7,  // 7: TweetService.GetTweets:input_type -> GetTweetsRequest
-	9,  // 8: TweetService.GetTweetsByUser:input_type -> GetTweetsByUserRequest
-	11, // 9: TweetService.UpdateTweet:input_type -> UpdateTweetRequest
-	27, // 10: TweetService.DeleteTweet:input_type -> DeleteTweetRequest
-	13, // 11: TweetService.AddLike:input_type -> AddLikeRequest
-	15, // 12: TweetService.RemoveLike:input_type -> RemoveLikeRequest
-	17, // 13: TweetService.AddComment:input_type -> AddCommentRequest
-	19, // 14: TweetService.RemoveComment:input_type -> RemoveCommentRequest
-	21, // 15: TweetService.AddShare:input_type -> AddShareRequest
-	23, // 16: TweetService.SaveTweet:input_type -> SaveTweetRequest
-	25, // 17: TweetService.RemoveSave:input_type -> RemoveSaveRequest
-	29, // 18: TweetService.GetTweetByID:input_type -> GetTweetByIDRequest
-	1,  // 19: TweetService.GetSavedTweets:input_type -> GetSavedTweetsRequest
-	3,  // 20: TweetService.GetLikedTweets:input_type -> GetLikedTweetsRequest
-	6,  // 21: TweetService.CreateTweet:output_type -> CreateTweetResponse
-	8,  // 22: TweetService.GetTweets:output_type -> GetTweetsResponse
-	10, // 23: TweetService.GetTweetsByUser:output_type -> GetTweetsByUserResponse
-	12, // 24: TweetService.UpdateTweet:output_type -> UpdateTweetResponse
-	28, // 25: TweetService.DeleteTweet:output_type -> DeleteTweetResponse
-	14, // 26: TweetService.AddLike:output_type -> AddLikeResponse
-	16, // 27: TweetService.RemoveLike:output_type -> RemoveLikeResponse
-	18, // 28: TweetService.AddComment:output_type -> AddCommentResponse
-	20, // 29: TweetService.RemoveComment:output_type -> RemoveCommentResponse
-	22, // 30: TweetService.AddShare:output_type -> AddShareResponse
-	24, // 31: TweetService.SaveTweet:output_type -> SaveTweetResponse
-	26, // 32: TweetService.RemoveSave:output_type -> RemoveSaveResponse
-	30, // 33: TweetService.GetTweetByID:output_type -> GetTweetByIDResponse
-	2,  // 34: TweetService.GetSavedTweets:output_type -> GetSavedTweetsResponse
-	4,  // 35: TweetService.GetLikedTweets:output_type -> GetLikedTweetsResponse
-	21, // [21:36] is the sub-list for method output_type
-	6,  // [6:21] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	35, // 0: Tweet.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 1: Tweet.attachments:type_name -> TweetMedia
+	34, // 2: TweetMedia.renditions:type_name -> TweetMedia.RenditionsEntry
+	0,  // 3: GetSavedTweetsResponse.tweets:type_name -> Tweet
+	0,  // 4: GetLikedTweetsResponse.tweets:type_name -> Tweet
+	0,  // 5: GetTweetsResponse.tweets:type_name -> Tweet
+	0,  // 6: GetTweetsByUserResponse.tweets:type_name -> Tweet
+	0,  // 7: GetTweetByIDResponse.tweet:type_name -> Tweet
+	0,  // 8: GetTweetsByIdsResponse.tweets:type_name -> Tweet
+	6,  // 9: TweetService.CreateTweet:input_type -> CreateTweetRequest
+	8,  // 10: TweetService.GetTweets:input_type -> GetTweetsRequest
+	10, // 11: TweetService.GetTweetsByUser:input_type -> GetTweetsByUserRequest
+	12, // 12: TweetService.UpdateTweet:input_type -> UpdateTweetRequest
+	28, // 13: TweetService.DeleteTweet:input_type -> DeleteTweetRequest
+	14, // 14: TweetService.AddLike:input_type -> AddLikeRequest
+	16, // 15: TweetService.RemoveLike:input_type -> RemoveLikeRequest
+	18, // 16: TweetService.AddComment:input_type -> AddCommentRequest
+	20, // 17: TweetService.RemoveComment:input_type -> RemoveCommentRequest
+	22, // 18: TweetService.AddShare:input_type -> AddShareRequest
+	24, // 19: TweetService.SaveTweet:input_type -> SaveTweetRequest
+	26, // 20: TweetService.RemoveSave:input_type -> RemoveSaveRequest
+	30, // 21: TweetService.GetTweetByID:input_type -> GetTweetByIDRequest
+	32, // 22: TweetService.GetTweetsByIds:input_type -> GetTweetsByIdsRequest
+	2,  // 23: TweetService.GetSavedTweets:input_type -> GetSavedTweetsRequest
+	4,  // 24: TweetService.GetLikedTweets:input_type -> GetLikedTweetsRequest
+	7,  // 25: TweetService.CreateTweet:output_type -> CreateTweetResponse
+	9,  // 26: TweetService.GetTweets:output_type -> GetTweetsResponse
+	11, // 27: TweetService.GetTweetsByUser:output_type -> GetTweetsByUserResponse
+	13, // 28: TweetService.UpdateTweet:output_type -> UpdateTweetResponse
+	29, // 29: TweetService.DeleteTweet:output_type -> DeleteTweetResponse
+	15, // 30: TweetService.AddLike:output_type -> AddLikeResponse
+	17, // 31: TweetService.RemoveLike:output_type -> RemoveLikeResponse
+	19, // 32: TweetService.AddComment:output_type -> AddCommentResponse
+	21, // 33: TweetService.RemoveComment:output_type -> RemoveCommentResponse
+	23, // 34: TweetService.AddShare:output_type -> AddShareResponse
+	25, // 35: TweetService.SaveTweet:output_type -> SaveTweetResponse
+	27, // 36: TweetService.RemoveSave:output_type -> RemoveSaveResponse
+	31, // 37: TweetService.GetTweetByID:output_type -> GetTweetByIDResponse
+	33, // 38: TweetService.GetTweetsByIds:output_type -> GetTweetsByIdsResponse
+	3,  // 39: TweetService.GetSavedTweets:output_type -> GetSavedTweetsResponse
+	5,  // 40: TweetService.GetLikedTweets:output_type -> GetLikedTweetsResponse
+	25, // [25:41] is the sub-list for method output_type
+	9,  // [9:25] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_tweet_proto_init() }
@@ -2235,7 +2539,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*GetSavedTweetsRequest); i {
+			switch v := v.(*TweetMedia); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2247,7 +2551,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*GetSavedTweetsResponse); i {
+			switch v := v.(*GetSavedTweetsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2259,7 +2563,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*GetLikedTweetsRequest); i {
+			switch v := v.(*GetSavedTweetsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2271,7 +2575,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*GetLikedTweetsResponse); i {
+			switch v := v.(*GetLikedTweetsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2283,7 +2587,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateTweetRequest); i {
+			switch v := v.(*GetLikedTweetsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2295,7 +2599,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateTweetResponse); i {
+			switch v := v.(*CreateTweetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2307,7 +2611,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*GetTweetsRequest); i {
+			switch v := v.(*CreateTweetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2319,7 +2623,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*GetTweetsResponse); i {
+			switch v := v.(*GetTweetsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2331,7 +2635,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*GetTweetsByUserRequest); i {
+			switch v := v.(*GetTweetsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2343,7 +2647,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*GetTweetsByUserResponse); i {
+			switch v := v.(*GetTweetsByUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2355,7 +2659,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateTweetRequest); i {
+			switch v := v.(*GetTweetsByUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2367,7 +2671,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateTweetResponse); i {
+			switch v := v.(*UpdateTweetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2379,7 +2683,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*AddLikeRequest); i {
+			switch v := v.(*UpdateTweetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2391,7 +2695,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*AddLikeResponse); i {
+			switch v := v.(*AddLikeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2403,7 +2707,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*RemoveLikeRequest); i {
+			switch v := v.(*AddLikeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2415,7 +2719,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*RemoveLikeResponse); i {
+			switch v := v.(*RemoveLikeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2427,7 +2731,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*AddCommentRequest); i {
+			switch v := v.(*RemoveLikeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2439,7 +2743,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*AddCommentResponse); i {
+			switch v := v.(*AddCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2451,7 +2755,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*RemoveCommentRequest); i {
+			switch v := v.(*AddCommentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2463,7 +2767,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*RemoveCommentResponse); i {
+			switch v := v.(*RemoveCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2475,7 +2779,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*AddShareRequest); i {
+			switch v := v.(*RemoveCommentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2487,7 +2791,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*AddShareResponse); i {
+			switch v := v.(*AddShareRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2499,7 +2803,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*SaveTweetRequest); i {
+			switch v := v.(*AddShareResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2511,7 +2815,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*SaveTweetResponse); i {
+			switch v := v.(*SaveTweetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2523,7 +2827,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*RemoveSaveRequest); i {
+			switch v := v.(*SaveTweetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2535,7 +2839,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*RemoveSaveResponse); i {
+			switch v := v.(*RemoveSaveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2547,7 +2851,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteTweetRequest); i {
+			switch v := v.(*RemoveSaveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2559,7 +2863,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[28].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteTweetResponse); i {
+			switch v := v.(*DeleteTweetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2571,7 +2875,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[29].Exporter = func(v any, i int) any {
-			switch v := v.(*GetTweetByIDRequest); i {
+			switch v := v.(*DeleteTweetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2583,6 +2887,18 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTweetByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_tweet_proto_msgTypes[31].Exporter = func(v any, i int) any {
 			switch v := v.(*GetTweetByIDResponse); i {
 			case 0:
 				return &v.state
@@ -2594,6 +2910,30 @@ func file_pkg_proto_tweet_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_proto_tweet_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTweetsByIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_tweet_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTweetsByIdsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2601,7 +2941,7 @@ func file_pkg_proto_tweet_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_proto_tweet_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   31,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
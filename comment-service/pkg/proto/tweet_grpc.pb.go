@@ -32,6 +32,7 @@ const (
 	TweetService_SaveTweet_FullMethodName       = "/TweetService/SaveTweet"
 	TweetService_RemoveSave_FullMethodName      = "/TweetService/RemoveSave"
 	TweetService_GetTweetByID_FullMethodName    = "/TweetService/GetTweetByID"
+	TweetService_GetTweetsByIds_FullMethodName  = "/TweetService/GetTweetsByIds"
 	TweetService_GetSavedTweets_FullMethodName  = "/TweetService/GetSavedTweets"
 	TweetService_GetLikedTweets_FullMethodName  = "/TweetService/GetLikedTweets"
 )
@@ -53,6 +54,7 @@ type TweetServiceClient interface {
 	SaveTweet(ctx context.Context, in *SaveTweetRequest, opts ...grpc.CallOption) (*SaveTweetResponse, error)
 	RemoveSave(ctx context.Context, in *RemoveSaveRequest, opts ...grpc.CallOption) (*RemoveSaveResponse, error)
 	GetTweetByID(ctx context.Context, in *GetTweetByIDRequest, opts ...grpc.CallOption) (*GetTweetByIDResponse, error)
+	GetTweetsByIds(ctx context.Context, in *GetTweetsByIdsRequest, opts ...grpc.CallOption) (*GetTweetsByIdsResponse, error)
 	GetSavedTweets(ctx context.Context, in *GetSavedTweetsRequest, opts ...grpc.CallOption) (*GetSavedTweetsResponse, error)
 	GetLikedTweets(ctx context.Context, in *GetLikedTweetsRequest, opts ...grpc.CallOption) (*GetLikedTweetsResponse, error)
 }
@@ -195,6 +197,16 @@ func (c *tweetServiceClient) GetTweetByID(ctx context.Context, in *GetTweetByIDR
 	return out, nil
 }
 
+func (c *tweetServiceClient) GetTweetsByIds(ctx context.Context, in *GetTweetsByIdsRequest, opts ...grpc.CallOption) (*GetTweetsByIdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTweetsByIdsResponse)
+	err := c.cc.Invoke(ctx, TweetService_GetTweetsByIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tweetServiceClient) GetSavedTweets(ctx context.Context, in *GetSavedTweetsRequest, opts ...grpc.CallOption) (*GetSavedTweetsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetSavedTweetsResponse)
@@ -232,6 +244,7 @@ type TweetServiceServer interface {
 	SaveTweet(context.Context, *SaveTweetRequest) (*SaveTweetResponse, error)
 	RemoveSave(context.Context, *RemoveSaveRequest) (*RemoveSaveResponse, error)
 	GetTweetByID(context.Context, *GetTweetByIDRequest) (*GetTweetByIDResponse, error)
+	GetTweetsByIds(context.Context, *GetTweetsByIdsRequest) (*GetTweetsByIdsResponse, error)
 	GetSavedTweets(context.Context, *GetSavedTweetsRequest) (*GetSavedTweetsResponse, error)
 	GetLikedTweets(context.Context, *GetLikedTweetsRequest) (*GetLikedTweetsResponse, error)
 	mustEmbedUnimplementedTweetServiceServer()
@@ -283,6 +296,9 @@ func (UnimplementedTweetServiceServer) RemoveSave(context.Context, *RemoveSaveRe
 func (UnimplementedTweetServiceServer) GetTweetByID(context.Context, *GetTweetByIDRequest) (*GetTweetByIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTweetByID not implemented")
 }
+func (UnimplementedTweetServiceServer) GetTweetsByIds(context.Context, *GetTweetsByIdsRequest) (*GetTweetsByIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTweetsByIds not implemented")
+}
 func (UnimplementedTweetServiceServer) GetSavedTweets(context.Context, *GetSavedTweetsRequest) (*GetSavedTweetsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSavedTweets not implemented")
 }
@@ -544,6 +560,24 @@ func _TweetService_GetTweetByID_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TweetService_GetTweetsByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTweetsByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TweetServiceServer).GetTweetsByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TweetService_GetTweetsByIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TweetServiceServer).GetTweetsByIds(ctx, req.(*GetTweetsByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TweetService_GetSavedTweets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSavedTweetsRequest)
 	if err := dec(in); err != nil {
@@ -639,6 +673,10 @@ var TweetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTweetByID",
 			Handler:    _TweetService_GetTweetByID_Handler,
 		},
+		{
+			MethodName: "GetTweetsByIds",
+			Handler:    _TweetService_GetTweetsByIds_Handler,
+		},
 		{
 			MethodName: "GetSavedTweets",
 			Handler:    _TweetService_GetSavedTweets_Handler,
@@ -5,6 +5,8 @@ import (
 	"direct-service/internal/models"
 	"direct-service/internal/service"
 	pb "direct-service/pkg/proto"
+	"pkg/pagination"
+	"pkg/principal"
 )
 
 type DirectHandler struct {
@@ -17,12 +19,15 @@ func NewDirectHandler(service service.DirectService) *DirectHandler {
 }
 
 func (h *DirectHandler) CreateDirectMessage(ctx context.Context, req *pb.CreateDirectMessageRequest) (*pb.CreateDirectMessageResponse, error) {
+	if err := checkCaller(ctx, req.SenderId); err != nil {
+		return nil, err
+	}
 	message := &models.DirectMessage{
 		SenderID:   int64(req.SenderId),
 		ReceiverID: int64(req.ReceiverId),
 		TweetID:    int64(req.TweetId),
 		Text:       req.Text,
-		Media:      req.Media,
+		MediaIDs:   req.MediaIds,
 	}
 	createdMessage, err := h.service.CreateDirectMessage(ctx, message)
 	if err != nil {
@@ -38,13 +43,21 @@ func (h *DirectHandler) CreateDirectMessage(ctx context.Context, req *pb.CreateD
 			TweetId:    int64(createdMessage.TweetID),
 			Text:       createdMessage.Text,
 			Media:      createdMessage.Media,
+			Attachments: attachmentsToProto(createdMessage.Attachments),
 			CreatedAt:  createdMessage.CreatedAt.Format("2006-01-02 15:04:05"),
 		},
 	}, nil
 }
 
 func (h *DirectHandler) GetDirectMessages(ctx context.Context, req *pb.GetDirectMessagesRequest) (*pb.GetDirectMessagesResponse, error) {
-	directMessages, err := h.service.GetDirectMessages(ctx, int64(req.SenderId), int64(req.ReceiverId))
+	if err := checkCaller(ctx, req.SenderId); err != nil {
+		return nil, err
+	}
+	page, err := pagination.New(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	directMessages, next, err := h.service.GetDirectMessages(ctx, int64(req.SenderId), int64(req.ReceiverId), page)
 	if err != nil {
 		return nil, err
 	}
@@ -57,6 +70,7 @@ func (h *DirectHandler) GetDirectMessages(ctx context.Context, req *pb.GetDirect
 			TweetId:    int64(message.TweetID),
 			Text:       message.Text,
 			Media:      message.Media,
+			Attachments: attachmentsToProto(message.Attachments),
 			CreatedAt:  message.CreatedAt.Format("2006-01-02 15:04:05"),
 		}
 	}
@@ -64,11 +78,15 @@ func (h *DirectHandler) GetDirectMessages(ctx context.Context, req *pb.GetDirect
 		Success: true,
 		Message: "Direct messages fetched successfully",
 		DirectMessages: protoDirectMessages,
+		NextPageToken: next,
 	}, nil
 }
 
 func (h *DirectHandler) GetDirectMessageByID(ctx context.Context, req *pb.GetDirectMessageByIDRequest) (*pb.GetDirectMessageByIDResponse, error) {
-	directMessage, err := h.service.GetDirectMessageByID(ctx, int64(req.Id))
+	if err := checkCaller(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	directMessage, err := h.service.GetDirectMessageByID(ctx, int64(req.Id), req.UserId)
 	if err != nil {
 		return nil, err
 	}
@@ -82,13 +100,17 @@ func (h *DirectHandler) GetDirectMessageByID(ctx context.Context, req *pb.GetDir
 			TweetId:    int64(directMessage.TweetID),
 			Text:       directMessage.Text,
 			Media:      directMessage.Media,
+			Attachments: attachmentsToProto(directMessage.Attachments),
 			CreatedAt:  directMessage.CreatedAt.Format("2006-01-02 15:04:05"),
 		},
 	}, nil
 }
 
 func (h *DirectHandler) DeleteDirectMessage(ctx context.Context, req *pb.DeleteDirectMessageRequest) (*pb.DeleteDirectMessageResponse, error) {
-	success, err := h.service.DeleteDirectMessage(ctx, int64(req.Id))
+	if err := checkCaller(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	success, err := h.service.DeleteDirectMessage(ctx, int64(req.Id), req.UserId)
 	if err != nil {
 		return nil, err
 	}
@@ -97,3 +119,34 @@ func (h *DirectHandler) DeleteDirectMessage(ctx context.Context, req *pb.DeleteD
 		Message: "Direct message deleted successfully",
 	}, nil
 }
+
+func attachmentsToProto(media []models.Media) []*pb.DirectMedia {
+	attachments := make([]*pb.DirectMedia, len(media))
+	for i, m := range media {
+		attachments[i] = &pb.DirectMedia{
+			Id:         m.ID,
+			Url:        m.URL,
+			MimeType:   m.MimeType,
+			Width:      m.Width,
+			Height:     m.Height,
+			DurationMs: m.DurationMS,
+			Blurhash:   m.Blurhash,
+			AltText:    m.AltText,
+			Renditions: m.Renditions,
+		}
+	}
+	return attachments
+}
+
+// checkCaller xabarlar faqat gateway uzatgan foydalanuvchi nomidan yuborilishi
+// va o'qilishini tekshiradi. x-user-id bo'lmasa so'rov rad etiladi.
+func checkCaller(ctx context.Context, userID int64) error {
+	callerID, ok := principal.CallerID(ctx)
+	if !ok {
+		return service.ErrUnauthenticated
+	}
+	if callerID != userID {
+		return service.ErrNotParticipant
+	}
+	return nil
+}
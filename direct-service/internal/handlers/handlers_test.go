@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"direct-service/internal/service"
+	pb "direct-service/pkg/proto"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// Rad etilgan so'rovlar bazaga yetib bormaydi, shuning uchun db kerak emas
+func TestDirectMessagesCheckCaller(t *testing.T) {
+	h := NewDirectHandler(*service.NewDirectService(nil, nil))
+	calls := map[string]func(context.Context) error{
+		"CreateDirectMessage": func(ctx context.Context) error {
+			_, err := h.CreateDirectMessage(ctx, &pb.CreateDirectMessageRequest{SenderId: 1, ReceiverId: 3, Text: "hi"})
+			return err
+		},
+		"GetDirectMessages": func(ctx context.Context) error {
+			_, err := h.GetDirectMessages(ctx, &pb.GetDirectMessagesRequest{SenderId: 1, ReceiverId: 3})
+			return err
+		},
+		"GetDirectMessageByID": func(ctx context.Context) error {
+			_, err := h.GetDirectMessageByID(ctx, &pb.GetDirectMessageByIDRequest{Id: 10, UserId: 1})
+			return err
+		},
+		"DeleteDirectMessage": func(ctx context.Context) error {
+			_, err := h.DeleteDirectMessage(ctx, &pb.DeleteDirectMessageRequest{Id: 10, UserId: 1})
+			return err
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			if err := call(context.Background()); !errors.Is(err, service.ErrUnauthenticated) {
+				t.Errorf("missing caller: err = %v, want %v", err, service.ErrUnauthenticated)
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "2"))
+			if err := call(ctx); !errors.Is(err, service.ErrNotParticipant) {
+				t.Errorf("another user: err = %v, want %v", err, service.ErrNotParticipant)
+			}
+		})
+	}
+}
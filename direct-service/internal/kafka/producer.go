@@ -2,11 +2,15 @@ package kafka
 
 import (
 	"context"
+	"direct-service/config"
+	"direct-service/internal/models"
 	"encoding/json"
 	"fmt"
+	"log/slog"
+	"pkg/metrics"
+	"pkg/requestid"
+	"pkg/tracing"
 	"strconv"
-	"direct-service/config"
-	"direct-service/internal/models"
 
 	"github.com/segmentio/kafka-go"
 )
@@ -27,7 +31,7 @@ func PublishDirectMessage(ctx context.Context, topic string, directMessage model
 
 	defer func() {
 		if err := producer.Close(); err != nil {
-			fmt.Printf("error closing kafka writer: %v\n", err)
+			slog.ErrorContext(ctx, "failed to close kafka writer", "error", err)
 		}
 	}()
 
@@ -40,7 +44,14 @@ func PublishDirectMessage(ctx context.Context, topic string, directMessage model
 		Key:   []byte(strconv.Itoa(int(directMessage.SenderID))),
 		Value: valueBytes,
 	}
-	if err := producer.WriteMessages(ctx, msg); err != nil {
+	if id := requestid.FromContext(ctx); id != "" {
+		msg.Headers = append(msg.Headers, kafka.Header{Key: requestid.Header, Value: []byte(id)})
+	}
+	ctx, span := tracing.StartProducerSpan(ctx, producer.Topic, &msg)
+	err = producer.WriteMessages(ctx, msg)
+	tracing.End(span, err)
+	metrics.KafkaProduced(producer.Topic, err)
+	if err != nil {
 		return fmt.Errorf("error writing message to kafka: %v", err)
 	}
 
@@ -10,5 +10,21 @@ type DirectMessage struct {
 	Text string `json:"text"`
 	Media []string `json:"media"`
 	CreatedAt time.Time `json:"created_at"`
+	MediaIDs []string `json:"media_ids"`
+	Attachments []Media `json:"attachments"`
+}
+
+// Media xabarga biriktirilgan fayl, user-service dagi yozuvdan. Media
+// maydonida eski xabarlarning URL lari va shu fayllarning URL lari bo'ladi.
+type Media struct {
+	ID string `json:"id"`
+	URL string `json:"url"`
+	MimeType string `json:"mime_type"`
+	Width int32 `json:"width"`
+	Height int32 `json:"height"`
+	DurationMS int64 `json:"duration_ms"`
+	Blurhash string `json:"blurhash"`
+	AltText string `json:"alt_text"`
+	Renditions map[string]string `json:"renditions"`
 }
 
@@ -2,7 +2,8 @@ package redis
 
 import (
 	"direct-service/config"
-	"log"
+	"log/slog"
+	"pkg/logger"
 
 	"github.com/go-redis/redis/v8"			
 )
@@ -10,13 +11,13 @@ import (
 func NewRedisClient() *redis.Client {
 	conf, err := config.LoadConfig()
 	if err != nil {
-		log.Fatal(err)
+		logger.Fatal("failed to load config", "error", err)
 	}
 
 	client := redis.NewClient(&redis.Options{
 		Addr: conf.REDIS_HOST + ":" + conf.REDIS_PORT,
 	})
 
-	log.Println("Connected to Redis")
+	slog.Info("connected to redis")
 	return client
 }
@@ -0,0 +1,15 @@
+package service
+
+import "pkg/grpcerr"
+
+// Servis xatolari. Reason qiymatlari gateway javobida "code" bo'lib chiqadi.
+var (
+	ErrUnauthenticated = grpcerr.Unauthenticated("UNAUTHENTICATED", "caller is not authenticated")
+	ErrMessageNotFound = grpcerr.NotFound("MESSAGE_NOT_FOUND", "direct message not found")
+	ErrPrivateAccount  = grpcerr.PermissionDenied("PRIVATE_ACCOUNT", "user is private and you are not following")
+	ErrBlockedByUser   = grpcerr.PermissionDenied("BLOCKED_BY_USER", "user is blocked")
+	ErrNotParticipant  = grpcerr.PermissionDenied("NOT_MESSAGE_PARTICIPANT", "you are not a participant of this message")
+	ErrNotMessageOwner = grpcerr.PermissionDenied("NOT_MESSAGE_OWNER", "only the sender can delete this message")
+	ErrTooManyMedia    = grpcerr.InvalidArgument("TOO_MANY_MEDIA", "media_ids", "a message can have at most 4 media")
+	ErrMediaNotFound   = grpcerr.NotFound("MEDIA_NOT_FOUND", "media not found")
+)
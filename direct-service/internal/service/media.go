@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"direct-service/internal/methods"
+	"direct-service/internal/models"
+	"direct-service/pkg/proto"
+	"log/slog"
+)
+
+// MaxMessageMedia bitta xabardagi media soni chegarasi
+const MaxMessageMedia = 4
+
+// checkMedia media yuboruvchiga tegishli va biriktirilgan ekanini tekshiradi,
+// ularni ids tartibida qaytaradi. Gateway media ni xabar uchun biriktiradi;
+// tekshiruv servis to'g'ridan-to'g'ri chaqirilganda begona media ishlatilmasligi uchun.
+func checkMedia(ctx context.Context, senderID int64, ids []string) ([]*proto.Media, error) {
+	if len(ids) > MaxMessageMedia {
+		return nil, ErrTooManyMedia
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	resp, err := methods.ConnectUser().GetMediaByIds(ctx, &proto.GetMediaByIdsRequest{Ids: ids})
+	if err != nil {
+		return nil, err
+	}
+	// takrorlangan ID bir marta qaytadi
+	if len(resp.Media) != len(ids) {
+		return nil, ErrMediaNotFound
+	}
+	for _, m := range resp.Media {
+		if int64(m.OwnerId) != senderID || !m.Attached {
+			return nil, ErrMediaNotFound
+		}
+	}
+	return resp.Media, nil
+}
+
+// releaseMedia o'chirilgan xabarning media larini ajratadi: gateway ularni
+// biriktirilmagan media kabi o'chiradi va yuboruvchining kvotasi bo'shaydi.
+// Xabar allaqachon o'chirilgan, shuning uchun xato faqat yoziladi.
+func releaseMedia(ctx context.Context, senderID int64, ids []string) {
+	if len(ids) == 0 {
+		return
+	}
+	_, err := methods.ConnectUser().DetachMedia(ctx, &proto.DetachMediaRequest{UserId: int32(senderID), Ids: ids})
+	if err != nil {
+		slog.WarnContext(ctx, "failed to release message media", "user_id", senderID, "error", err)
+	}
+}
+
+// attachMedia xabarlardagi media ID larini bitta GetMediaByIds so'rovi bilan
+// URL va tavsifga aylantiradi. user-service javob bermasa xabarlar media siz
+// qaytariladi, o'chirilgan media esa tashlab ketiladi.
+func attachMedia(ctx context.Context, messages ...*models.DirectMessage) {
+	var ids []string
+	for _, message := range messages {
+		ids = append(ids, message.MediaIDs...)
+	}
+	if len(ids) == 0 {
+		return
+	}
+	resp, err := methods.ConnectUser().GetMediaByIds(ctx, &proto.GetMediaByIdsRequest{Ids: ids})
+	if err != nil {
+		slog.WarnContext(ctx, "failed to get message media", "error", err)
+		return
+	}
+	byID := make(map[string]*proto.Media, len(resp.Media))
+	for _, m := range resp.Media {
+		byID[m.Id] = m
+	}
+	for _, message := range messages {
+		media := make([]*proto.Media, 0, len(message.MediaIDs))
+		for _, id := range message.MediaIDs {
+			if m, ok := byID[id]; ok {
+				media = append(media, m)
+			}
+		}
+		setMedia(message, media)
+	}
+}
+
+// setMedia media ni xabarga qo'shadi, URL lari eski xabarlardagi kabi Media da ham bo'ladi
+func setMedia(message *models.DirectMessage, media []*proto.Media) {
+	for _, m := range media {
+		message.Media = append(message.Media, m.Url)
+		message.Attachments = append(message.Attachments, models.Media{
+			ID:         m.Id,
+			URL:        m.Url,
+			MimeType:   m.MimeType,
+			Width:      m.Width,
+			Height:     m.Height,
+			DurationMS: m.DurationMs,
+			Blurhash:   m.Blurhash,
+			AltText:    m.AltText,
+			Renditions: m.Renditions,
+		})
+	}
+}
@@ -8,8 +8,11 @@ import (
 	"direct-service/internal/models"
 	"direct-service/pkg/proto"
 	"direct-service/utils"
-	"errors"
 	"github.com/go-redis/redis/v8"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"pkg/pagination"
 )
 
 type DirectService struct {
@@ -21,31 +24,50 @@ func NewDirectService(db *sql.DB, redisClient *redis.Client) *DirectService {
 	return &DirectService{db: db, RedisClient: redisClient}
 }
 
+// getParticipant userID ni otherID nomidan oladi. user-service GetUser javobida
+// blocked_users bo'lmaydi: otherID ni bloklagan foydalanuvchini u topilmagan deb
+// qaytaradi, shuning uchun blok shu yerda ErrBlockedByUser ga aylanadi.
+func getParticipant(ctx context.Context, userID, otherID int32) (*proto.User, error) {
+	users := methods.ConnectUser()
+	resp, err := users.GetUser(ctx, &proto.GetUserRequest{UserId: userID, ViewerId: otherID})
+	if status.Code(err) == codes.NotFound {
+		// foydalanuvchi mavjud bo'lsa, u otherID ni bloklagan
+		if _, lookupErr := users.GetUser(ctx, &proto.GetUserRequest{UserId: userID}); lookupErr == nil {
+			return nil, ErrBlockedByUser
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp.User, nil
+}
+
 func (s *DirectService) CreateDirectMessage(ctx context.Context, message *models.DirectMessage) (*models.DirectMessage, error) {
-	query := `INSERT INTO directs (sender_id, receiver_id, tweet_id, text, media) VALUES ($1, $2, $3, $4, $5) RETURNING *`
-	user := methods.ConnectUser()
-	sender, err := user.GetUser(ctx, &proto.GetUserRequest{UserId: int32(message.SenderID)})
+	query := `INSERT INTO directs (sender_id, receiver_id, tweet_id, text, media, media_ids) VALUES ($1, $2, $3, $4, '{}', $5) RETURNING *`
+	media, err := checkMedia(ctx, message.SenderID, message.MediaIDs)
+	if err != nil {
+		return nil, err
+	}
+	// ikkalasidan biri ikkinchisini bloklagan bo'lsa xabar yuborilmaydi
+	sender, err := getParticipant(ctx, int32(message.SenderID), int32(message.ReceiverID))
 	if err != nil {
 		return nil, err
 	}
-	receiver, err := user.GetUser(ctx, &proto.GetUserRequest{UserId: int32(message.ReceiverID)})
+	receiver, err := getParticipant(ctx, int32(message.ReceiverID), int32(message.SenderID))
 	if err != nil {
 		return nil, err
 	}
 
-	if sender.User.IsPrivate && sender.User.Id != receiver.User.Id {
-		if !utils.InSlice(sender.User.Followers, int32(receiver.User.Id)) {
-			return nil, errors.New("user is private and you are not following")
+	if sender.IsPrivate && sender.Id != receiver.Id {
+		if !utils.InSlice(sender.Followers, receiver.Id) {
+			return nil, ErrPrivateAccount
 		}
 	}
-	if receiver.User.IsPrivate && sender.User.Id != receiver.User.Id {
-		if !utils.InSlice(receiver.User.Followers, int32(sender.User.Id)) {
-			return nil, errors.New("user is private and you are not following")
+	if receiver.IsPrivate && sender.Id != receiver.Id {
+		if !utils.InSlice(receiver.Followers, sender.Id) {
+			return nil, ErrPrivateAccount
 		}
 	}
-	if !utils.InSlice(sender.User.BlockedUsers, int32(receiver.User.Id)) || !utils.InSlice(receiver.User.BlockedUsers, int32(sender.User.Id)) {
-		return nil, errors.New("user is blocked")
-	}
 	tweet := methods.ConnectTweet()
 	if message.TweetID != 0 {
 		_, err = tweet.GetTweetByID(ctx, &proto.GetTweetByIDRequest{TweetId: int32(message.TweetID)})
@@ -57,10 +79,11 @@ func (s *DirectService) CreateDirectMessage(ctx context.Context, message *models
 			return nil, err
 		}
 	}
-	err = s.db.QueryRowContext(ctx, query, message.SenderID, message.ReceiverID, message.TweetID, message.Text, message.Media).Scan(&message.ID, &message.SenderID, &message.ReceiverID, &message.TweetID, &message.Text, &message.Media, &message.CreatedAt)
+	err = s.db.QueryRowContext(ctx, query, message.SenderID, message.ReceiverID, message.TweetID, message.Text, pq.Array(message.MediaIDs)).Scan(&message.ID, &message.SenderID, &message.ReceiverID, &message.TweetID, &message.Text, &message.Media, &message.CreatedAt, pq.Array(&message.MediaIDs))
 	if err != nil {
 		return nil, err
 	}
+	setMedia(message, media)
 	err = kafka.PublishDirectMessage(ctx, "direct-message", *message)
 	if err != nil {
 		return nil, err
@@ -68,53 +91,89 @@ func (s *DirectService) CreateDirectMessage(ctx context.Context, message *models
 	return message, nil
 }
 
-func (s *DirectService) GetDirectMessages(ctx context.Context, senderID, receiverID int64) ([]*models.DirectMessage, error) {
-	query := `SELECT * FROM directs WHERE sender_id = $1 AND receiver_id = $2 OR sender_id = $2 AND receiver_id = $1`
+func (s *DirectService) GetDirectMessages(ctx context.Context, senderID, receiverID int64, page pagination.Page) ([]*models.DirectMessage, string, error) {
+	query := `
+		SELECT * FROM directs
+		WHERE (sender_id = $1 AND receiver_id = $2 OR sender_id = $2 AND receiver_id = $1)
+		AND (created_at, id) < ($3, $4)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $5
+	`
 	user := methods.ConnectUser()
 	_, err := user.GetUser(ctx, &proto.GetUserRequest{UserId: int32(senderID)})
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	_, err = user.GetUser(ctx, &proto.GetUserRequest{UserId: int32(receiverID)})
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	rows, err := s.db.QueryContext(ctx, query, senderID, receiverID)
+	rows, err := s.db.QueryContext(ctx, query, senderID, receiverID, page.After.CreatedAt, page.After.ID, page.Limit())
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	defer rows.Close()
 
 	var messages []*models.DirectMessage
 	for rows.Next() {
 		var message models.DirectMessage
-		err := rows.Scan(&message.ID, &message.SenderID, &message.ReceiverID, &message.TweetID, &message.Text, &message.Media, &message.CreatedAt)
+		err := rows.Scan(&message.ID, &message.SenderID, &message.ReceiverID, &message.TweetID, &message.Text, &message.Media, &message.CreatedAt, pq.Array(&message.MediaIDs))
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		messages = append(messages, &message)
 	}
 	if err := rows.Err(); err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	return messages, nil
+	messages, next := pagination.Trim(messages, page, func(message *models.DirectMessage) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: message.CreatedAt, ID: message.ID}
+	})
+	attachMedia(ctx, messages...)
+	return messages, next, nil
 }
 
-func (s *DirectService) GetDirectMessageByID(ctx context.Context, id int64) (*models.DirectMessage, error) {
+// GetDirectMessageByID xabarni qaytaradi. userID berilsa (gateway so'rovlari) u
+// xabarning yuboruvchisi yoki qabul qiluvchisi bo'lishi kerak.
+func (s *DirectService) GetDirectMessageByID(ctx context.Context, id, userID int64) (*models.DirectMessage, error) {
 	query := `SELECT * FROM directs WHERE id = $1`
 	var message models.DirectMessage
-	err := s.db.QueryRowContext(ctx, query, id).Scan(&message.ID, &message.SenderID, &message.ReceiverID, &message.TweetID, &message.Text, &message.Media, &message.CreatedAt)
+	err := s.db.QueryRowContext(ctx, query, id).Scan(&message.ID, &message.SenderID, &message.ReceiverID, &message.TweetID, &message.Text, &message.Media, &message.CreatedAt, pq.Array(&message.MediaIDs))
+	if err == sql.ErrNoRows {
+		return nil, ErrMessageNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
+	if userID != 0 && message.SenderID != userID && message.ReceiverID != userID {
+		return nil, ErrNotParticipant
+	}
+	attachMedia(ctx, &message)
 	return &message, nil
 }
 
-func (s *DirectService) DeleteDirectMessage(ctx context.Context, id int64) (bool, error) {
-	query := `DELETE FROM directs WHERE id = $1`
-	_, err := s.db.ExecContext(ctx, query, id)
+// DeleteDirectMessage xabarni o'chiradi va uning media larini bo'shatadi. userID
+// berilsa faqat yuboruvchi o'chira oladi.
+func (s *DirectService) DeleteDirectMessage(ctx context.Context, id, userID int64) (bool, error) {
+	if userID != 0 {
+		message, err := s.GetDirectMessageByID(ctx, id, 0)
+		if err != nil {
+			return false, err
+		}
+		if message.SenderID != userID {
+			return false, ErrNotMessageOwner
+		}
+	}
+	query := `DELETE FROM directs WHERE id = $1 RETURNING sender_id, media_ids`
+	var senderID int64
+	var mediaIDs []string
+	err := s.db.QueryRowContext(ctx, query, id).Scan(&senderID, pq.Array(&mediaIDs))
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
 	if err != nil {
 		return false, err
 	}
+	releaseMedia(ctx, senderID, mediaIDs)
 	return true, nil
 }
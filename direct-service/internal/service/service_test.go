@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"direct-service/internal/methods"
+	"direct-service/pkg/proto"
+	"direct-service/utils"
+	"pkg/grpcerr"
+
+	"google.golang.org/grpc"
+)
+
+// fakeUsers user-service kabi: viewer ni bloklagan foydalanuvchi topilmaydi
+type fakeUsers struct {
+	proto.UserServiceClient
+	blocked map[int32][]int32
+	// detached DetachMedia ga kelgan egasi va media ID lari
+	detached map[int32][]string
+}
+
+func (f *fakeUsers) GetUser(ctx context.Context, req *proto.GetUserRequest, opts ...grpc.CallOption) (*proto.GetUserResponse, error) {
+	blocked, ok := f.blocked[req.UserId]
+	if !ok || (req.ViewerId != 0 && req.ViewerId != req.UserId && utils.InSlice(blocked, req.ViewerId)) {
+		return nil, grpcerr.NotFound("USER_NOT_FOUND", "user not found")
+	}
+	return &proto.GetUserResponse{User: &proto.User{Id: req.UserId}}, nil
+}
+
+func (f *fakeUsers) DetachMedia(ctx context.Context, req *proto.DetachMediaRequest, opts ...grpc.CallOption) (*proto.DetachMediaResponse, error) {
+	f.detached[req.UserId] = append(f.detached[req.UserId], req.Ids...)
+	return &proto.DetachMediaResponse{Success: true}, nil
+}
+
+func TestReleaseMedia(t *testing.T) {
+	users := &fakeUsers{detached: make(map[int32][]string)}
+	methods.SetUserClient(users)
+
+	releaseMedia(context.Background(), 2, nil)
+	releaseMedia(context.Background(), 2, []string{"m1", "m2"})
+	// media yuboruvchiniki, u ajratilgach gateway o'chiradi va kvota bo'shaydi
+	if len(users.detached) != 1 || len(users.detached[2]) != 2 {
+		t.Errorf("detached media = %v, want [m1 m2] of user 2", users.detached)
+	}
+}
+
+func TestGetParticipant(t *testing.T) {
+	// 1 foydalanuvchi 2 ni bloklagan
+	methods.SetUserClient(&fakeUsers{blocked: map[int32][]int32{1: {2}, 2: {}, 3: {}}})
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		user, other  int32
+		wantErr      error
+		wantNotFound bool
+	}{
+		{"not blocked", 1, 3, nil, false},
+		{"blocked the other user", 1, 2, ErrBlockedByUser, false},
+		{"was blocked by the other user", 2, 1, nil, false},
+		{"self", 1, 1, nil, false},
+		{"missing user", 9, 1, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, err := getParticipant(ctx, tt.user, tt.other)
+			if tt.wantNotFound {
+				if err == nil || errors.Is(err, ErrBlockedByUser) {
+					t.Errorf("err = %v, want not found", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && user.Id != tt.user {
+				t.Errorf("user = %v", user)
+			}
+		})
+	}
+}
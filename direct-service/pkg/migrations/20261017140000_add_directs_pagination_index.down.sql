@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_directs_sender_receiver_created_at;
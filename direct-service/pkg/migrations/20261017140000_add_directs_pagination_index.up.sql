@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_directs_sender_receiver_created_at ON directs (sender_id, receiver_id, created_at DESC, id DESC);
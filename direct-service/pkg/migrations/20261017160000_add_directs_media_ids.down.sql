@@ -0,0 +1 @@
+ALTER TABLE directs DROP COLUMN IF EXISTS media_ids;
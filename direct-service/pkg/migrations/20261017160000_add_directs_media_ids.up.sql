@@ -0,0 +1 @@
+ALTER TABLE directs ADD COLUMN IF NOT EXISTS media_ids TEXT[] NOT NULL DEFAULT '{}';
@@ -5,6 +5,8 @@ import (
 	"like-service/internal/models"
 	"like-service/internal/service"
 	pb "like-service/pkg/proto"
+	"pkg/pagination"
+	"pkg/principal"
 )
 
 type LikeHandler struct {
@@ -17,6 +19,9 @@ func NewLikeHandler(service service.LikeService) *LikeHandler {
 }
 
 func (h *LikeHandler) CreateLikeTweet(ctx context.Context, req *pb.CreateLikeTweetRequest) (*pb.CreateLikeTweetResponse, error) {
+	if err := checkCaller(ctx, req.UserId); err != nil {
+		return nil, err
+	}
 	like, err := h.service.CreateLikeTweet(ctx, &models.Like{
 		UserID:         int64(req.UserId),
 		LikeIdentifier: string(models.LikeIdentifierTweet),
@@ -65,6 +70,9 @@ func (h *LikeHandler) GetLikedTweet(ctx context.Context, req *pb.GetLikedTweetRe
 }
 
 func (h *LikeHandler) DeleteLikeTweet(ctx context.Context, req *pb.DeleteLikeTweetRequest) (*pb.DeleteLikeTweetResponse, error) {
+	if err := checkCaller(ctx, req.UserId); err != nil {
+		return nil, err
+	}
 	err := h.service.DeleteLikeTweet(ctx, &models.Like{
 		UserID:         int64(req.UserId),
 		LikeIdentifier: string(models.LikeIdentifierTweet),
@@ -80,10 +88,14 @@ func (h *LikeHandler) DeleteLikeTweet(ctx context.Context, req *pb.DeleteLikeTwe
 }
 
 func (h *LikeHandler) GetLikesTweet(ctx context.Context, req *pb.GetLikesTweetRequest) (*pb.GetLikesTweetResponse, error) {
-	likes, err := h.service.GetLikesTweet(ctx, &models.Like{
+	page, err := pagination.New(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	likes, next, err := h.service.GetLikesTweet(ctx, &models.Like{
 		LikeIdentifier: string(models.LikeIdentifierTweet),
 		LikedID:        int64(req.TweetId),
-	})
+	}, page)
 	if err != nil {
 		return nil, err
 	}
@@ -101,14 +113,19 @@ func (h *LikeHandler) GetLikesTweet(ctx context.Context, req *pb.GetLikesTweetRe
 		Success: true,
 		Message: "Likes retrieved successfully",
 		Likes:   likesProto,
+		NextPageToken: next,
 	}, nil
 }
 
 func (h *LikeHandler) GetLikeTweetByUser(ctx context.Context, req *pb.GetLikeTweetByUserRequest) (*pb.GetLikeTweetByUserResponse, error) {
-	likes, err := h.service.GetLikeTweetByUser(ctx, &models.Like{
+	page, err := pagination.New(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	likes, next, err := h.service.GetLikeTweetByUser(ctx, &models.Like{
 		UserID: int64(req.UserId),
 		LikeIdentifier: string(models.LikeIdentifierTweet),
-	})
+	}, page)
 	if err != nil {
 		return nil, err
 	}
@@ -126,10 +143,14 @@ func (h *LikeHandler) GetLikeTweetByUser(ctx context.Context, req *pb.GetLikeTwe
 		Success: true,
 		Message: "Likes retrieved successfully",
 		Likes:   likesProto,
+		NextPageToken: next,
 	}, nil
 }	
 
 func (h *LikeHandler) CreateLikeComment(ctx context.Context, req *pb.CreateLikeCommentRequest) (*pb.CreateLikeCommentResponse, error) {
+	if err := checkCaller(ctx, req.UserId); err != nil {
+		return nil, err
+	}
 	like, err := h.service.CreateLikeComment(ctx, &models.Like{
 		UserID:         int64(req.UserId),
 		LikeIdentifier: string(models.LikeIdentifierComment),
@@ -177,7 +198,11 @@ func (h *LikeHandler) GetLikesComment(ctx context.Context, req *pb.GetLikesComme
 }
 
 func (h *LikeHandler) DeleteLikeComment(ctx context.Context, req *pb.DeleteLikeCommentRequest) (*pb.DeleteLikeCommentResponse, error) {
+	if err := checkCaller(ctx, req.UserId); err != nil {
+		return nil, err
+	}
 	err := h.service.DeleteLikeComment(ctx, &models.Like{
+		UserID:         int64(req.UserId),
 		LikeIdentifier: string(models.LikeIdentifierComment),
 		LikedID:        int64(req.CommentId),
 	})
@@ -212,4 +237,17 @@ func (h *LikeHandler) GetLikeCommentByUser(ctx context.Context, req *pb.GetLikeC
 		Message: "Likes retrieved successfully",
 		Likes:   likesProto,
 	}, nil
-}
\ No newline at end of file
+}
+
+// checkCaller like faqat gateway uzatgan foydalanuvchi nomidan qo'yilishi va
+// olinishini tekshiradi. x-user-id bo'lmasa so'rov rad etiladi.
+func checkCaller(ctx context.Context, userID int32) error {
+	callerID, ok := principal.CallerID(ctx)
+	if !ok {
+		return service.ErrUnauthenticated
+	}
+	if callerID != int64(userID) {
+		return service.ErrNotLikeOwner
+	}
+	return nil
+}
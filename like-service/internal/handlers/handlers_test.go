@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"like-service/internal/service"
+	pb "like-service/pkg/proto"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// Rad etilgan so'rovlar bazaga yetib bormaydi, shuning uchun db kerak emas
+func TestLikeMutationsCheckCaller(t *testing.T) {
+	h := NewLikeHandler(*service.NewLikeService(nil, nil))
+	calls := map[string]func(context.Context) error{
+		"CreateLikeTweet": func(ctx context.Context) error {
+			_, err := h.CreateLikeTweet(ctx, &pb.CreateLikeTweetRequest{UserId: 1, TweetId: 10})
+			return err
+		},
+		"DeleteLikeTweet": func(ctx context.Context) error {
+			_, err := h.DeleteLikeTweet(ctx, &pb.DeleteLikeTweetRequest{UserId: 1, TweetId: 10})
+			return err
+		},
+		"CreateLikeComment": func(ctx context.Context) error {
+			_, err := h.CreateLikeComment(ctx, &pb.CreateLikeCommentRequest{UserId: 1, CommentId: 10})
+			return err
+		},
+		"DeleteLikeComment": func(ctx context.Context) error {
+			_, err := h.DeleteLikeComment(ctx, &pb.DeleteLikeCommentRequest{UserId: 1, CommentId: 10})
+			return err
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			if err := call(context.Background()); !errors.Is(err, service.ErrUnauthenticated) {
+				t.Errorf("missing caller: err = %v, want %v", err, service.ErrUnauthenticated)
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "2"))
+			if err := call(ctx); !errors.Is(err, service.ErrNotLikeOwner) {
+				t.Errorf("another user: err = %v, want %v", err, service.ErrNotLikeOwner)
+			}
+		})
+	}
+}
@@ -4,8 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strconv"
 	"like-service/config"
+	"log/slog"
+	"pkg/metrics"
+	"pkg/requestid"
+	"pkg/tracing"
+	"strconv"
 
 	"github.com/segmentio/kafka-go"
 )
@@ -31,7 +35,7 @@ func PublishLikeNotification(ctx context.Context, action string, userID int32, t
 
 	defer func() {
 		if err := producer.Close(); err != nil {
-			fmt.Printf("error closing kafka writer: %v\n", err)
+			slog.ErrorContext(ctx, "failed to close kafka writer", "error", err)
 		}
 	}()
 
@@ -61,7 +65,14 @@ func PublishLikeNotification(ctx context.Context, action string, userID int32, t
 		Key:   []byte(strconv.Itoa(int(notification.UserID))), 
 		Value: valueBytes,
 	}
-	if err := producer.WriteMessages(ctx, msg); err != nil {
+	if id := requestid.FromContext(ctx); id != "" {
+		msg.Headers = append(msg.Headers, kafka.Header{Key: requestid.Header, Value: []byte(id)})
+	}
+	ctx, span := tracing.StartProducerSpan(ctx, producer.Topic, &msg)
+	err = producer.WriteMessages(ctx, msg)
+	tracing.End(span, err)
+	metrics.KafkaProduced(producer.Topic, err)
+	if err != nil {
 		return fmt.Errorf("error writing message to kafka: %v", err)
 	}
 
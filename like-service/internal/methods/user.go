@@ -1,24 +1,41 @@
 package methods
 
 import (
-	grp "like-service/pkg/proto"
 	"like-service/config"
-	"log"
+	grp "like-service/pkg/proto"
+	"pkg/grpcclient"
+	"pkg/logger"
+	"pkg/metrics"
+	"pkg/mtls"
+	"pkg/requestid"
+	"sync"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
-func ConnectUser() grp.UserServiceClient {
-	conf, err := config.LoadConfig()
-	if err != nil {
-		log.Fatalf("Failed to load config: %v", err)
-	}
-	conn, err := grpc.NewClient(conf.USER_SERVER_NAME+":"+conf.USER_SERVER_PORT, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		log.Fatal("error connect user micro...", err)
-	}
+var (
+	userOnce   sync.Once
+	userClient grp.UserServiceClient
+)
 
-	client := grp.NewUserServiceClient(conn)
-	return client
+// ConnectUser user-service uchun umumiy clientni qaytaradi.
+// Ulanish birinchi chaqiruvda bir marta ochiladi va keyin qayta ishlatiladi.
+func ConnectUser() grp.UserServiceClient {
+	userOnce.Do(func() {
+		conf, err := config.LoadConfig()
+		if err != nil {
+			logger.Fatal("failed to load config", "error", err)
+		}
+		creds, err := mtls.ClientCredentials(conf.MTLS(), conf.USER_SERVER_NAME)
+		if err != nil {
+			logger.Fatal("failed to load TLS credentials", "error", err)
+		}
+		conn, err := grpcclient.Dial(conf.USER_SERVER_NAME+":"+conf.USER_SERVER_PORT, creds, grpcclient.DefaultOptions,
+			grpc.WithChainUnaryInterceptor(requestid.UnaryClientInterceptor(), logger.UnaryClientInterceptor(), metrics.UnaryClientInterceptor()))
+		if err != nil {
+			logger.Fatal("failed to connect to user service", "error", err)
+		}
+		userClient = grp.NewUserServiceClient(conn)
+	})
+	return userClient
 }
@@ -0,0 +1,9 @@
+package service
+
+import "pkg/grpcerr"
+
+// Servis xatolari. Reason qiymatlari gateway javobida "code" bo'lib chiqadi.
+var (
+	ErrUnauthenticated = grpcerr.Unauthenticated("UNAUTHENTICATED", "caller is not authenticated")
+	ErrNotLikeOwner    = grpcerr.PermissionDenied("NOT_LIKE_OWNER", "you can only like or unlike as yourself")
+)
@@ -3,12 +3,11 @@ package service
 import (
 	"context"
 	"database/sql"
-	"errors"
 	"like-service/internal/kafka"
 	"like-service/internal/methods"
 	"like-service/internal/models"
 	"like-service/pkg/proto"
-	"like-service/utils"
+	"pkg/pagination"
 
 	"github.com/go-redis/redis/v8"
 )
@@ -28,24 +27,13 @@ func (s *LikeService) CreateLikeTweet(ctx context.Context, like *models.Like) (m
 		VALUES ($1, $2, $3)
 		RETURNING *
 	`
+	// tweet-service like qo'yuvchini bloklangan yoki yopiq akkauntga obuna
+	// bo'lmaganini tekshiradi
 	tweet := methods.ConnectTweet()
-	_, err := tweet.GetTweetByID(ctx, &proto.GetTweetByIDRequest{TweetId: int32(like.LikedID)})
+	_, err := tweet.GetTweetByID(ctx, &proto.GetTweetByIDRequest{TweetId: int32(like.LikedID), ViewerId: int32(like.UserID)})
 	if err != nil {
 		return models.Like{}, err
 	}
-	user := methods.ConnectUser()
-	userResponse, err := user.GetUser(ctx, &proto.GetUserRequest{UserId: int32(like.UserID)})
-	if err != nil {
-		return models.Like{}, err
-	}
-	if userResponse.User.IsPrivate {
-		if !utils.InSlice(userResponse.User.Followers, int32(like.UserID)) {
-			return models.Like{}, errors.New("user is private and not in followers")
-		}
-	}
-	if utils.InSlice(userResponse.User.BlockedUsers, int32(like.UserID)) {
-		return models.Like{}, errors.New("user is blocked")
-	}
 	row := s.db.QueryRowContext(ctx, query, like.UserID, like.LikeIdentifier, like.LikedID)
 	var newLike models.Like
 	err = row.Scan(&newLike.ID, &newLike.UserID, &newLike.LikeIdentifier, &newLike.LikedID, &newLike.CreatedAt)
@@ -71,24 +59,12 @@ func (s *LikeService) CreateLikeComment(ctx context.Context, like *models.Like)
 		VALUES ($1, $2, $3) 
 		RETURNING *
 	`
+	// comment-service like qo'yuvchini comment muallifi bloklamaganini tekshiradi
 	comment := methods.ConnectComment()
-	_, err := comment.GetComment(ctx, &proto.GetCommentRequest{Id: int64(like.LikedID)})
-	if err != nil {
-		return models.Like{}, err
-	}
-	user := methods.ConnectUser()
-	userResponse, err := user.GetUser(ctx, &proto.GetUserRequest{UserId: int32(like.UserID)})
+	_, err := comment.GetComment(ctx, &proto.GetCommentRequest{Id: int64(like.LikedID), UserId: like.UserID})
 	if err != nil {
 		return models.Like{}, err
 	}
-	if userResponse.User.IsPrivate {
-		if !utils.InSlice(userResponse.User.Followers, int32(like.UserID)) {
-			return models.Like{}, errors.New("user is private and not in followers")
-		}
-	}
-	if utils.InSlice(userResponse.User.BlockedUsers, int32(like.UserID)) {
-		return models.Like{}, errors.New("user is blocked")
-	}
 	row := s.db.QueryRowContext(ctx, query, like.UserID, like.LikeIdentifier, like.LikedID)
 	var newLike models.Like
 	err = row.Scan(&newLike.ID, &newLike.UserID, &newLike.LikeIdentifier, &newLike.LikedID, &newLike.CreatedAt)
@@ -141,17 +117,18 @@ func (s *LikeService) DeleteLikeComment(ctx context.Context, like *models.Like)
 	return nil
 }
 
-func (s *LikeService) GetLikesTweet(ctx context.Context, like *models.Like) ([]models.Like, error) {
+func (s *LikeService) GetLikesTweet(ctx context.Context, like *models.Like, page pagination.Page) ([]models.Like, string, error) {
 	query := `
-		SELECT * FROM likes
-		WHERE like_identifier = $1 AND liked_id = $2
+		SELECT id, user_id, like_identifier, liked_id, created_at FROM likes
+		WHERE like_identifier = $1 AND liked_id = $2 AND (created_at, id) < ($3, $4)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $5
 	`
-	var likes []models.Like
-	err := s.db.QueryRowContext(ctx, query, like.LikeIdentifier, like.LikedID).Scan(&likes)
+	rows, err := s.db.QueryContext(ctx, query, like.LikeIdentifier, like.LikedID, page.After.CreatedAt, page.After.ID, page.Limit())
 	if err != nil {
-		return []models.Like{}, err
-	}					
-	return likes, nil
+		return nil, "", err
+	}
+	return scanLikes(rows, page)
 }
 
 func (s *LikeService) GetLikesComment(ctx context.Context, like *models.Like) ([]models.Like, error) {
@@ -181,17 +158,18 @@ func (s *LikeService) GetLikedTweets(ctx context.Context, like *models.Like) ([]
 	return likes, nil
 }
 
-func (s *LikeService) GetLikeTweetByUser(ctx context.Context, like *models.Like) ([]models.Like, error) {
+func (s *LikeService) GetLikeTweetByUser(ctx context.Context, like *models.Like, page pagination.Page) ([]models.Like, string, error) {
 	query := `
-		SELECT * FROM likes
-		WHERE user_id = $1 AND like_identifier = $2 AND liked_id = $3
+		SELECT id, user_id, like_identifier, liked_id, created_at FROM likes
+		WHERE user_id = $1 AND like_identifier = $2 AND (created_at, id) < ($3, $4)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $5
 	`
-	var likes []models.Like
-	err := s.db.QueryRowContext(ctx, query, like.UserID, like.LikeIdentifier, like.LikedID).Scan(&likes)
+	rows, err := s.db.QueryContext(ctx, query, like.UserID, like.LikeIdentifier, page.After.CreatedAt, page.After.ID, page.Limit())
 	if err != nil {
-		return []models.Like{}, err
+		return nil, "", err
 	}
-	return likes, nil
+	return scanLikes(rows, page)
 }
 
 func (s *LikeService) GetLikeCommentByUser(ctx context.Context, like *models.Like) ([]models.Like, error) {
@@ -206,3 +184,25 @@ func (s *LikeService) GetLikeCommentByUser(ctx context.Context, like *models.Lik
 	}
 	return likes, nil
 }
+
+// scanLikes sahifadagi like larni o'qiydi va keyingi sahifa tokenini qaytaradi
+func scanLikes(rows *sql.Rows, page pagination.Page) ([]models.Like, string, error) {
+	defer rows.Close()
+
+	likes := []models.Like{}
+	for rows.Next() {
+		var like models.Like
+		err := rows.Scan(&like.ID, &like.UserID, &like.LikeIdentifier, &like.LikedID, &like.CreatedAt)
+		if err != nil {
+			return nil, "", err
+		}
+		likes = append(likes, like)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+	likes, next := pagination.Trim(likes, page, func(like models.Like) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: like.CreatedAt, ID: like.ID}
+	})
+	return likes, next, nil
+}
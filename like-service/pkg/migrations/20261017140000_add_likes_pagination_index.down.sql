@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_likes_liked_created_at;
+DROP INDEX IF EXISTS idx_likes_user_created_at;
@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS idx_likes_liked_created_at ON likes (like_identifier, liked_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_likes_user_created_at ON likes (user_id, like_identifier, created_at DESC, id DESC);
@@ -1,15 +1,45 @@
 package main
 
 import (
-	"log"
-	"notification-service/internal/websocket"
+	"context"
+	"notification-service/config"
+	"notification-service/internal/db"
 	"notification-service/internal/grpc" 
+	"notification-service/internal/webhook"
+	"notification-service/internal/websocket"
+	"pkg/logger"
+	"pkg/metrics"
+	"pkg/tracing"
+	"strings"
 )
 
 func main() {
+	conf, err := config.LoadConfig()
+	if err != nil {
+		logger.Fatal("failed to load config", "error", err)
+	}
+	logger.Init(conf.SERVER_NAME, conf.LOG_LEVEL)
+	shutdownTracing, err := tracing.Init(context.Background(), conf.Tracing())
+	if err != nil {
+		logger.Fatal("failed to set up tracing", "error", err)
+	}
+	defer shutdownTracing(context.Background())
+
+	postgres, err := db.NewPostgresDB()
+	if err != nil {
+		logger.Fatal("failed to connect to database", "error", err)
+	}
+	defer postgres.Close()
+	metrics.RegisterDB(postgres, conf.DB_NAME)
+
+	// webhooklar: Kafka dagi bildirishnomalar navbatga yoziladi, dispatcher ularni yuboradi
+	webhookStore := webhook.NewPostgresStore(postgres)
+	go webhook.NewConsumer(webhookStore, strings.Split(conf.KAFKA_BROKERS, ","), conf.WEBHOOK_CONSUMER_GROUP, conf.WebhookTopics()).Run(context.Background())
+	go webhook.NewDispatcher(webhookStore, conf.Webhooks()).Run(context.Background())
+
 	go websocket.StartWebSocketServer() 
 
-	if err := grpc.StartGRPCServer(); err != nil {
-		log.Fatalf("failed to start gRPC server: %v", err)
+	if err := grpc.StartGRPCServer(postgres, webhook.NewService(webhookStore, conf.WEBHOOK_ALLOW_INSECURE)); err != nil {
+		logger.Fatal("failed to start gRPC server", "error", err)
 	}
-}
\ No newline at end of file
+}
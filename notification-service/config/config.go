@@ -1,6 +1,10 @@
 package config
 
 import (
+	"notification-service/internal/webhook"
+	"pkg/mtls"
+	"pkg/tracing"
+
 	"github.com/spf13/viper"
 )
 
@@ -8,12 +12,29 @@ type Config struct {
 	SERVER_PORT          string `mapstructure:"SERVER_PORT"`
 	SERVER_NAME          string `mapstructure:"SERVER_NAME"`
 	SERVER_ENV           string `mapstructure:"SERVER_ENV"`
+	LOG_LEVEL            string `mapstructure:"LOG_LEVEL"`
+	GRPC_TLS_MODE        string `mapstructure:"GRPC_TLS_MODE"`
+	GRPC_TLS_CA          string `mapstructure:"GRPC_TLS_CA"`
+	GRPC_TLS_CERT        string `mapstructure:"GRPC_TLS_CERT"`
+	GRPC_TLS_KEY         string `mapstructure:"GRPC_TLS_KEY"`
+	GRPC_ALLOWED_CALLERS string `mapstructure:"GRPC_ALLOWED_CALLERS"`
+	OTEL_TRACES_EXPORTER string `mapstructure:"OTEL_TRACES_EXPORTER"`
+	OTEL_EXPORTER_OTLP_ENDPOINT string `mapstructure:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+	OTEL_EXPORTER_OTLP_INSECURE bool `mapstructure:"OTEL_EXPORTER_OTLP_INSECURE"`
+	OTEL_TRACES_SAMPLER_ARG string `mapstructure:"OTEL_TRACES_SAMPLER_ARG"`
 	KAFKA_BROKERS        string `mapstructure:"KAFKA_BROKERS"`
 	KAFKA_TOPIC_FOLLOWS string `mapstructure:"KAFKA_TOPIC_FOLLOWS"`
 	KAFKA_TOPIC_LIKES    string `mapstructure:"KAFKA_TOPIC_LIKES"`
 	KAFKA_TOPIC_COMMENTS  string `mapstructure:"KAFKA_TOPIC_COMMENTS"`
 	KAFKA_TOPIC_DIRECTS   string `mapstructure:"KAFKA_TOPIC_DIRECTS"`
 	KAFKA_CONSUMER_GROUP  string `mapstructure:"KAFKA_CONSUMER_GROUP"`
+	DB_HOST              string `mapstructure:"DB_HOST"`
+	DB_PORT              string `mapstructure:"DB_PORT"`
+	DB_USER              string `mapstructure:"DB_USER"`
+	DB_PASSWORD          string `mapstructure:"DB_PASSWORD"`
+	DB_NAME              string `mapstructure:"DB_NAME"`
+	WEBHOOK_CONSUMER_GROUP string `mapstructure:"WEBHOOK_CONSUMER_GROUP"`
+	WEBHOOK_ALLOW_INSECURE bool   `mapstructure:"WEBHOOK_ALLOW_INSECURE"`
 }
 
 func LoadConfig() (Config, error) {
@@ -35,4 +56,45 @@ func LoadConfig() (Config, error) {
 	}
 
 	return config, nil
-}
\ No newline at end of file
+}
+
+// MTLS servislararo gRPC ulanishlari uchun TLS sozlamalari
+func (c Config) MTLS() mtls.Config {
+	return mtls.Config{
+		Mode:           c.GRPC_TLS_MODE,
+		CAFile:         c.GRPC_TLS_CA,
+		CertFile:       c.GRPC_TLS_CERT,
+		KeyFile:        c.GRPC_TLS_KEY,
+		AllowedCallers: mtls.ParseIdentities(c.GRPC_ALLOWED_CALLERS),
+	}
+}
+
+// Tracing OpenTelemetry exporter sozlamalari
+func (c Config) Tracing() tracing.Config {
+	return tracing.Config{
+		ServiceName: c.SERVER_NAME,
+		Environment: c.SERVER_ENV,
+		Exporter:    c.OTEL_TRACES_EXPORTER,
+		Endpoint:    c.OTEL_EXPORTER_OTLP_ENDPOINT,
+		Insecure:    c.OTEL_EXPORTER_OTLP_INSECURE,
+		SampleRatio: c.OTEL_TRACES_SAMPLER_ARG,
+	}
+}
+
+// Webhooks yetkazish sozlamalari. WEBHOOK_ALLOW_INSECURE http:// va ichki
+// tarmoqdagi endpointlarga ruxsat beradi, faqat lokal ishlab chiqish uchun.
+func (c Config) Webhooks() webhook.Options {
+	opts := webhook.DefaultOptions()
+	opts.AllowPrivateNetworks = c.WEBHOOK_ALLOW_INSECURE
+	return opts
+}
+
+// WebhookTopics webhook hodisalari o'qiladigan topiclar
+func (c Config) WebhookTopics() webhook.Topics {
+	return webhook.Topics{
+		Follows:  c.KAFKA_TOPIC_FOLLOWS,
+		Likes:    c.KAFKA_TOPIC_LIKES,
+		Comments: c.KAFKA_TOPIC_COMMENTS,
+		Directs:  c.KAFKA_TOPIC_DIRECTS,
+	}
+}
@@ -3,9 +3,12 @@ package consumer
 import (
 	"context"
 	"encoding/json"
-	"log"
+	"log/slog"
 	"notification-service/internal/grpc"
 	"notification-service/internal/models" 
+	"pkg/metrics"
+	"pkg/requestid"
+	"pkg/tracing"
 
 	"github.com/segmentio/kafka-go"
 )
@@ -20,19 +23,39 @@ func ConsumeNotifications() {
 	for {
 		m, err := r.ReadMessage(context.Background())
 		if err != nil {
-			log.Println("Error reading message:", err)
+			slog.Error("failed to read kafka message", "error", err)
 			continue
 		}
 
+		// producer qo'ygan X-Request-ID bo'lmasa yangisi yaratiladi
+		id := headerValue(m.Headers, requestid.Header)
+		if !requestid.Valid(id) {
+			id = requestid.New()
+		}
+		ctx, span := tracing.StartConsumerSpan(requestid.NewContext(context.Background(), id), m)
+
 		var notification models.DirectMessage
 		if err := json.Unmarshal(m.Value, &notification); err != nil {
-			log.Println("Error unmarshalling message:", err)
+			slog.ErrorContext(ctx, "failed to unmarshal kafka message", "error", err)
+			tracing.End(span, err)
+			metrics.KafkaConsumed(m.Topic, err)
 			continue
 		}
 
-		err = grpc.HandleDirectMessage(context.Background(), notification)
+		err = grpc.HandleDirectMessage(ctx, notification)
 		if err != nil {
-			log.Println("Error handling direct message:", err)
+			slog.ErrorContext(ctx, "failed to handle direct message", "error", err)
+		}
+		tracing.End(span, err)
+		metrics.KafkaConsumed(m.Topic, err)
+	}
+}
+
+func headerValue(headers []kafka.Header, key string) string {
+	for _, h := range headers {
+		if h.Key == key {
+			return string(h.Value)
 		}
 	}
+	return ""
 }
@@ -0,0 +1,32 @@
+package db
+
+import (
+	"database/sql"
+	"notification-service/config"
+	"fmt"
+	"log/slog"
+
+	"github.com/XSAM/otelsql"
+	_ "github.com/lib/pq"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+func NewPostgresDB() (*sql.DB, error) {
+	config, err := config.LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	psqlInfo := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable", config.DB_HOST, config.DB_PORT, config.DB_USER, config.DB_PASSWORD, config.DB_NAME)
+	db, err := otelsql.Open("postgres", psqlInfo, otelsql.WithAttributes(semconv.DBSystemPostgreSQL))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = db.Ping(); err != nil {
+		return nil, err
+	}
+
+	slog.Info("connected to postgres")
+	return db, nil
+}
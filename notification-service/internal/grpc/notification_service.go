@@ -2,7 +2,7 @@ package grpc
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"notification-service/internal/models"
 	"notification-service/internal/producer"
 	"notification-service/proto"
@@ -21,10 +21,10 @@ func (s *NotificationServiceServer) SendDirectMessage(ctx context.Context, msg *
 		Text:       msg.Text,
 	})
 	if err != nil {
-		log.Printf("Error publishing direct message: %v\n", err)
+		slog.ErrorContext(ctx, "failed to publish direct message", "error", err)
 		return &proto.EmptyResponse{}, err
 	}
-	log.Printf("Received DirectMessage: %+v\n", msg)
+	slog.InfoContext(ctx, "direct message published", "sender_id", msg.SenderId, "receiver_id", msg.ReceiverId)
 	return &proto.EmptyResponse{}, nil
 }
 
@@ -36,10 +36,10 @@ func (s *NotificationServiceServer) SendFollowNotification(ctx context.Context,
 		Message:    msg.Message,
 	})
 	if err != nil {
-		log.Printf("Error publishing follow notification: %v\n", err)
+		slog.ErrorContext(ctx, "failed to publish follow notification", "error", err)
 		return &proto.EmptyResponse{}, err
 	}
-	log.Printf("Received FollowNotification: %+v\n", msg)
+	slog.InfoContext(ctx, "follow notification published", "follower_id", msg.FollowerId)
 	return &proto.EmptyResponse{}, nil
 }
 
@@ -53,10 +53,10 @@ func (s *NotificationServiceServer) SendLikeNotification(ctx context.Context, ms
 		Message:     msg.Message,
 	})
 	if err != nil {
-		log.Printf("Error publishing like notification: %v\n", err)
+		slog.ErrorContext(ctx, "failed to publish like notification", "error", err)
 		return &proto.EmptyResponse{}, err
 	}
-	log.Printf("Received LikeNotification: %+v\n", msg)
+	slog.InfoContext(ctx, "like notification published", "tweet_owner_id", msg.TweetOwnerId)
 	return &proto.EmptyResponse{}, nil
 }
 
@@ -70,10 +70,10 @@ func (s *NotificationServiceServer) SendCommentNotification(ctx context.Context,
 		Message:     msg.Message,
 	})
 	if err != nil {
-		log.Printf("Error publishing comment notification: %v\n", err)
+		slog.ErrorContext(ctx, "failed to publish comment notification", "error", err)
 		return &proto.EmptyResponse{}, err
 	}
-	log.Printf("Received CommentNotification: %+v\n", msg)
+	slog.InfoContext(ctx, "comment notification published", "tweet_id", msg.TweetId, "commenter_id", msg.CommenterId)
 	return &proto.EmptyResponse{}, nil
 }
 
@@ -1,25 +1,65 @@
 package grpc
 
 import (
-	"log"
-	"net"
+	"context"
+	"database/sql"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"log/slog"
+	"net"
+	"notification-service/config"
+	"notification-service/internal/producer"
+	"notification-service/internal/webhook"
 	"notification-service/proto"
+	"pkg/grpcerr"
+	"pkg/healthcheck"
+	"pkg/logger"
+	"pkg/metrics"
+	"pkg/mtls"
+	"pkg/requestid"
+	"time"
 )
 
-func StartGRPCServer() error {
+func StartGRPCServer(db *sql.DB, webhooks *webhook.Service) error {
+	conf, err := config.LoadConfig()
+	if err != nil {
+		logger.Fatal("failed to load config", "error", err)
+		return err
+	}
+	creds, err := mtls.ServerCredentials(conf.MTLS())
+	if err != nil {
+		logger.Fatal("failed to load TLS credentials", "error", err)
+		return err
+	}
+
 	listener, err := net.Listen("tcp", ":50051")
 	if err != nil {
-		log.Fatalf("Failed to listen: %v", err)
+		logger.Fatal("failed to listen", "error", err)
 		return err
 	}
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(
+		grpc.Creds(creds),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(requestid.UnaryServerInterceptor(), logger.UnaryServerInterceptor(), metrics.UnaryServerInterceptor(), grpcerr.UnaryServerInterceptor(conf.SERVER_NAME)),
+	)
 	proto.RegisterNotificationServiceServer(s, &NotificationServiceServer{})
+	proto.RegisterWebhookServiceServer(s, NewWebhookServiceServer(webhooks))
+
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(s, healthServer)
+	checker := healthcheck.New(healthServer, proto.NotificationService_ServiceDesc.ServiceName)
+	checker.Add("kafka", func(ctx context.Context) error {
+		return producer.Ping(ctx, conf.KAFKA_BROKERS)
+	})
+	checker.Add("postgres", db.PingContext)
+	go checker.Run(10*time.Second, nil)
 
-	log.Println("gRPC server is running on port :50051")
+	slog.Info("grpc server started", "port", "50051")
 	if err := s.Serve(listener); err != nil {
-		log.Fatalf("Failed to serve: %v", err)
+		logger.Fatal("failed to serve gRPC", "error", err)
 		return err
 	}
 	return nil
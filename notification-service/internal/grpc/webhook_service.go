@@ -0,0 +1,169 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"notification-service/internal/webhook"
+	"notification-service/proto"
+	"pkg/pagination"
+	"pkg/principal"
+)
+
+type WebhookServiceServer struct {
+	proto.UnimplementedWebhookServiceServer
+	service *webhook.Service
+}
+
+func NewWebhookServiceServer(service *webhook.Service) *WebhookServiceServer {
+	return &WebhookServiceServer{service: service}
+}
+
+// CreateWebhook yangi webhook yaratadi va imzo kalitini qaytaradi
+func (s *WebhookServiceServer) CreateWebhook(ctx context.Context, req *proto.CreateWebhookRequest) (*proto.CreateWebhookResponse, error) {
+	if err := checkCaller(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	created, err := s.service.Create(ctx, req.UserId, req.Url, req.Events)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.CreateWebhookResponse{Webhook: toProtoWebhook(created), Secret: created.Secret}, nil
+}
+
+// ListWebhooks foydalanuvchining barcha webhooklari
+func (s *WebhookServiceServer) ListWebhooks(ctx context.Context, req *proto.ListWebhooksRequest) (*proto.ListWebhooksResponse, error) {
+	if err := checkCaller(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	webhooks, err := s.service.List(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.ListWebhooksResponse{Webhooks: make([]*proto.Webhook, len(webhooks))}
+	for i, w := range webhooks {
+		resp.Webhooks[i] = toProtoWebhook(w)
+	}
+	return resp, nil
+}
+
+func (s *WebhookServiceServer) GetWebhook(ctx context.Context, req *proto.GetWebhookRequest) (*proto.GetWebhookResponse, error) {
+	if err := checkCaller(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	w, err := s.service.Get(ctx, req.Id, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetWebhookResponse{Webhook: toProtoWebhook(w)}, nil
+}
+
+// UpdateWebhook URL, hodisalar yoki holatni o'zgartiradi
+func (s *WebhookServiceServer) UpdateWebhook(ctx context.Context, req *proto.UpdateWebhookRequest) (*proto.UpdateWebhookResponse, error) {
+	if err := checkCaller(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	w, err := s.service.Update(ctx, req.Id, req.UserId, webhook.Update{
+		URL:    req.Url,
+		Events: req.Events,
+		Active: req.Active,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &proto.UpdateWebhookResponse{Webhook: toProtoWebhook(w)}, nil
+}
+
+func (s *WebhookServiceServer) DeleteWebhook(ctx context.Context, req *proto.DeleteWebhookRequest) (*proto.DeleteWebhookResponse, error) {
+	if err := checkCaller(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	if err := s.service.Delete(ctx, req.Id, req.UserId); err != nil {
+		return nil, err
+	}
+	return &proto.DeleteWebhookResponse{Success: true}, nil
+}
+
+func (s *WebhookServiceServer) RotateWebhookSecret(ctx context.Context, req *proto.RotateWebhookSecretRequest) (*proto.RotateWebhookSecretResponse, error) {
+	if err := checkCaller(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	secret, err := s.service.RotateSecret(ctx, req.Id, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.RotateWebhookSecretResponse{Secret: secret}, nil
+}
+
+// ListWebhookDeliveries yetkazish logi, yangilari birinchi
+func (s *WebhookServiceServer) ListWebhookDeliveries(ctx context.Context, req *proto.ListWebhookDeliveriesRequest) (*proto.ListWebhookDeliveriesResponse, error) {
+	if err := checkCaller(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	page, err := pagination.New(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	deliveries, next, err := s.service.Deliveries(ctx, req.Id, req.UserId, page)
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.ListWebhookDeliveriesResponse{
+		Deliveries:    make([]*proto.WebhookDelivery, len(deliveries)),
+		NextPageToken: next,
+	}
+	for i, d := range deliveries {
+		resp.Deliveries[i] = &proto.WebhookDelivery{
+			Id:             d.ID,
+			WebhookId:      d.WebhookID,
+			EventId:        d.EventID,
+			EventType:      d.EventType,
+			Status:         d.Status,
+			Attempts:       int32(d.Attempts),
+			ResponseStatus: int32(d.ResponseStatus),
+			Error:          d.Error,
+			CreatedAt:      formatTime(d.CreatedAt),
+			NextAttemptAt:  formatTime(d.NextAttemptAt),
+			DeliveredAt:    formatTime(d.DeliveredAt),
+		}
+		// tugagan yetkazishda keyingi urinish yo'q
+		if d.Status != webhook.StatusPending {
+			resp.Deliveries[i].NextAttemptAt = ""
+		}
+	}
+	return resp, nil
+}
+
+// checkCaller so'rovdagi user_id gateway uzatgan foydalanuvchi ekanini
+// tekshiradi. x-user-id bo'lmasa so'rov rad etiladi.
+func checkCaller(ctx context.Context, userID int32) error {
+	callerID, ok := principal.CallerID(ctx)
+	if !ok {
+		return webhook.ErrUnauthenticated
+	}
+	if callerID != int64(userID) {
+		return webhook.ErrNotCaller
+	}
+	return nil
+}
+
+func toProtoWebhook(w *webhook.Webhook) *proto.Webhook {
+	return &proto.Webhook{
+		Id:             w.ID,
+		UserId:         w.UserID,
+		Url:            w.URL,
+		Events:         w.Events,
+		Active:         w.Active,
+		FailureCount:   int32(w.FailureCount),
+		DisabledReason: w.DisabledReason,
+		CreatedAt:      formatTime(w.CreatedAt),
+		UpdatedAt:      formatTime(w.UpdatedAt),
+	}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"notification-service/internal/webhook"
+	"notification-service/proto"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestWebhookRPCsCheckCaller(t *testing.T) {
+	// service nil: tekshiruvdan o'tgan so'rov panic bilan tugaydi
+	s := NewWebhookServiceServer(nil)
+	calls := map[string]func(context.Context) error{
+		"CreateWebhook": func(ctx context.Context) error {
+			_, err := s.CreateWebhook(ctx, &proto.CreateWebhookRequest{UserId: 2, Url: "https://example.com/hook"})
+			return err
+		},
+		"ListWebhooks": func(ctx context.Context) error {
+			_, err := s.ListWebhooks(ctx, &proto.ListWebhooksRequest{UserId: 2})
+			return err
+		},
+		"DeleteWebhook": func(ctx context.Context) error {
+			_, err := s.DeleteWebhook(ctx, &proto.DeleteWebhookRequest{Id: 1, UserId: 2})
+			return err
+		},
+		"RotateWebhookSecret": func(ctx context.Context) error {
+			_, err := s.RotateWebhookSecret(ctx, &proto.RotateWebhookSecretRequest{Id: 1, UserId: 2})
+			return err
+		},
+		"ListWebhookDeliveries": func(ctx context.Context) error {
+			_, err := s.ListWebhookDeliveries(ctx, &proto.ListWebhookDeliveriesRequest{Id: 1, UserId: 2})
+			return err
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			if err := call(context.Background()); !errors.Is(err, webhook.ErrUnauthenticated) {
+				t.Errorf("without a caller: err = %v, want %v", err, webhook.ErrUnauthenticated)
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "1"))
+			if err := call(ctx); !errors.Is(err, webhook.ErrNotCaller) {
+				t.Errorf("as another user: err = %v, want %v", err, webhook.ErrNotCaller)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package producer
+
+import (
+	"context"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// Ping broker bilan ulanish ochib ko'radi, health check uchun
+func Ping(ctx context.Context, broker string) error {
+	conn, err := kafka.DialContext(ctx, "tcp", broker)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
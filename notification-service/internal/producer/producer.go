@@ -4,11 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strconv"
-	"notification-service/config"
 	"github.com/segmentio/kafka-go"
-	"notification-service/proto"
+	"log/slog"
+	"notification-service/config"
 	"notification-service/internal/websocket"
+	"notification-service/proto"
+	"pkg/metrics"
+	"pkg/requestid"
+	"pkg/tracing"
+	"strconv"
 )
 
 func PublishFollowNotification(ctx context.Context, notification *proto.FollowNotification) error {
@@ -23,7 +27,7 @@ func PublishFollowNotification(ctx context.Context, notification *proto.FollowNo
 
 	defer func() {
 		if err := producer.Close(); err != nil {
-			fmt.Printf("error closing kafka writer: %v\n", err)
+			slog.ErrorContext(ctx, "failed to close kafka writer", "error", err)
 		}
 	}()
 
@@ -36,7 +40,14 @@ func PublishFollowNotification(ctx context.Context, notification *proto.FollowNo
 		Key:   []byte(strconv.Itoa(int(notification.UserId))), 
 		Value: valueBytes,
 	}
-	if err := producer.WriteMessages(ctx, msg); err != nil {
+	if id := requestid.FromContext(ctx); id != "" {
+		msg.Headers = append(msg.Headers, kafka.Header{Key: requestid.Header, Value: []byte(id)})
+	}
+	ctx, span := tracing.StartProducerSpan(ctx, producer.Topic, &msg)
+	err = producer.WriteMessages(ctx, msg)
+	tracing.End(span, err)
+	metrics.KafkaProduced(producer.Topic, err)
+	if err != nil {
 		return fmt.Errorf("error writing message to kafka: %v", err)
 	}
 
@@ -57,7 +68,7 @@ func PublishLikeNotification(ctx context.Context, notification *proto.LikeNotifi
 
 	defer func() {
 		if err := producer.Close(); err != nil {
-			fmt.Printf("error closing kafka writer: %v\n", err)
+			slog.ErrorContext(ctx, "failed to close kafka writer", "error", err)
 		}
 	}()
 
@@ -70,7 +81,14 @@ func PublishLikeNotification(ctx context.Context, notification *proto.LikeNotifi
 		Key:   []byte(strconv.Itoa(int(notification.UserId))), 
 		Value: valueBytes,
 	}
-	if err := producer.WriteMessages(ctx, msg); err != nil {
+	if id := requestid.FromContext(ctx); id != "" {
+		msg.Headers = append(msg.Headers, kafka.Header{Key: requestid.Header, Value: []byte(id)})
+	}
+	ctx, span := tracing.StartProducerSpan(ctx, producer.Topic, &msg)
+	err = producer.WriteMessages(ctx, msg)
+	tracing.End(span, err)
+	metrics.KafkaProduced(producer.Topic, err)
+	if err != nil {
 		return fmt.Errorf("error writing message to kafka: %v", err)
 	}
 
@@ -91,7 +109,7 @@ func PublishCommentNotification(ctx context.Context, notification *proto.Comment
 
 	defer func() {
 		if err := producer.Close(); err != nil {
-			fmt.Printf("error closing kafka writer: %v\n", err)
+			slog.ErrorContext(ctx, "failed to close kafka writer", "error", err)
 		}
 	}()
 
@@ -104,7 +122,14 @@ func PublishCommentNotification(ctx context.Context, notification *proto.Comment
 		Key:   []byte(strconv.Itoa(int(notification.UserId))), 
 		Value: valueBytes,
 	}
-	if err := producer.WriteMessages(ctx, msg); err != nil {
+	if id := requestid.FromContext(ctx); id != "" {
+		msg.Headers = append(msg.Headers, kafka.Header{Key: requestid.Header, Value: []byte(id)})
+	}
+	ctx, span := tracing.StartProducerSpan(ctx, producer.Topic, &msg)
+	err = producer.WriteMessages(ctx, msg)
+	tracing.End(span, err)
+	metrics.KafkaProduced(producer.Topic, err)
+	if err != nil {
 		return fmt.Errorf("error writing message to kafka: %v", err)
 	}
 
@@ -125,7 +150,7 @@ func PublishDirectMessage(ctx context.Context, notification *proto.DirectMessage
 
 	defer func() {
 		if err := producer.Close(); err != nil {
-			fmt.Printf("error closing kafka writer: %v\n", err)
+			slog.ErrorContext(ctx, "failed to close kafka writer", "error", err)
 		}
 	}()
 
@@ -138,7 +163,14 @@ func PublishDirectMessage(ctx context.Context, notification *proto.DirectMessage
 		Key:   []byte(strconv.Itoa(int(notification.ReceiverId))), 
 		Value: valueBytes,
 	}
-	if err := producer.WriteMessages(ctx, msg); err != nil {
+	if id := requestid.FromContext(ctx); id != "" {
+		msg.Headers = append(msg.Headers, kafka.Header{Key: requestid.Header, Value: []byte(id)})
+	}
+	ctx, span := tracing.StartProducerSpan(ctx, producer.Topic, &msg)
+	err = producer.WriteMessages(ctx, msg)
+	tracing.End(span, err)
+	metrics.KafkaProduced(producer.Topic, err)
+	if err != nil {
 		return fmt.Errorf("error writing message to kafka: %v", err)
 	}
 
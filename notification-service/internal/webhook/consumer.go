@@ -0,0 +1,244 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"pkg/tracing"
+	"time"
+
+	"pkg/metrics"
+	"pkg/requestid"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// Topics servislar bildirishnoma yozadigan Kafka topiclari
+type Topics struct {
+	Follows  string
+	Likes    string
+	Comments string
+	Directs  string
+}
+
+// retryDelay navbatga yozib bo'lmaganda (masalan Postgres ishlamasa) kutish.
+// Xabar commit qilinmaydi, shuning uchun hodisa yo'qolmaydi.
+const retryDelay = 5 * time.Second
+
+// Consumer Kafka dagi bildirishnomalarni o'qiydi va har bir mos webhook uchun
+// yetkazish yaratadi. Xabar navbatga yozilgandan keyingina commit qilinadi.
+type Consumer struct {
+	store   Store
+	brokers []string
+	group   string
+	topics  Topics
+}
+
+func NewConsumer(store Store, brokers []string, group string, topics Topics) *Consumer {
+	return &Consumer{store: store, brokers: brokers, group: group, topics: topics}
+}
+
+// Run har bir topicni alohida readerda ctx bekor qilinguncha o'qiydi
+func (c *Consumer) Run(ctx context.Context) {
+	done := make(chan struct{})
+	topics := []string{c.topics.Follows, c.topics.Likes, c.topics.Comments, c.topics.Directs}
+	for _, topic := range topics {
+		go func(topic string) {
+			defer func() { done <- struct{}{} }()
+			c.consume(ctx, topic)
+		}(topic)
+	}
+	for range topics {
+		<-done
+	}
+}
+
+func (c *Consumer) consume(ctx context.Context, topic string) {
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: c.brokers,
+		Topic:   topic,
+		GroupID: c.group,
+	})
+	defer r.Close()
+
+	for {
+		m, err := r.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			slog.Error("failed to read kafka message", "topic", topic, "error", err)
+			continue
+		}
+
+		for {
+			err = c.Handle(ctx, m)
+			if err == nil || ctx.Err() != nil {
+				break
+			}
+			slog.Error("failed to enqueue webhook deliveries", "topic", topic, "offset", m.Offset, "error", err)
+			select {
+			case <-ctx.Done():
+			case <-time.After(retryDelay):
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if err := r.CommitMessages(ctx, m); err != nil {
+			slog.Error("failed to commit kafka message", "topic", topic, "offset", m.Offset, "error", err)
+		}
+	}
+}
+
+// Handle bitta xabarni hodisaga aylantiradi va obuna bo'lgan webhooklar uchun
+// navbatga yozadi. Buzilgan xabarlar log qilinadi va o'tkazib yuboriladi.
+func (c *Consumer) Handle(ctx context.Context, m kafka.Message) error {
+	// producer qo'ygan X-Request-ID bo'lmasa yangisi yaratiladi
+	id := headerValue(m.Headers, requestid.Header)
+	if !requestid.Valid(id) {
+		id = requestid.New()
+	}
+	ctx, span := tracing.StartConsumerSpan(requestid.NewContext(ctx, id), m)
+
+	event, ok, err := c.topics.Event(m)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to unmarshal kafka message", "topic", m.Topic, "error", err)
+		tracing.End(span, err)
+		metrics.KafkaConsumed(m.Topic, err)
+		return nil
+	}
+	if ok {
+		err = Fanout(ctx, c.store, event)
+	}
+	tracing.End(span, err)
+	metrics.KafkaConsumed(m.Topic, err)
+	return err
+}
+
+// Fanout hodisani foydalanuvchining obuna bo'lgan har bir faol webhooki navbatiga qo'yadi
+func Fanout(ctx context.Context, store Store, event Event) error {
+	webhooks, err := store.Subscriptions(ctx, event.UserID, event.Type)
+	if err != nil {
+		return err
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+	payload, err := NewPayload(event)
+	if err != nil {
+		return fmt.Errorf("error marshaling webhook payload: %v", err)
+	}
+	for _, webhook := range webhooks {
+		if err := store.Enqueue(ctx, webhook.ID, event, payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Event Kafka xabarini hodisaga aylantiradi. ok=false bo'lsa xabar webhooklarga
+// tegishli emas: unlike, comment o'chirilishi, rad etilgan so'rov yoki o'ziga like.
+func (t Topics) Event(m kafka.Message) (Event, bool, error) {
+	event := Event{
+		ID:        fmt.Sprintf("%s-%d-%d", m.Topic, m.Partition, m.Offset),
+		CreatedAt: m.Time,
+		Data:      json.RawMessage(m.Value),
+	}
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+
+	switch m.Topic {
+	case t.Follows:
+		var n struct {
+			UserID     int32  `json:"user_id"`
+			FollowerID int32  `json:"follower_id"`
+			Action     string `json:"action"`
+		}
+		if err := json.Unmarshal(m.Value, &n); err != nil {
+			return Event{}, false, err
+		}
+		event.Type, event.UserID = EventFollow, n.UserID
+		switch n.Action {
+		case "reject":
+			return Event{}, false, nil
+		case "add":
+			// AddFollowing da user_id kuzatishni boshlagan, follower_id esa kuzatilgan foydalanuvchi
+			event.UserID = n.FollowerID
+		}
+
+	case t.Likes:
+		var n struct {
+			UserID       int32  `json:"user_id"`
+			CommenterID  int32  `json:"commenter_id"`
+			TweetOwnerID int32  `json:"tweet_owner_id"`
+			Action       string `json:"action"`
+		}
+		if err := json.Unmarshal(m.Value, &n); err != nil {
+			return Event{}, false, err
+		}
+		if n.Action == "unlike" {
+			return Event{}, false, nil
+		}
+		// tweetga like da tweet egasi, commentga like da comment muallifi
+		event.Type, event.UserID = EventLike, n.TweetOwnerID
+		if event.UserID == 0 {
+			event.UserID = n.CommenterID
+		}
+		if event.UserID == n.UserID {
+			return Event{}, false, nil
+		}
+
+	case t.Comments:
+		var n struct {
+			UserID      int32  `json:"user_id"`
+			CommenterID int32  `json:"commenter_id"`
+			Action      string `json:"action"`
+		}
+		if err := json.Unmarshal(m.Value, &n); err != nil {
+			return Event{}, false, err
+		}
+		if n.Action == "remove" || n.UserID == n.CommenterID {
+			return Event{}, false, nil
+		}
+		event.Type, event.UserID = EventComment, n.UserID
+
+	case t.Directs:
+		// direct-service xabarni "message" ichida, bu servisning producer i esa to'g'ridan-to'g'ri yozadi
+		var wrapped struct {
+			Message json.RawMessage `json:"message"`
+		}
+		if err := json.Unmarshal(m.Value, &wrapped); err != nil {
+			return Event{}, false, err
+		}
+		if len(wrapped.Message) > 0 && wrapped.Message[0] == '{' {
+			event.Data = wrapped.Message
+		}
+		var n struct {
+			ReceiverID int64 `json:"receiver_id"`
+		}
+		if err := json.Unmarshal(event.Data, &n); err != nil {
+			return Event{}, false, err
+		}
+		event.Type, event.UserID = EventDirectMessage, int32(n.ReceiverID)
+
+	default:
+		return Event{}, false, nil
+	}
+
+	if event.UserID <= 0 {
+		return Event{}, false, nil
+	}
+	return event, true, nil
+}
+
+func headerValue(headers []kafka.Header, key string) string {
+	for _, h := range headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,69 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+func TestTopicsEvent(t *testing.T) {
+	topics := Topics{Follows: "follows", Likes: "likes", Comments: "comments", Directs: "directs"}
+	sent := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		topic    string
+		value    string
+		wantOK   bool
+		wantType string
+		wantUser int32
+		wantData string
+	}{
+		{"follow added", "follows", `{"user_id":3,"follower_id":9,"action":"add"}`, true, EventFollow, 9, ""},
+		{"follow request", "follows", `{"user_id":9,"follower_id":3,"action":"request"}`, true, EventFollow, 9, ""},
+		{"follow rejected", "follows", `{"user_id":9,"follower_id":3,"action":"reject"}`, false, "", 0, ""},
+		{"tweet liked", "likes", `{"user_id":3,"tweet_owner_id":9,"action":"like","tweet_id":1}`, true, EventLike, 9, ""},
+		{"comment liked", "likes", `{"user_id":3,"commenter_id":9,"action":"like"}`, true, EventLike, 9, ""},
+		{"own tweet liked", "likes", `{"user_id":9,"tweet_owner_id":9,"action":"like"}`, false, "", 0, ""},
+		{"unliked", "likes", `{"user_id":3,"tweet_owner_id":9,"action":"unlike"}`, false, "", 0, ""},
+		{"commented", "comments", `{"user_id":9,"commenter_id":3,"tweet_id":1,"action":"add"}`, true, EventComment, 9, ""},
+		{"own tweet commented", "comments", `{"user_id":9,"commenter_id":9,"tweet_id":1,"action":"add"}`, false, "", 0, ""},
+		{"direct from direct-service", "directs", `{"message":{"id":1,"sender_id":3,"receiver_id":9}}`, true, EventDirectMessage, 9, `{"id":1,"sender_id":3,"receiver_id":9}`},
+		{"direct from notification-service", "directs", `{"id":1,"sender_id":3,"receiver_id":9,"message":"hi"}`, true, EventDirectMessage, 9, `{"id":1,"sender_id":3,"receiver_id":9,"message":"hi"}`},
+		{"unknown topic", "retweets", `{"user_id":9}`, false, "", 0, ""},
+		{"no recipient", "comments", `{"commenter_id":3,"action":"add"}`, false, "", 0, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := kafka.Message{Topic: tt.topic, Partition: 2, Offset: 40, Value: []byte(tt.value), Time: sent}
+			event, ok, err := topics.Event(m)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if event.Type != tt.wantType || event.UserID != tt.wantUser {
+				t.Errorf("got %s for user %d, want %s for user %d", event.Type, event.UserID, tt.wantType, tt.wantUser)
+			}
+			if event.ID != tt.topic+"-2-40" || !event.CreatedAt.Equal(sent) {
+				t.Errorf("unexpected event id %q at %v", event.ID, event.CreatedAt)
+			}
+			wantData := tt.wantData
+			if wantData == "" {
+				wantData = tt.value
+			}
+			if string(event.Data) != wantData {
+				t.Errorf("data = %s, want %s", event.Data, wantData)
+			}
+		})
+	}
+
+	if _, _, err := topics.Event(kafka.Message{Topic: "likes", Value: []byte("not json")}); err == nil {
+		t.Error("expected an error for a malformed message")
+	}
+}
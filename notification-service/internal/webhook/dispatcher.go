@@ -0,0 +1,266 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Options Dispatcher sozlamalari
+type Options struct {
+	// PollInterval navbatda vaqti kelgan yetkazishlarni tekshirish oralig'i
+	PollInterval time.Duration
+	BatchSize    int
+	// Concurrency bir vaqtda yuboriladigan so'rovlar
+	Concurrency int
+	// Timeout bitta so'rov uchun; endpoint shu vaqtda javob bermasa urinish xato
+	Timeout time.Duration
+	// Lease olingan yetkazish shu vaqt ichida boshqa workerga berilmaydi
+	Lease time.Duration
+	// MaxAttempts dan keyin yetkazish failed bo'ladi
+	MaxAttempts int
+	// BaseBackoff birinchi qayta urinishgacha kutish, har safar ikki barobar oshadi
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// MaxFailures ketma-ket muvaffaqiyatsiz urinishlardan keyin webhook o'chiriladi
+	MaxFailures int
+	// AllowPrivateNetworks localhost va ichki tarmoq manzillariga yuborishga ruxsat
+	// beradi. O'chiq bo'lsa webhook orqali ichki servislarga so'rov yuborib bo'lmaydi.
+	AllowPrivateNetworks bool
+}
+
+// DefaultOptions 8 ta urinish taxminan 2 soat ichida tugaydi
+func DefaultOptions() Options {
+	return Options{
+		PollInterval: time.Second,
+		BatchSize:    50,
+		Concurrency:  8,
+		Timeout:      10 * time.Second,
+		Lease:        time.Minute,
+		MaxAttempts:  8,
+		BaseBackoff:  30 * time.Second,
+		MaxBackoff:   time.Hour,
+		MaxFailures:  20,
+	}
+}
+
+// backoff attempt-urinish muvaffaqiyatsiz bo'lgandan keyingi kutish
+func (o Options) backoff(attempt int) time.Duration {
+	delay := o.BaseBackoff
+	for i := 1; i < attempt && delay < o.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > o.MaxBackoff {
+		delay = o.MaxBackoff
+	}
+	return delay
+}
+
+// Dispatcher navbatdagi yetkazishlarni endpointlarga yuboradi
+type Dispatcher struct {
+	store  Store
+	opts   Options
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewDispatcher(store Store, opts Options) *Dispatcher {
+	dialer := &net.Dialer{Timeout: 5 * time.Second}
+	if !opts.AllowPrivateNetworks {
+		dialer.Control = denyPrivateNetworks
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	// proxy orqali ulanishda manzil tekshiruvi proxy ga qo'llanib qoladi
+	transport.Proxy = nil
+
+	return &Dispatcher{
+		store: store,
+		opts:  opts,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   opts.Timeout,
+			// redirect kuzatilmaydi, 3xx javob xato hisoblanadi
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		now: time.Now,
+	}
+}
+
+// Run ctx bekor qilinguncha navbatni qayta-qayta tekshiradi
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.opts.PollInterval)
+	defer ticker.Stop()
+	for {
+		n, err := d.Dispatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "failed to dispatch webhooks", "error", err)
+		}
+		// to'plam to'la bo'lsa navbatda yana bor, kutmasdan davom etadi
+		if n == d.opts.BatchSize && ctx.Err() == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch vaqti kelgan yetkazishlarning bitta to'plamini yuboradi va nechta
+// yetkazish olinganini qaytaradi
+func (d *Dispatcher) Dispatch(ctx context.Context) (int, error) {
+	deliveries, err := d.store.Claim(ctx, d.now(), d.opts.Lease, d.opts.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, max(d.opts.Concurrency, 1))
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(delivery *Delivery) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			d.deliver(ctx, delivery)
+		}(delivery)
+	}
+	wg.Wait()
+	return len(deliveries), nil
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, delivery *Delivery) {
+	attempt := d.send(ctx, delivery)
+	if ctx.Err() != nil {
+		// to'xtash paytida uzilgan urinish hisoblanmaydi, lease tugagach qayta yuboriladi
+		return
+	}
+
+	number := delivery.Attempts + 1
+	result := "success"
+	if !attempt.OK() {
+		result = "failed"
+		if number < d.opts.MaxAttempts {
+			result = "retry"
+			attempt.RetryAt = attempt.At.Add(d.opts.backoff(number))
+		}
+	}
+	deliveryAttempts.WithLabelValues(delivery.EventType, result).Inc()
+
+	failures, err := d.store.RecordAttempt(ctx, delivery, attempt)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to record webhook delivery", "delivery_id", delivery.ID, "error", err)
+		return
+	}
+	if attempt.OK() {
+		return
+	}
+	slog.WarnContext(ctx, "webhook delivery failed",
+		"webhook_id", delivery.WebhookID,
+		"delivery_id", delivery.ID,
+		"attempt", number,
+		"status", attempt.StatusCode,
+		"error", attempt.Err,
+	)
+
+	if failures >= d.opts.MaxFailures {
+		reason := fmt.Sprintf("disabled after %d consecutive failed deliveries", failures)
+		if err := d.store.Disable(ctx, delivery.WebhookID, reason); err != nil {
+			slog.ErrorContext(ctx, "failed to disable webhook", "webhook_id", delivery.WebhookID, "error", err)
+			return
+		}
+		webhooksDisabled.Inc()
+		slog.WarnContext(ctx, "webhook disabled", "webhook_id", delivery.WebhookID, "failures", failures)
+	}
+}
+
+// send bitta POST so'rovi. 2xx dan boshqa javob va tarmoq xatolari muvaffaqiyatsiz urinish.
+func (d *Dispatcher) send(ctx context.Context, delivery *Delivery) Attempt {
+	timestamp := d.now()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return Attempt{Err: err.Error(), At: timestamp}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "notification-service-webhooks/1.0")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+	req.Header.Set(HeaderSignature, Sign(delivery.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return Attempt{Err: err.Error(), At: d.now()}
+	}
+	defer resp.Body.Close()
+	// connection qayta ishlatilishi uchun body o'qib tashlanadi
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	attempt := Attempt{StatusCode: resp.StatusCode, At: d.now()}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		attempt.Err = fmt.Sprintf("unexpected status %d", resp.StatusCode)
+	}
+	return attempt
+}
+
+var errPrivateNetwork = errors.New("webhook address is not publicly routable")
+
+// denyPrivateNetworks DNS dan keyin, ulanishdan oldin IP ni tekshiradi, shuning
+// uchun domen ichki manzilga qaratilgan bo'lsa ham so'rov yuborilmaydi
+func denyPrivateNetworks(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	if !publicAddr(addrPort.Addr()) {
+		return errPrivateNetwork
+	}
+	return nil
+}
+
+// reservedPrefixes IsPrivate va boshqa tekshiruvlarga kirmaydigan, lekin ommaviy
+// internetda bo'lmagan tarmoqlar
+var reservedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // "this network"
+	netip.MustParsePrefix("100.64.0.0/10"),   // CGNAT, RFC 6598
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments
+	netip.MustParsePrefix("192.0.2.0/24"),    // TEST-NET-1
+	netip.MustParsePrefix("198.18.0.0/15"),   // benchmarking, RFC 2544
+	netip.MustParsePrefix("198.51.100.0/24"), // TEST-NET-2
+	netip.MustParsePrefix("203.0.113.0/24"),  // TEST-NET-3
+	netip.MustParsePrefix("240.0.0.0/4"),     // reserved va broadcast
+	netip.MustParsePrefix("64:ff9b::/96"),    // NAT64, ichidagi IPv4 ichki bo'lishi mumkin
+	netip.MustParsePrefix("64:ff9b:1::/48"),  // local-use NAT64
+	netip.MustParsePrefix("2001:db8::/32"),   // documentation
+	netip.MustParsePrefix("fec0::/10"),       // site-local
+}
+
+// publicAddr manzil ommaviy internetdagi unicast manzil bo'lsa true
+func publicAddr(addr netip.Addr) bool {
+	// ::ffff:10.0.0.1 kabi manzillar IPv4 sifatida tekshiriladi
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsUnspecified() || addr.IsLoopback() || addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() || addr.IsMulticast() {
+		return false
+	}
+	for _, prefix := range reservedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,343 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"pkg/pagination"
+)
+
+// receiver httptest endpointi: kelgan so'rovlarni yozib oladi va statuses
+// ro'yxatidagi javoblarni ketma-ket qaytaradi, ro'yxat tugasa 200
+type receiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	statuses []int
+	requests []receivedRequest
+}
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+func newReceiver(t *testing.T, statuses ...int) *receiver {
+	t.Helper()
+	r := &receiver{statuses: statuses}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		r.mu.Lock()
+		r.requests = append(r.requests, receivedRequest{header: req.Header.Clone(), body: body})
+		status := http.StatusOK
+		if len(r.statuses) > 0 {
+			status, r.statuses = r.statuses[0], r.statuses[1:]
+		}
+		r.mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *receiver) received() []receivedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedRequest(nil), r.requests...)
+}
+
+type dispatcherTest struct {
+	t          *testing.T
+	store      *memoryStore
+	dispatcher *Dispatcher
+	now        time.Time
+}
+
+func newDispatcherTest(t *testing.T, opts Options) *dispatcherTest {
+	opts.AllowPrivateNetworks = true
+	dt := &dispatcherTest{t: t, store: newMemoryStore(), now: time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)}
+	dt.dispatcher = NewDispatcher(dt.store, opts)
+	dt.dispatcher.now = func() time.Time { return dt.now }
+	return dt
+}
+
+func (dt *dispatcherTest) webhook(url string, events ...string) *Webhook {
+	dt.t.Helper()
+	w, err := NewService(dt.store, true).Create(context.Background(), 7, url, events)
+	if err != nil {
+		dt.t.Fatal(err)
+	}
+	return w
+}
+
+func (dt *dispatcherTest) publish(id, eventType string) {
+	dt.t.Helper()
+	event := Event{ID: id, Type: eventType, UserID: 7, CreatedAt: dt.now, Data: json.RawMessage(`{"follower_id":42}`)}
+	if err := Fanout(context.Background(), dt.store, event); err != nil {
+		dt.t.Fatal(err)
+	}
+}
+
+func (dt *dispatcherTest) dispatch(want int) {
+	dt.t.Helper()
+	n, err := dt.dispatcher.Dispatch(context.Background())
+	if err != nil {
+		dt.t.Fatal(err)
+	}
+	if n != want {
+		dt.t.Fatalf("dispatched %d deliveries, want %d", n, want)
+	}
+}
+
+// deliveries webhookning yetkazishlari, yangilari birinchi
+func (dt *dispatcherTest) deliveries(webhookID int64) []*Delivery {
+	dt.t.Helper()
+	page, err := pagination.New(100, "")
+	if err != nil {
+		dt.t.Fatal(err)
+	}
+	deliveries, err := dt.store.Deliveries(context.Background(), webhookID, page)
+	if err != nil {
+		dt.t.Fatal(err)
+	}
+	return deliveries
+}
+
+func TestDispatcherDeliversSignedPayload(t *testing.T) {
+	dt := newDispatcherTest(t, DefaultOptions())
+	r := newReceiver(t)
+	w := dt.webhook(r.URL, EventFollow)
+	dt.publish("follows-0-1", EventFollow)
+	// obuna bo'linmagan hodisa navbatga tushmaydi
+	dt.publish("likes-0-1", EventLike)
+
+	dt.dispatch(1)
+
+	requests := r.received()
+	if len(requests) != 1 {
+		t.Fatalf("receiver got %d requests, want 1", len(requests))
+	}
+	got := requests[0]
+	if got.header.Get(HeaderEvent) != EventFollow {
+		t.Errorf("%s = %q, want %q", HeaderEvent, got.header.Get(HeaderEvent), EventFollow)
+	}
+	if !Verify(w.Secret, got.header.Get(HeaderTimestamp), got.header.Get(HeaderSignature), got.body, 5*time.Minute, dt.now) {
+		t.Errorf("signature %q does not verify", got.header.Get(HeaderSignature))
+	}
+	if Verify("whsec_other", got.header.Get(HeaderTimestamp), got.header.Get(HeaderSignature), got.body, 5*time.Minute, dt.now) {
+		t.Error("signature verifies with another secret")
+	}
+
+	var payload Payload
+	if err := json.Unmarshal(got.body, &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.ID != "follows-0-1" || payload.Type != EventFollow || payload.UserID != 7 || string(payload.Data) != `{"follower_id":42}` {
+		t.Errorf("unexpected payload %s", got.body)
+	}
+
+	deliveries := dt.deliveries(w.ID)
+	if len(deliveries) != 1 {
+		t.Fatalf("got %d deliveries, want 1", len(deliveries))
+	}
+	d := deliveries[0]
+	if d.Status != StatusSucceeded || d.Attempts != 1 || d.ResponseStatus != http.StatusOK || !d.DeliveredAt.Equal(dt.now) {
+		t.Errorf("unexpected delivery %+v", d)
+	}
+	if got.header.Get(HeaderDelivery) == "" {
+		t.Errorf("%s header is empty", HeaderDelivery)
+	}
+
+	// bir xil Kafka xabari qayta o'qilsa yangi yetkazish yaratilmaydi
+	dt.publish("follows-0-1", EventFollow)
+	dt.dispatch(0)
+}
+
+func TestDispatcherRetriesWithBackoff(t *testing.T) {
+	opts := DefaultOptions()
+	dt := newDispatcherTest(t, opts)
+	r := newReceiver(t, http.StatusInternalServerError, http.StatusServiceUnavailable)
+	w := dt.webhook(r.URL, EventComment)
+	dt.publish("comments-0-5", EventComment)
+
+	start := dt.now
+	dt.dispatch(1)
+	d := dt.deliveries(w.ID)[0]
+	if d.Status != StatusPending || d.Attempts != 1 || d.ResponseStatus != http.StatusInternalServerError {
+		t.Fatalf("after first attempt: %+v", d)
+	}
+	if want := start.Add(opts.BaseBackoff); !d.NextAttemptAt.Equal(want) {
+		t.Fatalf("next attempt at %v, want %v", d.NextAttemptAt, want)
+	}
+
+	// kutish tugamaguncha qayta yuborilmaydi
+	dt.now = start.Add(opts.BaseBackoff - time.Second)
+	dt.dispatch(0)
+
+	dt.now = start.Add(opts.BaseBackoff)
+	dt.dispatch(1)
+	d = dt.deliveries(w.ID)[0]
+	if want := dt.now.Add(2 * opts.BaseBackoff); d.Status != StatusPending || d.Attempts != 2 || !d.NextAttemptAt.Equal(want) {
+		t.Fatalf("after second attempt: %+v, want next attempt at %v", d, want)
+	}
+
+	dt.now = d.NextAttemptAt
+	dt.dispatch(1)
+	d = dt.deliveries(w.ID)[0]
+	if d.Status != StatusSucceeded || d.Attempts != 3 || d.Error != "" {
+		t.Fatalf("after third attempt: %+v", d)
+	}
+	if got := dt.store.webhook(w.ID).FailureCount; got != 0 {
+		t.Errorf("failure count = %d after success, want 0", got)
+	}
+	if len(r.received()) != 3 {
+		t.Errorf("receiver got %d requests, want 3", len(r.received()))
+	}
+}
+
+func TestDispatcherFailsAfterMaxAttempts(t *testing.T) {
+	opts := DefaultOptions()
+	opts.MaxAttempts = 2
+	dt := newDispatcherTest(t, opts)
+	r := newReceiver(t, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway)
+	w := dt.webhook(r.URL, EventLike)
+	dt.publish("likes-0-9", EventLike)
+
+	dt.dispatch(1)
+	dt.now = dt.now.Add(opts.BaseBackoff)
+	dt.dispatch(1)
+
+	d := dt.deliveries(w.ID)[0]
+	if d.Status != StatusFailed || d.Attempts != 2 || d.Error == "" {
+		t.Fatalf("unexpected delivery %+v", d)
+	}
+	dt.now = dt.now.Add(opts.MaxBackoff)
+	dt.dispatch(0)
+}
+
+func TestDispatcherDisablesWebhookAfterMaxFailures(t *testing.T) {
+	opts := DefaultOptions()
+	opts.MaxAttempts = 1
+	opts.MaxFailures = 3
+	dt := newDispatcherTest(t, opts)
+	r := newReceiver(t, http.StatusGone, http.StatusGone, http.StatusGone, http.StatusGone)
+	w := dt.webhook(r.URL, EventDirectMessage)
+
+	for i := 0; i < opts.MaxFailures; i++ {
+		if !dt.store.webhook(w.ID).Active {
+			t.Fatalf("webhook disabled after %d failures", i)
+		}
+		dt.publish(fmt.Sprintf("directs-0-%d", i), EventDirectMessage)
+		dt.dispatch(1)
+	}
+
+	disabled := dt.store.webhook(w.ID)
+	if disabled.Active || disabled.FailureCount != opts.MaxFailures || disabled.DisabledReason == "" {
+		t.Fatalf("unexpected webhook %+v", disabled)
+	}
+	// o'chirilgan webhookka yangi hodisa navbatga qo'yilmaydi
+	dt.publish("directs-0-z", EventDirectMessage)
+	dt.dispatch(0)
+	if len(dt.deliveries(w.ID)) != opts.MaxFailures {
+		t.Errorf("got %d deliveries, want %d", len(dt.deliveries(w.ID)), opts.MaxFailures)
+	}
+
+	// qayta yoqilganda xatolar hisobi nolga tushadi
+	active := true
+	enabled, err := NewService(dt.store, true).Update(context.Background(), w.ID, w.UserID, Update{Active: &active})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !enabled.Active || enabled.FailureCount != 0 || enabled.DisabledReason != "" {
+		t.Errorf("unexpected webhook after re-enabling %+v", enabled)
+	}
+}
+
+func TestDispatcherRefusesPrivateNetworks(t *testing.T) {
+	r := newReceiver(t)
+	store := newMemoryStore()
+	w, err := NewService(store, true).Create(context.Background(), 7, r.URL, []string{EventFollow})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Fanout(context.Background(), store, Event{ID: "follows-0-1", Type: EventFollow, UserID: 7, CreatedAt: time.Now()}); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := DefaultOptions()
+	opts.MaxAttempts = 1
+	if _, err := NewDispatcher(store, opts).Dispatch(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(r.received()) != 0 {
+		t.Fatal("request was sent to a loopback address")
+	}
+	page, _ := pagination.New(10, "")
+	deliveries, _ := store.Deliveries(context.Background(), w.ID, page)
+	if len(deliveries) != 1 || deliveries[0].Status != StatusFailed || !strings.Contains(deliveries[0].Error, errPrivateNetwork.Error()) {
+		t.Fatalf("unexpected deliveries %+v", deliveries)
+	}
+}
+
+func TestPublicAddr(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"0.0.0.0", false},
+		{"100.64.0.1", false},
+		{"100.127.255.254", false},
+		{"198.18.0.1", false},
+		{"198.19.255.254", false},
+		{"240.0.0.1", false},
+		{"255.255.255.255", false},
+		{"224.0.0.1", false},
+		{"::1", false},
+		{"fe80::1", false},
+		{"fc00::1", false},
+		{"::ffff:10.0.0.1", false},
+		{"::ffff:100.64.0.1", false},
+		{"64:ff9b::a00:1", false},
+	}
+	for _, tt := range tests {
+		if got := publicAddr(netip.MustParseAddr(tt.addr)); got != tt.want {
+			t.Errorf("publicAddr(%s) = %v, want %v", tt.addr, got, tt.want)
+		}
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	opts := Options{BaseBackoff: 30 * time.Second, MaxBackoff: 5 * time.Minute}
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{4, 4 * time.Minute},
+		{5, 5 * time.Minute},
+		{20, 5 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := opts.backoff(tt.attempt); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempt, got, tt.want)
+		}
+	}
+}
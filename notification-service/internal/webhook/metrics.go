@@ -0,0 +1,19 @@
+package webhook
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// deliveryAttempts result: success, retry yoki failed (urinishlar tugagan)
+	deliveryAttempts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "webhook_delivery_attempts_total",
+		Help: "Number of webhook delivery attempts, by event type and result (success, retry, failed).",
+	}, []string{"event_type", "result"})
+
+	webhooksDisabled = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "webhooks_disabled_total",
+		Help: "Number of webhooks disabled after repeated delivery failures.",
+	})
+)
@@ -0,0 +1,163 @@
+package webhook
+
+import (
+	"context"
+	"net/url"
+
+	"pkg/pagination"
+)
+
+// Service webhooklarni boshqarish: URL va hodisalarni tekshiradi, imzo kalitini
+// yaratadi. Barcha amallar faqat webhook egasi uchun.
+type Service struct {
+	store Store
+	// allowInsecure http:// URL larga ruxsat beradi (faqat lokal ishlab chiqish uchun)
+	allowInsecure bool
+}
+
+func NewService(store Store, allowInsecure bool) *Service {
+	return &Service{store: store, allowInsecure: allowInsecure}
+}
+
+// Update berilmagan (nil yoki bo'sh) maydonlar o'zgarmaydi
+type Update struct {
+	URL    *string
+	Events []string
+	Active *bool
+}
+
+// Create yangi webhook yaratadi. Secret faqat shu javobda qaytariladi.
+func (s *Service) Create(ctx context.Context, userID int32, rawURL string, events []string) (*Webhook, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidUserID
+	}
+	if err := s.validateURL(rawURL); err != nil {
+		return nil, err
+	}
+	events, err := normalizeEvents(events)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := NewSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	webhook := &Webhook{UserID: userID, URL: rawURL, Secret: secret, Events: events, Active: true}
+	if err := s.store.Create(ctx, webhook); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func (s *Service) Get(ctx context.Context, id int64, userID int32) (*Webhook, error) {
+	return s.store.Get(ctx, id, userID)
+}
+
+func (s *Service) List(ctx context.Context, userID int32) ([]*Webhook, error) {
+	return s.store.List(ctx, userID)
+}
+
+// Update webhookni o'zgartiradi. Qayta yoqilgan webhookning xatolar hisobi
+// nolga tushadi va kutib turgan yetkazishlar davom etadi.
+func (s *Service) Update(ctx context.Context, id int64, userID int32, update Update) (*Webhook, error) {
+	webhook, err := s.store.Get(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if update.URL != nil {
+		if err := s.validateURL(*update.URL); err != nil {
+			return nil, err
+		}
+		webhook.URL = *update.URL
+	}
+	if len(update.Events) > 0 {
+		events, err := normalizeEvents(update.Events)
+		if err != nil {
+			return nil, err
+		}
+		webhook.Events = events
+	}
+	if update.Active != nil {
+		if *update.Active && !webhook.Active {
+			webhook.FailureCount = 0
+			webhook.DisabledReason = ""
+		}
+		webhook.Active = *update.Active
+	}
+
+	if err := s.store.Update(ctx, webhook); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func (s *Service) Delete(ctx context.Context, id int64, userID int32) error {
+	return s.store.Delete(ctx, id, userID)
+}
+
+// RotateSecret yangi imzo kalitini yaratadi, eskisi darhol ishlamay qoladi
+func (s *Service) RotateSecret(ctx context.Context, id int64, userID int32) (string, error) {
+	secret, err := NewSecret()
+	if err != nil {
+		return "", err
+	}
+	if err := s.store.SetSecret(ctx, id, userID, secret); err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+// Deliveries webhookning yetkazish logi, yangilari birinchi
+func (s *Service) Deliveries(ctx context.Context, id int64, userID int32, page pagination.Page) ([]*Delivery, string, error) {
+	if _, err := s.store.Get(ctx, id, userID); err != nil {
+		return nil, "", err
+	}
+	deliveries, err := s.store.Deliveries(ctx, id, page)
+	if err != nil {
+		return nil, "", err
+	}
+	deliveries, next := pagination.Trim(deliveries, page, func(delivery *Delivery) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: delivery.CreatedAt, ID: delivery.ID}
+	})
+	return deliveries, next, nil
+}
+
+func (s *Service) validateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" || u.User != nil {
+		return ErrInvalidURL
+	}
+	if u.Scheme != "https" && !(s.allowInsecure && u.Scheme == "http") {
+		return ErrInvalidURL
+	}
+	return nil
+}
+
+// normalizeEvents takrorlarni olib tashlaydi va noma'lum hodisalarni rad etadi
+func normalizeEvents(events []string) ([]string, error) {
+	if len(events) == 0 {
+		return nil, ErrInvalidEvents
+	}
+	seen := make(map[string]bool, len(events))
+	var normalized []string
+	for _, event := range events {
+		if !knownEvent(event) {
+			return nil, ErrInvalidEvents
+		}
+		if !seen[event] {
+			seen[event] = true
+			normalized = append(normalized, event)
+		}
+	}
+	return normalized, nil
+}
+
+func knownEvent(event string) bool {
+	for _, known := range EventTypes {
+		if event == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Yetkazish headerlari. Qabul qiluvchi imzoni Verify dagi kabi tekshiradi va
+// X-Webhook-Delivery bo'yicha takroriy so'rovlarni tashlab yuboradi.
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// signaturePrefix imzo algoritmi, keyinchalik boshqasiga o'tish uchun
+const signaturePrefix = "sha256="
+
+// NewSecret tasodifiy imzo kaliti
+func NewSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("error generating webhook secret: %v", err)
+	}
+	return "whsec_" + hex.EncodeToString(secret), nil
+}
+
+// Sign "timestamp.body" ning HMAC-SHA256 imzosi. Timestamp imzoga kirgani uchun
+// ushlab olingan so'rovni keyinroq qayta yuborib bo'lmaydi.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify qabul qiluvchi tomonda imzoni va timestamp eskirmaganini tekshiradi
+func Verify(secret, timestamp, signature string, body []byte, tolerance time.Duration, now time.Time) bool {
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+	sent := time.Unix(unix, 0)
+	if now.Sub(sent) > tolerance || sent.Sub(now) > tolerance {
+		return false
+	}
+	return hmac.Equal([]byte(Sign(secret, sent, body)), []byte(signature))
+}
@@ -0,0 +1,49 @@
+package webhook
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVerify(t *testing.T) {
+	secret, err := NewSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(secret, "whsec_") {
+		t.Fatalf("secret %q has no whsec_ prefix", secret)
+	}
+
+	sent := time.Unix(1791000000, 0)
+	body := []byte(`{"id":"follows-0-1","type":"follow"}`)
+	timestamp := strconv.FormatInt(sent.Unix(), 10)
+	signature := Sign(secret, sent, body)
+
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp string
+		signature string
+		body      []byte
+		now       time.Time
+		want      bool
+	}{
+		{"valid", secret, timestamp, signature, body, sent.Add(time.Minute), true},
+		{"changed body", secret, timestamp, signature, []byte(`{"id":"follows-0-2","type":"follow"}`), sent, false},
+		{"other secret", "whsec_other", timestamp, signature, body, sent, false},
+		{"changed timestamp", secret, strconv.FormatInt(sent.Unix()+1, 10), signature, body, sent, false},
+		{"expired", secret, timestamp, signature, body, sent.Add(6 * time.Minute), false},
+		{"from the future", secret, timestamp, signature, body, sent.Add(-6 * time.Minute), false},
+		{"no prefix", secret, timestamp, strings.TrimPrefix(signature, signaturePrefix), body, sent, false},
+		{"bad timestamp", secret, "yesterday", signature, body, sent, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Verify(tt.secret, tt.timestamp, tt.signature, tt.body, 5*time.Minute, tt.now); got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,300 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"pkg/pagination"
+
+	"github.com/lib/pq"
+)
+
+// Attempt bitta yetkazish urinishining natijasi
+type Attempt struct {
+	StatusCode int
+	// Err bo'sh bo'lsa urinish muvaffaqiyatli
+	Err string
+	At  time.Time
+	// RetryAt bo'sh bo'lmasa yetkazish shu vaqtda qayta uriniladi, aks holda failed bo'ladi
+	RetryAt time.Time
+}
+
+// OK endpoint 2xx qaytardi
+func (a Attempt) OK() bool {
+	return a.Err == ""
+}
+
+// Store webhooklar va yetkazish navbati
+type Store interface {
+	Create(ctx context.Context, webhook *Webhook) error
+	Get(ctx context.Context, id int64, userID int32) (*Webhook, error)
+	List(ctx context.Context, userID int32) ([]*Webhook, error)
+	Update(ctx context.Context, webhook *Webhook) error
+	Delete(ctx context.Context, id int64, userID int32) error
+	SetSecret(ctx context.Context, id int64, userID int32, secret string) error
+	Deliveries(ctx context.Context, webhookID int64, page pagination.Page) ([]*Delivery, error)
+
+	// Subscriptions userID ning eventType ga obuna bo'lgan faol webhooklari
+	Subscriptions(ctx context.Context, userID int32, eventType string) ([]*Webhook, error)
+	// Enqueue yetkazishni navbatga qo'yadi. Bir webhook uchun bir xil event.ID ikkinchi marta yozilmaydi.
+	Enqueue(ctx context.Context, webhookID int64, event Event, payload []byte) error
+	// Claim vaqti kelgan yetkazishlarni oladi va ularni lease davomida boshqa
+	// workerlardan yashiradi. Worker to'xtab qolsa yetkazish lease dan keyin qayta olinadi.
+	Claim(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*Delivery, error)
+	// RecordAttempt urinishni logga yozadi va webhookning ketma-ket xatolar sonini qaytaradi
+	RecordAttempt(ctx context.Context, delivery *Delivery, attempt Attempt) (int, error)
+	// Disable webhookni o'chiradi, navbatdagi yetkazishlar qayta yoqilguncha kutadi
+	Disable(ctx context.Context, webhookID int64, reason string) error
+}
+
+// PostgresStore Store ning Postgres dagi amalga oshirilishi
+type PostgresStore struct {
+	db *sql.DB
+}
+
+func NewPostgresStore(db *sql.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+const webhookColumns = `id, user_id, url, secret, events, active, failure_count, disabled_reason, created_at, updated_at`
+
+func scanWebhook(row interface{ Scan(...any) error }) (*Webhook, error) {
+	var webhook Webhook
+	err := row.Scan(&webhook.ID, &webhook.UserID, &webhook.URL, &webhook.Secret, pq.Array(&webhook.Events), &webhook.Active, &webhook.FailureCount, &webhook.DisabledReason, &webhook.CreatedAt, &webhook.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+func (s *PostgresStore) Create(ctx context.Context, webhook *Webhook) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	// bir foydalanuvchining parallel Create lari navbatma-navbat bajariladi, aks
+	// holda ikkalasi ham COUNT ni limitdan past ko'rib limitdan oshib ketadi.
+	// Lock tranzaksiya tugaganda bo'shaydi.
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('webhooks'), $1)`, webhook.UserID); err != nil {
+		return fmt.Errorf("error locking user webhooks: %v", err)
+	}
+	query := `INSERT INTO webhooks (user_id, url, secret, events)
+		SELECT $1, $2, $3, $4
+		WHERE (SELECT COUNT(*) FROM webhooks WHERE user_id = $1) < $5
+		RETURNING ` + webhookColumns
+	created, err := scanWebhook(tx.QueryRowContext(ctx, query, webhook.UserID, webhook.URL, webhook.Secret, pq.Array(webhook.Events), MaxWebhooksPerUser))
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrTooManyWebhooks
+	}
+	if err != nil {
+		return fmt.Errorf("error creating webhook: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing webhook: %v", err)
+	}
+	*webhook = *created
+	return nil
+}
+
+func (s *PostgresStore) Get(ctx context.Context, id int64, userID int32) (*Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhooks WHERE id = $1 AND user_id = $2`
+	webhook, err := scanWebhook(s.db.QueryRowContext(ctx, query, id, userID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrWebhookNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting webhook: %v", err)
+	}
+	return webhook, nil
+}
+
+func (s *PostgresStore) List(ctx context.Context, userID int32) ([]*Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhooks WHERE user_id = $1 ORDER BY id`
+	rows, err := s.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing webhooks: %v", err)
+	}
+	defer rows.Close()
+
+	webhooks := []*Webhook{}
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning webhook: %v", err)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	return webhooks, rows.Err()
+}
+
+func (s *PostgresStore) Update(ctx context.Context, webhook *Webhook) error {
+	query := `UPDATE webhooks
+		SET url = $3, events = $4, active = $5, failure_count = $6, disabled_reason = $7, updated_at = NOW()
+		WHERE id = $1 AND user_id = $2
+		RETURNING updated_at`
+	err := s.db.QueryRowContext(ctx, query, webhook.ID, webhook.UserID, webhook.URL, pq.Array(webhook.Events), webhook.Active, webhook.FailureCount, webhook.DisabledReason).Scan(&webhook.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrWebhookNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("error updating webhook: %v", err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) Delete(ctx context.Context, id int64, userID int32) error {
+	// yetkazish logi ON DELETE CASCADE bilan o'chadi
+	result, err := s.db.ExecContext(ctx, `DELETE FROM webhooks WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		return fmt.Errorf("error deleting webhook: %v", err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (s *PostgresStore) SetSecret(ctx context.Context, id int64, userID int32, secret string) error {
+	result, err := s.db.ExecContext(ctx, `UPDATE webhooks SET secret = $3, updated_at = NOW() WHERE id = $1 AND user_id = $2`, id, userID, secret)
+	if err != nil {
+		return fmt.Errorf("error updating webhook secret: %v", err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (s *PostgresStore) Deliveries(ctx context.Context, webhookID int64, page pagination.Page) ([]*Delivery, error) {
+	query := `SELECT id, webhook_id, event_id, event_type, status, attempts, response_status, error, created_at, next_attempt_at, delivered_at
+		FROM webhook_deliveries
+		WHERE webhook_id = $1 AND (created_at, id) < ($2, $3)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $4`
+	rows, err := s.db.QueryContext(ctx, query, webhookID, page.After.CreatedAt, page.After.ID, page.Limit())
+	if err != nil {
+		return nil, fmt.Errorf("error listing webhook deliveries: %v", err)
+	}
+	defer rows.Close()
+
+	deliveries := []*Delivery{}
+	for rows.Next() {
+		var delivery Delivery
+		var deliveredAt sql.NullTime
+		if err := rows.Scan(&delivery.ID, &delivery.WebhookID, &delivery.EventID, &delivery.EventType, &delivery.Status, &delivery.Attempts, &delivery.ResponseStatus, &delivery.Error, &delivery.CreatedAt, &delivery.NextAttemptAt, &deliveredAt); err != nil {
+			return nil, fmt.Errorf("error scanning webhook delivery: %v", err)
+		}
+		delivery.DeliveredAt = deliveredAt.Time
+		deliveries = append(deliveries, &delivery)
+	}
+	return deliveries, rows.Err()
+}
+
+func (s *PostgresStore) Subscriptions(ctx context.Context, userID int32, eventType string) ([]*Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhooks WHERE user_id = $1 AND active AND $2 = ANY(events)`
+	rows, err := s.db.QueryContext(ctx, query, userID, eventType)
+	if err != nil {
+		return nil, fmt.Errorf("error getting webhook subscriptions: %v", err)
+	}
+	defer rows.Close()
+
+	var webhooks []*Webhook
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning webhook: %v", err)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	return webhooks, rows.Err()
+}
+
+func (s *PostgresStore) Enqueue(ctx context.Context, webhookID int64, event Event, payload []byte) error {
+	query := `INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (webhook_id, event_id) DO NOTHING`
+	if _, err := s.db.ExecContext(ctx, query, webhookID, event.ID, event.Type, payload); err != nil {
+		return fmt.Errorf("error enqueueing webhook delivery: %v", err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) Claim(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*Delivery, error) {
+	// SKIP LOCKED bir nechta replika bitta yetkazishni ikki marta olmasligi uchun
+	query := `UPDATE webhook_deliveries d
+		SET next_attempt_at = $2
+		FROM webhooks w
+		WHERE w.id = d.webhook_id AND d.id IN (
+			SELECT pending.id FROM webhook_deliveries pending
+			JOIN webhooks hook ON hook.id = pending.webhook_id
+			WHERE pending.status = 'pending' AND pending.next_attempt_at <= $1 AND hook.active
+			ORDER BY pending.next_attempt_at
+			LIMIT $3
+			FOR UPDATE OF pending SKIP LOCKED
+		)
+		RETURNING d.id, d.webhook_id, d.event_id, d.event_type, d.payload, d.attempts, d.created_at, w.url, w.secret`
+	rows, err := s.db.QueryContext(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("error claiming webhook deliveries: %v", err)
+	}
+	defer rows.Close()
+
+	var deliveries []*Delivery
+	for rows.Next() {
+		delivery := Delivery{Status: StatusPending}
+		if err := rows.Scan(&delivery.ID, &delivery.WebhookID, &delivery.EventID, &delivery.EventType, &delivery.Payload, &delivery.Attempts, &delivery.CreatedAt, &delivery.URL, &delivery.Secret); err != nil {
+			return nil, fmt.Errorf("error scanning webhook delivery: %v", err)
+		}
+		deliveries = append(deliveries, &delivery)
+	}
+	return deliveries, rows.Err()
+}
+
+func (s *PostgresStore) RecordAttempt(ctx context.Context, delivery *Delivery, attempt Attempt) (int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("error starting transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	status, nextAttemptAt, deliveredAt := StatusFailed, attempt.At, sql.NullTime{}
+	switch {
+	case attempt.OK():
+		status, deliveredAt = StatusSucceeded, sql.NullTime{Time: attempt.At, Valid: true}
+	case !attempt.RetryAt.IsZero():
+		status, nextAttemptAt = StatusPending, attempt.RetryAt
+	}
+	_, err = tx.ExecContext(ctx, `UPDATE webhook_deliveries
+		SET status = $2, attempts = attempts + 1, response_status = $3, error = $4, next_attempt_at = $5, delivered_at = $6
+		WHERE id = $1`,
+		delivery.ID, status, attempt.StatusCode, attempt.Err, nextAttemptAt, deliveredAt)
+	if err != nil {
+		return 0, fmt.Errorf("error recording webhook delivery: %v", err)
+	}
+
+	query := `UPDATE webhooks SET failure_count = failure_count + 1 WHERE id = $1 RETURNING failure_count`
+	if attempt.OK() {
+		query = `UPDATE webhooks SET failure_count = 0 WHERE id = $1 RETURNING failure_count`
+	}
+	var failures int
+	if err := tx.QueryRowContext(ctx, query, delivery.WebhookID).Scan(&failures); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("error updating webhook failures: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("error committing webhook delivery: %v", err)
+	}
+	return failures, nil
+}
+
+func (s *PostgresStore) Disable(ctx context.Context, webhookID int64, reason string) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE webhooks SET active = FALSE, disabled_reason = $2, updated_at = NOW() WHERE id = $1`, webhookID, reason)
+	if err != nil {
+		return fmt.Errorf("error disabling webhook: %v", err)
+	}
+	return nil
+}
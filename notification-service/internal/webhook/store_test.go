@@ -0,0 +1,226 @@
+package webhook
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"pkg/pagination"
+)
+
+// memoryStore testlar uchun Store, PostgresStore dagi holat o'zgarishlarini takrorlaydi
+type memoryStore struct {
+	mu         sync.Mutex
+	webhooks   map[int64]*Webhook
+	deliveries map[int64]*Delivery
+	nextID     int64
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{webhooks: map[int64]*Webhook{}, deliveries: map[int64]*Delivery{}}
+}
+
+func (s *memoryStore) id() int64 {
+	s.nextID++
+	return s.nextID
+}
+
+func (s *memoryStore) Create(_ context.Context, webhook *Webhook) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	count := 0
+	for _, w := range s.webhooks {
+		if w.UserID == webhook.UserID {
+			count++
+		}
+	}
+	if count >= MaxWebhooksPerUser {
+		return ErrTooManyWebhooks
+	}
+	webhook.ID = s.id()
+	webhook.CreatedAt, webhook.UpdatedAt = time.Now(), time.Now()
+	stored := *webhook
+	s.webhooks[webhook.ID] = &stored
+	return nil
+}
+
+func (s *memoryStore) Get(_ context.Context, id int64, userID int32) (*Webhook, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w, ok := s.webhooks[id]
+	if !ok || w.UserID != userID {
+		return nil, ErrWebhookNotFound
+	}
+	copied := *w
+	return &copied, nil
+}
+
+func (s *memoryStore) List(_ context.Context, userID int32) ([]*Webhook, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var webhooks []*Webhook
+	for _, w := range s.webhooks {
+		if w.UserID == userID {
+			copied := *w
+			webhooks = append(webhooks, &copied)
+		}
+	}
+	sort.Slice(webhooks, func(i, j int) bool { return webhooks[i].ID < webhooks[j].ID })
+	return webhooks, nil
+}
+
+func (s *memoryStore) Update(_ context.Context, webhook *Webhook) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w, ok := s.webhooks[webhook.ID]
+	if !ok || w.UserID != webhook.UserID {
+		return ErrWebhookNotFound
+	}
+	stored := *webhook
+	s.webhooks[webhook.ID] = &stored
+	return nil
+}
+
+func (s *memoryStore) Delete(_ context.Context, id int64, userID int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w, ok := s.webhooks[id]
+	if !ok || w.UserID != userID {
+		return ErrWebhookNotFound
+	}
+	delete(s.webhooks, id)
+	for deliveryID, d := range s.deliveries {
+		if d.WebhookID == id {
+			delete(s.deliveries, deliveryID)
+		}
+	}
+	return nil
+}
+
+func (s *memoryStore) SetSecret(_ context.Context, id int64, userID int32, secret string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w, ok := s.webhooks[id]
+	if !ok || w.UserID != userID {
+		return ErrWebhookNotFound
+	}
+	w.Secret = secret
+	return nil
+}
+
+func (s *memoryStore) Deliveries(_ context.Context, webhookID int64, page pagination.Page) ([]*Delivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var deliveries []*Delivery
+	for _, d := range s.deliveries {
+		if d.WebhookID == webhookID {
+			copied := *d
+			deliveries = append(deliveries, &copied)
+		}
+	}
+	sort.Slice(deliveries, func(i, j int) bool { return deliveries[i].ID > deliveries[j].ID })
+	if len(deliveries) > page.Limit() {
+		deliveries = deliveries[:page.Limit()]
+	}
+	return deliveries, nil
+}
+
+func (s *memoryStore) Subscriptions(_ context.Context, userID int32, eventType string) ([]*Webhook, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var webhooks []*Webhook
+	for _, w := range s.webhooks {
+		if w.UserID == userID && w.Active && w.Subscribed(eventType) {
+			copied := *w
+			webhooks = append(webhooks, &copied)
+		}
+	}
+	return webhooks, nil
+}
+
+func (s *memoryStore) Enqueue(_ context.Context, webhookID int64, event Event, payload []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, d := range s.deliveries {
+		if d.WebhookID == webhookID && d.EventID == event.ID {
+			return nil
+		}
+	}
+	id := s.id()
+	s.deliveries[id] = &Delivery{
+		ID:            id,
+		WebhookID:     webhookID,
+		EventID:       event.ID,
+		EventType:     event.Type,
+		Payload:       payload,
+		Status:        StatusPending,
+		CreatedAt:     event.CreatedAt,
+		NextAttemptAt: event.CreatedAt,
+	}
+	return nil
+}
+
+func (s *memoryStore) Claim(_ context.Context, now time.Time, lease time.Duration, limit int) ([]*Delivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var due []*Delivery
+	for _, d := range s.deliveries {
+		w := s.webhooks[d.WebhookID]
+		if d.Status == StatusPending && !d.NextAttemptAt.After(now) && w.Active {
+			due = append(due, d)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].NextAttemptAt.Before(due[j].NextAttemptAt) })
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	claimed := make([]*Delivery, len(due))
+	for i, d := range due {
+		d.NextAttemptAt = now.Add(lease)
+		w := s.webhooks[d.WebhookID]
+		copied := *d
+		copied.URL, copied.Secret = w.URL, w.Secret
+		claimed[i] = &copied
+	}
+	return claimed, nil
+}
+
+func (s *memoryStore) RecordAttempt(_ context.Context, delivery *Delivery, attempt Attempt) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := s.deliveries[delivery.ID]
+	d.Attempts++
+	d.ResponseStatus, d.Error, d.NextAttemptAt = attempt.StatusCode, attempt.Err, attempt.At
+	switch {
+	case attempt.OK():
+		d.Status, d.DeliveredAt = StatusSucceeded, attempt.At
+	case !attempt.RetryAt.IsZero():
+		d.Status, d.NextAttemptAt = StatusPending, attempt.RetryAt
+	default:
+		d.Status = StatusFailed
+	}
+
+	w := s.webhooks[delivery.WebhookID]
+	if attempt.OK() {
+		w.FailureCount = 0
+	} else {
+		w.FailureCount++
+	}
+	return w.FailureCount, nil
+}
+
+func (s *memoryStore) Disable(_ context.Context, webhookID int64, reason string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w := s.webhooks[webhookID]
+	w.Active, w.DisabledReason = false, reason
+	return nil
+}
+
+func (s *memoryStore) webhook(id int64) Webhook {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return *s.webhooks[id]
+}
@@ -0,0 +1,123 @@
+// Package webhook tashqi integratsiyalar uchun chiquvchi webhooklar. Foydalanuvchi
+// endpoint va hodisa turlarini ro'yxatdan o'tkazadi; Kafka dagi follow, like,
+// comment va direct bildirishnomalari har bir mos webhook uchun yetkazish
+// navbatiga yoziladi, Dispatcher esa ularni HMAC imzosi bilan POST qiladi.
+// Muvaffaqiyatsiz yetkazishlar eksponensial kutish bilan qayta uriniladi, ketma-ket
+// ko'p xato bergan webhook avtomatik o'chiriladi.
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+
+	"pkg/grpcerr"
+)
+
+// Hodisa turlari
+const (
+	EventFollow        = "follow"
+	EventLike          = "like"
+	EventComment       = "comment"
+	EventDirectMessage = "direct_message"
+)
+
+// EventTypes webhook obuna bo'lishi mumkin bo'lgan barcha hodisalar
+var EventTypes = []string{EventFollow, EventLike, EventComment, EventDirectMessage}
+
+// Yetkazish holatlari
+const (
+	StatusPending   = "pending"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+// MaxWebhooksPerUser bitta foydalanuvchi yarata oladigan webhooklar soni
+const MaxWebhooksPerUser = 10
+
+// Servis xatolari. Reason qiymatlari gateway javobida "code" bo'lib chiqadi.
+var (
+	ErrUnauthenticated = grpcerr.Unauthenticated("UNAUTHENTICATED", "caller is not authenticated")
+	ErrNotCaller       = grpcerr.PermissionDenied("NOT_CALLER", "you can only manage your own webhooks")
+	ErrWebhookNotFound = grpcerr.NotFound("WEBHOOK_NOT_FOUND", "webhook not found")
+	ErrInvalidURL      = grpcerr.InvalidArgument("INVALID_WEBHOOK_URL", "url", "url must be an absolute https URL")
+	ErrInvalidEvents   = grpcerr.InvalidArgument("INVALID_WEBHOOK_EVENTS", "events", "events must contain follow, like, comment or direct_message")
+	ErrTooManyWebhooks = grpcerr.FailedPrecondition("WEBHOOK_LIMIT_REACHED", "webhook limit reached")
+	ErrInvalidUserID   = grpcerr.InvalidArgument("INVALID_USER_ID", "user_id", "user_id must be positive")
+)
+
+// Webhook foydalanuvchining endpointi
+type Webhook struct {
+	ID     int64
+	UserID int32
+	URL    string
+	// Secret payload imzosi uchun HMAC kaliti
+	Secret string
+	Events []string
+	Active bool
+	// FailureCount ketma-ket muvaffaqiyatsiz urinishlar
+	FailureCount   int
+	DisabledReason string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Subscribed webhook eventType ga obuna bo'lganmi
+func (w *Webhook) Subscribed(eventType string) bool {
+	for _, event := range w.Events {
+		if event == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Event Kafka bildirishnomasidan olingan hodisa
+type Event struct {
+	// ID Kafka dagi joyidan olinadi, qayta o'qilgan xabar yangi yetkazish yaratmaydi
+	ID   string
+	Type string
+	// UserID hodisa kimga tegishli: yangi kuzatuvchi, like yoki comment olgan, xabar qabul qilgan foydalanuvchi
+	UserID    int32
+	CreatedAt time.Time
+	Data      json.RawMessage
+}
+
+// Payload endpointga yuboriladigan JSON
+type Payload struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	UserID    int32           `json:"user_id"`
+	CreatedAt string          `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// NewPayload hodisaning JSON ko'rinishi
+func NewPayload(event Event) ([]byte, error) {
+	return json.Marshal(Payload{
+		ID:        event.ID,
+		Type:      event.Type,
+		UserID:    event.UserID,
+		CreatedAt: event.CreatedAt.UTC().Format(time.RFC3339),
+		Data:      event.Data,
+	})
+}
+
+// Delivery bitta hodisani bitta webhookka yetkazish va uning logi
+type Delivery struct {
+	ID             int64
+	WebhookID      int64
+	EventID        string
+	EventType      string
+	Payload        []byte
+	Status         string
+	Attempts       int
+	ResponseStatus int
+	Error          string
+	CreatedAt      time.Time
+	NextAttemptAt  time.Time
+	DeliveredAt    time.Time
+
+	// Claim qaytaradigan webhook ma'lumotlari
+	URL    string
+	Secret string
+}
@@ -0,0 +1,12 @@
+package websocket
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// connections hozir ochiq turgan WebSocket ulanishlari soni
+var connections = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "websocket_connections",
+	Help: "Number of live WebSocket connections.",
+})
@@ -1,9 +1,12 @@
 package websocket
 
 import (
-	"net/http"
 	"github.com/gorilla/websocket"
-	"log"
+	"log/slog"
+	"net/http"
+	"pkg/logger"
+	"pkg/metrics"
+	"sync"
 )
 
 var upgrader = websocket.Upgrader{
@@ -16,44 +19,66 @@ type Client struct {
 	conn *websocket.Conn
 }
 
-var clients = make(map[*Client]bool)
+// clientsMu clients ni himoya qiladi. Broadcast paytida ham ushlab turiladi:
+// gorilla/websocket bitta ulanishga parallel yozishga ruxsat bermaydi.
+var (
+	clientsMu sync.Mutex
+	clients   = make(map[*Client]bool)
+)
 
 func StartWebSocketServer() {
 	http.HandleFunc("/ws", handleConnections)
-	log.Println("WebSocket server is running on :8080")
+	http.Handle("/metrics", metrics.Handler())
+	slog.Info("websocket server started", "port", "8080")
 	err := http.ListenAndServe(":8080", nil)
 	if err != nil {
-		log.Fatal("ListenAndServe: ", err)
+		logger.Fatal("websocket server failed", "error", err)
 	}
 }
 
 func handleConnections(w http.ResponseWriter, r *http.Request) {
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
-		log.Println(err)
+		slog.Warn("websocket upgrade failed", "error", err)
 		return
 	}
 	defer conn.Close()
 
 	client := &Client{conn: conn}
+	clientsMu.Lock()
 	clients[client] = true
+	clientsMu.Unlock()
+	connections.Inc()
 
 	for {
 		_, _, err := conn.ReadMessage()
 		if err != nil {
-			delete(clients, client)
+			clientsMu.Lock()
+			removeClient(client)
+			clientsMu.Unlock()
 			break
 		}
 	}
 }
 
+// removeClient clientsMu ushlab turilganda chaqiriladi. Ulanish o'qishda ham,
+// yozishda ham uzilishi mumkin, gauge faqat birinchi o'chirishda kamayadi.
+func removeClient(client *Client) {
+	if clients[client] {
+		delete(clients, client)
+		connections.Dec()
+	}
+}
+
 func BroadcastMessage(message []byte) {
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
 	for client := range clients {
 		err := client.conn.WriteMessage(websocket.TextMessage, message)
 		if err != nil {
-			log.Println(err)
+			slog.Warn("websocket write failed", "error", err)
 			client.conn.Close()
-			delete(clients, client)
+			removeClient(client)
 		}
 	}
 }
\ No newline at end of file
@@ -0,0 +1,81 @@
+package websocket
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// waitConnections gauge kutilgan qiymatga yetguncha kutadi
+func waitConnections(t *testing.T, want float64) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for testutil.ToFloat64(connections) != want {
+		if time.Now().After(deadline) {
+			t.Fatalf("websocket_connections = %v, want %v", testutil.ToFloat64(connections), want)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestConnectionsAndBroadcast(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(handleConnections))
+	defer server.Close()
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+	start := testutil.ToFloat64(connections)
+
+	const n = 10
+	conns := make([]*websocket.Conn, n)
+	var wg sync.WaitGroup
+	for i := range conns {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			conns[i] = conn
+		}(i)
+	}
+	wg.Wait()
+	if t.Failed() {
+		t.FailNow()
+	}
+	waitConnections(t, start+n)
+
+	// parallel broadcastlar bitta ulanishga bir vaqtda yozmaydi
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			BroadcastMessage([]byte("hello"))
+		}()
+	}
+	wg.Wait()
+	for _, conn := range conns {
+		conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+		for i := 0; i < 3; i++ {
+			if _, msg, err := conn.ReadMessage(); err != nil || string(msg) != "hello" {
+				t.Fatalf("message %d: %q, %v", i+1, msg, err)
+			}
+		}
+	}
+
+	for _, conn := range conns {
+		conn.Close()
+	}
+	waitConnections(t, start)
+	// uzilgan ulanishlarga yozish gauge ni ikkinchi marta kamaytirmaydi
+	BroadcastMessage([]byte("bye"))
+	if got := testutil.ToFloat64(connections); got != start {
+		t.Errorf("websocket_connections after broadcast = %v, want %v", got, start)
+	}
+}
@@ -0,0 +1,132 @@
+package grpcclient
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrCircuitOpen breaker ochiq bo'lganda chaqiruv yuborilmasdan qaytariladi
+var ErrCircuitOpen = status.Error(codes.Unavailable, "circuit breaker is open")
+
+type breakerState int
+
+const (
+	stateClosed breakerState = iota
+	stateOpen
+	stateHalfOpen
+)
+
+func (s breakerState) String() string {
+	switch s {
+	case stateOpen:
+		return "open"
+	case stateHalfOpen:
+		return "half-open"
+	}
+	return "closed"
+}
+
+// Breaker bitta downstream servis uchun circuit breaker.
+// Ketma-ket threshold ta xatodan keyin ochiladi, openTimeout o'tgach bitta
+// sinov chaqiruvini o'tkazadi va uning natijasiga qarab yopiladi yoki qayta ochiladi.
+type Breaker struct {
+	name        string
+	threshold   int
+	openTimeout time.Duration
+
+	mu       sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func NewBreaker(name string, threshold int, openTimeout time.Duration) *Breaker {
+	return &Breaker{name: name, threshold: threshold, openTimeout: openTimeout}
+}
+
+// Allow chaqiruvni yuborish mumkinligini tekshiradi
+func (b *Breaker) Allow() error {
+	if b.threshold <= 0 {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case stateOpen:
+		if time.Since(b.openedAt) < b.openTimeout {
+			return ErrCircuitOpen
+		}
+		b.setState(stateHalfOpen)
+		b.probing = true
+		return nil
+	case stateHalfOpen:
+		if b.probing {
+			return ErrCircuitOpen
+		}
+		b.probing = true
+	}
+	return nil
+}
+
+// Record chaqiruv natijasini hisobga oladi
+func (b *Breaker) Record(err error) {
+	if b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !isFailure(err) {
+		b.failures = 0
+		b.probing = false
+		if b.state != stateClosed {
+			b.setState(stateClosed)
+		}
+		return
+	}
+
+	b.failures++
+	b.probing = false
+	if b.state == stateHalfOpen || b.failures >= b.threshold {
+		b.openedAt = time.Now()
+		if b.state != stateOpen {
+			b.setState(stateOpen)
+		}
+	}
+}
+
+func (b *Breaker) setState(state breakerState) {
+	slog.Warn("circuit breaker state changed", "target", b.name, "from", b.state.String(), "to", state.String())
+	b.state = state
+}
+
+func (b *Breaker) Interceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := b.Allow(); err != nil {
+			return err
+		}
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		b.Record(err)
+		return err
+	}
+}
+
+// isFailure faqat servis sog'lig'ini ko'rsatadigan xatolarni hisobga oladi.
+// Biznes xatolari (NotFound, InvalidArgument, Unknown ...) breakerni ochmaydi.
+// Internal ham hisoblanmaydi: grpcerr kutilmagan xatolarni Internal qiladi, bitta
+// metoddagi bug butun servisga chaqiruvlarni to'xtatib qo'ymasligi kerak.
+func isFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
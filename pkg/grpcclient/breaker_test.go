@@ -0,0 +1,122 @@
+package grpcclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errUnavailable = status.Error(codes.Unavailable, "connection refused")
+
+func TestBreakerOpensAfterThreshold(t *testing.T) {
+	b := NewBreaker("user-service", 3, time.Minute)
+
+	for i := 0; i < 2; i++ {
+		if err := b.Allow(); err != nil {
+			t.Fatalf("call %d rejected: %v", i+1, err)
+		}
+		b.Record(errUnavailable)
+	}
+	// muvaffaqiyatli chaqiruv ketma-ket xatolar hisobini nolga tushiradi
+	b.Record(nil)
+	for i := 0; i < 2; i++ {
+		b.Record(errUnavailable)
+	}
+	if err := b.Allow(); err != nil {
+		t.Fatalf("breaker opened before %d consecutive failures: %v", 3, err)
+	}
+
+	b.Record(status.Error(codes.DeadlineExceeded, "timeout"))
+	if err := b.Allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("after threshold: err = %v, want %v", err, ErrCircuitOpen)
+	}
+}
+
+func TestBreakerIgnoresApplicationErrors(t *testing.T) {
+	b := NewBreaker("user-service", 1, time.Minute)
+	for _, code := range []codes.Code{codes.Internal, codes.Unknown, codes.NotFound, codes.InvalidArgument, codes.PermissionDenied, codes.FailedPrecondition} {
+		b.Record(status.Error(code, "application error"))
+		if err := b.Allow(); err != nil {
+			t.Errorf("%s opened the breaker", code)
+		}
+	}
+	for _, code := range []codes.Code{codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted} {
+		b := NewBreaker("user-service", 1, time.Minute)
+		b.Record(status.Error(code, "service error"))
+		if err := b.Allow(); !errors.Is(err, ErrCircuitOpen) {
+			t.Errorf("%s did not open the breaker", code)
+		}
+	}
+}
+
+func TestBreakerHalfOpen(t *testing.T) {
+	b := NewBreaker("user-service", 1, time.Minute)
+	b.Record(errUnavailable)
+
+	// openTimeout o'tgach faqat bitta sinov chaqiruvi o'tadi
+	b.openedAt = time.Now().Add(-time.Minute)
+	if err := b.Allow(); err != nil {
+		t.Fatalf("probe rejected: %v", err)
+	}
+	if b.state != stateHalfOpen {
+		t.Fatalf("state = %s, want half-open", b.state)
+	}
+	if err := b.Allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("second call during probe: err = %v, want %v", err, ErrCircuitOpen)
+	}
+
+	// sinov muvaffaqiyatsiz bo'lsa breaker yana to'liq muddatga ochiladi
+	b.Record(errUnavailable)
+	if b.state != stateOpen || b.Allow() == nil {
+		t.Fatalf("failed probe: state = %s, want open", b.state)
+	}
+
+	b.openedAt = time.Now().Add(-time.Minute)
+	if err := b.Allow(); err != nil {
+		t.Fatalf("second probe rejected: %v", err)
+	}
+	b.Record(nil)
+	if b.state != stateClosed {
+		t.Fatalf("successful probe: state = %s, want closed", b.state)
+	}
+	for i := 0; i < 3; i++ {
+		if err := b.Allow(); err != nil {
+			t.Errorf("call %d after recovery rejected: %v", i+1, err)
+		}
+	}
+}
+
+func TestBreakerDisabled(t *testing.T) {
+	b := NewBreaker("user-service", 0, time.Minute)
+	for i := 0; i < 10; i++ {
+		b.Record(errUnavailable)
+	}
+	if err := b.Allow(); err != nil {
+		t.Errorf("disabled breaker rejected a call: %v", err)
+	}
+}
+
+func TestBreakerInterceptor(t *testing.T) {
+	b := NewBreaker("user-service", 1, time.Minute)
+	interceptor := b.Interceptor()
+	calls := 0
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		calls++
+		return errUnavailable
+	}
+
+	if err := interceptor(context.Background(), "/user.UserService/GetUser", nil, nil, nil, invoker); !errors.Is(err, errUnavailable) {
+		t.Fatalf("first call: err = %v", err)
+	}
+	if err := interceptor(context.Background(), "/user.UserService/GetUser", nil, nil, nil, invoker); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("open breaker: err = %v, want %v", err, ErrCircuitOpen)
+	}
+	if calls != 1 {
+		t.Errorf("open breaker sent %d calls, want 1", calls)
+	}
+}
@@ -0,0 +1,51 @@
+// Package grpcclient boshqa servislarga ulanish uchun umumiy sozlamalar:
+// har bir chaqiruvga standart deadline, o'qish metodlarini qayta urinish va
+// sekin yoki ishlamayotgan servisni ajratib qo'yadigan circuit breaker.
+package grpcclient
+
+import (
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+type Options struct {
+	// Timeout context da deadline bo'lmasa har bir chaqiruvga qo'yiladi
+	Timeout time.Duration
+	// MaxAttempts idempotent metodlar uchun urinishlar soni (birinchisi bilan)
+	MaxAttempts int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// FailureThreshold ketma-ket shuncha xatodan keyin breaker ochiladi
+	FailureThreshold int
+	// OpenTimeout ochiq breaker shuncha vaqtdan keyin bitta sinov chaqiruvini o'tkazadi
+	OpenTimeout time.Duration
+}
+
+var DefaultOptions = Options{
+	Timeout:          5 * time.Second,
+	MaxAttempts:      3,
+	BaseBackoff:      100 * time.Millisecond,
+	MaxBackoff:       time.Second,
+	FailureThreshold: 5,
+	OpenTimeout:      10 * time.Second,
+}
+
+// Dial target uchun uzoq yashovchi ulanish yaratadi. grpc.ClientConn bir nechta
+// chaqiruvni bitta HTTP/2 ulanishda multiplex qiladi, shuning uchun uni butun
+// jarayon davomida qayta ishlatish kerak. creds mtls.ClientCredentials dan keladi.
+func Dial(target string, creds credentials.TransportCredentials, opts Options, extra ...grpc.DialOption) (*grpc.ClientConn, error) {
+	breaker := NewBreaker(target, opts.FailureThreshold, opts.OpenTimeout)
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithChainUnaryInterceptor(
+			TimeoutInterceptor(opts.Timeout),
+			RetryInterceptor(opts.MaxAttempts, opts.BaseBackoff, opts.MaxBackoff),
+			breaker.Interceptor(),
+		),
+	}
+	return grpc.NewClient(target, append(dialOpts, extra...)...)
+}
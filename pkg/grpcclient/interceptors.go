@@ -0,0 +1,87 @@
+package grpcclient
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// readPrefixes bilan boshlanadigan metodlar holatni o'zgartirmaydi va qayta yuborilishi xavfsiz
+var readPrefixes = []string{"Get", "List", "Search"}
+
+// TimeoutInterceptor context da deadline bo'lmasa standart deadline qo'yadi
+func TimeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok && timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// RetryInterceptor idempotent metodlarni Unavailable xatosida exponential backoff bilan qayta yuboradi
+func RetryInterceptor(maxAttempts int, base, max time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if maxAttempts <= 1 || !IsIdempotent(method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		var err error
+		for attempt := 0; attempt < maxAttempts; attempt++ {
+			if attempt > 0 {
+				timer := time.NewTimer(backoff(attempt, base, max))
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return err
+				case <-timer.C:
+				}
+			}
+			err = invoker(ctx, method, req, reply, cc, opts...)
+			if !retryable(err) {
+				return err
+			}
+		}
+		return err
+	}
+}
+
+// IsIdempotent metod nomidan uni qayta yuborish mumkinligini aniqlaydi.
+// method "/user.UserService/GetUser" ko'rinishida keladi.
+func IsIdempotent(method string) bool {
+	name := method[strings.LastIndex(method, "/")+1:]
+	for _, prefix := range readPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func retryable(err error) bool {
+	if err == nil || errors.Is(err, ErrCircuitOpen) {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
+
+// backoff "full jitter": [0, min(max, base*2^attempt)) oralig'ida tasodifiy kutish
+func backoff(attempt int, base, max time.Duration) time.Duration {
+	d := base << uint(attempt-1)
+	if d <= 0 || d > max {
+		d = max
+	}
+	return time.Duration(rand.Int63n(int64(d) + 1))
+}
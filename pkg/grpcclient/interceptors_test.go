@@ -0,0 +1,131 @@
+package grpcclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// failingInvoker birinchi failures ta chaqiruvda err qaytaradi
+func failingInvoker(failures int, err error, calls *int) grpc.UnaryInvoker {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		*calls++
+		if *calls <= failures {
+			return err
+		}
+		return nil
+	}
+}
+
+func TestRetryInterceptor(t *testing.T) {
+	retry := RetryInterceptor(3, time.Millisecond, 5*time.Millisecond)
+	tests := []struct {
+		name      string
+		method    string
+		failures  int
+		err       error
+		wantCalls int
+		wantErr   bool
+	}{
+		{"read recovers", "/user.UserService/GetUser", 2, errUnavailable, 3, false},
+		{"read gives up", "/user.UserService/GetUser", 5, errUnavailable, 3, true},
+		{"mutation is not retried", "/user.UserService/CreateUser", 5, errUnavailable, 1, true},
+		{"application error is not retried", "/tweet.TweetService/ListTweets", 5, status.Error(codes.Internal, "bug"), 1, true},
+		{"not found is not retried", "/tweet.TweetService/SearchTweets", 5, status.Error(codes.NotFound, "missing"), 1, true},
+		{"open breaker is not retried", "/user.UserService/GetUser", 5, ErrCircuitOpen, 1, true},
+		{"resource exhausted", "/user.UserService/GetUser", 1, status.Error(codes.ResourceExhausted, "busy"), 2, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := retry(context.Background(), tt.method, nil, nil, nil, failingInvoker(tt.failures, tt.err, &calls))
+			if (err != nil) != tt.wantErr || calls != tt.wantCalls {
+				t.Errorf("err = %v after %d calls, want error %v after %d calls", err, calls, tt.wantErr, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryInterceptorStopsOnCancel(t *testing.T) {
+	retry := RetryInterceptor(5, time.Hour, time.Hour)
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		calls++
+		cancel()
+		return errUnavailable
+	}
+
+	done := make(chan error)
+	go func() { done <- retry(ctx, "/user.UserService/GetUser", nil, nil, nil, invoker) }()
+	select {
+	case err := <-done:
+		if !errors.Is(err, errUnavailable) || calls != 1 {
+			t.Errorf("err = %v after %d calls", err, calls)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("retry kept waiting after the context was cancelled")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	base, max := 100*time.Millisecond, time.Second
+	for attempt := 1; attempt <= 10; attempt++ {
+		limit := base << uint(attempt-1)
+		if limit > max {
+			limit = max
+		}
+		for i := 0; i < 100; i++ {
+			if d := backoff(attempt, base, max); d < 0 || d > limit {
+				t.Fatalf("backoff(%d) = %s, want [0, %s]", attempt, d, limit)
+			}
+		}
+	}
+	// katta attempt da siljish toshib ketsa ham max dan oshmaydi
+	if d := backoff(70, base, max); d < 0 || d > max {
+		t.Errorf("backoff(70) = %s", d)
+	}
+}
+
+func TestTimeoutInterceptor(t *testing.T) {
+	interceptor := TimeoutInterceptor(time.Second)
+	var deadline time.Time
+	var hasDeadline bool
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		deadline, hasDeadline = ctx.Deadline()
+		return nil
+	}
+
+	interceptor(context.Background(), "/user.UserService/GetUser", nil, nil, nil, invoker)
+	if !hasDeadline || time.Until(deadline) > time.Second {
+		t.Errorf("default deadline = %v, %v", deadline, hasDeadline)
+	}
+
+	// chaqiruvchi qo'ygan deadline o'zgarmaydi
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	interceptor(ctx, "/user.UserService/GetUser", nil, nil, nil, invoker)
+	if time.Until(deadline) < 59*time.Minute {
+		t.Errorf("caller deadline replaced with %v", deadline)
+	}
+}
+
+func TestIsIdempotent(t *testing.T) {
+	for method, want := range map[string]bool{
+		"/user.UserService/GetUser":         true,
+		"/tweet.TweetService/ListTweets":    true,
+		"/tweet.TweetService/SearchTweets":  true,
+		"/user.UserService/CreateUser":      false,
+		"/like.LikeService/DeleteLikeTweet": false,
+		"GetUser":                           true,
+	} {
+		if got := IsIdempotent(method); got != want {
+			t.Errorf("IsIdempotent(%q) = %v, want %v", method, got, want)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Package grpcerr servis xatolarini ma'noli gRPC status kodlari va barqaror
+// reason qiymatlari bilan qaytarish uchun. Gateway reason ni HTTP javobdagi
+// "code" maydoniga aylantiradi, shuning uchun reason lar o'zgarmasligi kerak.
+package grpcerr
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// Error gRPC kodi, UPPER_SNAKE reason va (InvalidArgument uchun) maydon nomiga ega xato.
+// Bir xil qiymat qaytarilgani uchun errors.Is bilan solishtirish mumkin.
+type Error struct {
+	Code    codes.Code
+	Reason  string
+	Message string
+	Field   string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// GRPCStatus status.FromError va gRPC server uchun
+func (e *Error) GRPCStatus() *status.Status {
+	return e.status("")
+}
+
+func (e *Error) status(domain string) *status.Status {
+	st := status.New(e.Code, e.Message)
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: e.Reason, Domain: domain}}
+	if e.Field != "" {
+		details = append(details, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: e.Field, Description: e.Message}},
+		})
+	}
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		return withDetails
+	}
+	return st
+}
+
+func New(code codes.Code, reason, message string) *Error {
+	return &Error{Code: code, Reason: reason, Message: message}
+}
+
+func NotFound(reason, message string) *Error {
+	return New(codes.NotFound, reason, message)
+}
+
+func AlreadyExists(reason, message string) *Error {
+	return New(codes.AlreadyExists, reason, message)
+}
+
+func PermissionDenied(reason, message string) *Error {
+	return New(codes.PermissionDenied, reason, message)
+}
+
+func Unauthenticated(reason, message string) *Error {
+	return New(codes.Unauthenticated, reason, message)
+}
+
+func FailedPrecondition(reason, message string) *Error {
+	return New(codes.FailedPrecondition, reason, message)
+}
+
+// InvalidArgument field so'rovdagi noto'g'ri maydon nomi, u BadRequest detail ga yoziladi
+func InvalidArgument(reason, field, message string) *Error {
+	return &Error{Code: codes.InvalidArgument, Reason: reason, Message: message, Field: field}
+}
+
+// ErrPermissionDenied rol yoki egalik tekshiruvidan o'tmagan chaqiruvlar uchun umumiy xato
+var ErrPermissionDenied = PermissionDenied("PERMISSION_DENIED", "permission denied")
+
+// UnaryServerInterceptor handler qaytargan xatoni gRPC status ga aylantiradi:
+// *Error o'z kodi bilan, sql.ErrNoRows NotFound bo'ladi, qolgan xatolar Internal
+// bo'lib, asl xabar faqat DebugInfo detail ida uzatiladi va log qilinadi.
+// domain ErrorInfo.Domain ga yoziladi (odatda servis nomi). Zanjirda oxirgi bo'lishi
+// kerak, shunda logger va metrics interceptorlari yakuniy kodni ko'radi.
+func UnaryServerInterceptor(domain string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+		return resp, Status(ctx, domain, err).Err()
+	}
+}
+
+// Status xatoni gRPC status ga aylantiradi
+func Status(ctx context.Context, domain string, err error) *status.Status {
+	var appErr *Error
+	switch {
+	case errors.As(err, &appErr):
+		return appErr.status(domain)
+	case errors.Is(err, sql.ErrNoRows):
+		return NotFound("NOT_FOUND", "not found").status(domain)
+	case errors.Is(err, context.Canceled):
+		return status.New(codes.Canceled, "request canceled")
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.New(codes.DeadlineExceeded, "deadline exceeded")
+	}
+
+	// boshqa servisdan kelgan status o'zgarishsiz uzatiladi
+	if st, ok := status.FromError(err); ok && st.Code() != codes.Unknown {
+		return st
+	}
+
+	slog.ErrorContext(ctx, "internal error", "error", err)
+	st := status.New(codes.Internal, "internal error")
+	details := []protoadapt.MessageV1{
+		&errdetails.ErrorInfo{Reason: "INTERNAL", Domain: domain},
+		&errdetails.DebugInfo{Detail: err.Error()},
+	}
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		return withDetails
+	}
+	return st
+}
@@ -0,0 +1,113 @@
+package grpcerr
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errTweetNotFound = NotFound("TWEET_NOT_FOUND", "tweet not found")
+
+// details status dagi ErrorInfo, BadRequest va DebugInfo ni ajratadi
+func details(st *status.Status) (*errdetails.ErrorInfo, *errdetails.BadRequest, *errdetails.DebugInfo) {
+	var (
+		info  *errdetails.ErrorInfo
+		bad   *errdetails.BadRequest
+		debug *errdetails.DebugInfo
+	)
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.BadRequest:
+			bad = d
+		case *errdetails.DebugInfo:
+			debug = d
+		}
+	}
+	return info, bad, debug
+}
+
+func TestStatus(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name       string
+		err        error
+		wantCode   codes.Code
+		wantReason string
+	}{
+		{"typed error", errTweetNotFound, codes.NotFound, "TWEET_NOT_FOUND"},
+		{"wrapped typed error", fmt.Errorf("get tweet: %w", errTweetNotFound), codes.NotFound, "TWEET_NOT_FOUND"},
+		{"no rows", fmt.Errorf("scan: %w", sql.ErrNoRows), codes.NotFound, "NOT_FOUND"},
+		{"unexpected error", errors.New("pq: connection refused"), codes.Internal, "INTERNAL"},
+		{"canceled", context.Canceled, codes.Canceled, ""},
+		{"deadline", context.DeadlineExceeded, codes.DeadlineExceeded, ""},
+		{"downstream status", status.Error(codes.PermissionDenied, "blocked"), codes.PermissionDenied, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := Status(ctx, "tweet-service", tt.err)
+			if st.Code() != tt.wantCode {
+				t.Fatalf("code = %s, want %s", st.Code(), tt.wantCode)
+			}
+			info, _, debug := details(st)
+			if tt.wantReason == "" {
+				return
+			}
+			if info == nil || info.Reason != tt.wantReason || info.Domain != "tweet-service" {
+				t.Errorf("ErrorInfo = %v, want reason %s", info, tt.wantReason)
+			}
+			// ichki xato matni faqat DebugInfo da, status xabarida emas
+			if tt.wantCode == codes.Internal && (st.Message() != "internal error" || debug == nil || debug.Detail != tt.err.Error()) {
+				t.Errorf("internal status %q with debug %v", st.Message(), debug)
+			}
+		})
+	}
+}
+
+func TestInvalidArgument(t *testing.T) {
+	err := InvalidArgument("INVALID_USERNAME", "username", "username is too short")
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument || st.Message() != "username is too short" {
+		t.Fatalf("status = %v", st)
+	}
+	_, bad, _ := details(st)
+	if bad == nil || len(bad.FieldViolations) != 1 || bad.FieldViolations[0].Field != "username" {
+		t.Errorf("BadRequest = %v", bad)
+	}
+}
+
+func TestErrorsIs(t *testing.T) {
+	if !errors.Is(fmt.Errorf("wrapped: %w", ErrPermissionDenied), ErrPermissionDenied) {
+		t.Error("wrapped ErrPermissionDenied does not match")
+	}
+	if errors.Is(errTweetNotFound, NotFound("TWEET_NOT_FOUND", "tweet not found")) {
+		t.Error("distinct errors with the same reason match")
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor("user-service")
+	info := &grpc.UnaryServerInfo{FullMethod: "/user.UserService/GetUser"}
+
+	_, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, sql.ErrNoRows
+	})
+	if st, _ := status.FromError(err); st.Code() != codes.NotFound {
+		t.Errorf("code = %s, want NotFound", st.Code())
+	}
+
+	resp, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	if err != nil || resp != "ok" {
+		t.Errorf("success: %v, %v", resp, err)
+	}
+}
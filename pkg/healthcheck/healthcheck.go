@@ -0,0 +1,106 @@
+// Package healthcheck servis bog'liqliklarini (Postgres, Redis, Kafka) davriy
+// tekshiradi va natijani standart grpc.health.v1 servisi orqali e'lon qiladi.
+package healthcheck
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const checkTimeout = 2 * time.Second
+
+type Check func(ctx context.Context) error
+
+type Checker struct {
+	server  *health.Server
+	service string
+
+	mu      sync.Mutex
+	names   []string
+	checks  []Check
+	serving bool
+}
+
+// New birinchi tekshiruvgacha servisni NOT_SERVING deb belgilaydi.
+// service pb.XService_ServiceDesc.ServiceName bo'lishi kerak.
+func New(server *health.Server, service string) *Checker {
+	server.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	server.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	return &Checker{server: server, service: service}
+}
+
+func (c *Checker) Add(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.names = append(c.names, name)
+	c.checks = append(c.checks, check)
+}
+
+// CheckNow barcha tekshiruvlarni parallel bajaradi va statusni yangilaydi
+func (c *Checker) CheckNow(ctx context.Context) bool {
+	c.mu.Lock()
+	names, checks := c.names, c.checks
+	c.mu.Unlock()
+
+	errs := make([]error, len(checks))
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func(i int, check Check) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+			defer cancel()
+			errs[i] = check(ctx)
+		}(i, check)
+	}
+	wg.Wait()
+
+	serving := true
+	for i, err := range errs {
+		if err != nil {
+			serving = false
+			slog.Warn("health check failed", "check", names[i], "error", err)
+		}
+	}
+
+	status := healthpb.HealthCheckResponse_SERVING
+	if !serving {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	c.server.SetServingStatus("", status)
+	c.server.SetServingStatus(c.service, status)
+
+	c.mu.Lock()
+	if c.serving != serving {
+		slog.Info("health status changed", "service", c.service, "status", status.String())
+	}
+	c.serving = serving
+	c.mu.Unlock()
+	return serving
+}
+
+// Run tekshiruvlarni darhol va keyin har interval da done yopilguncha bajaradi
+func (c *Checker) Run(interval time.Duration, done <-chan struct{}) {
+	c.CheckNow(context.Background())
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			c.CheckNow(context.Background())
+		}
+	}
+}
+
+// Shutdown servisni NOT_SERVING qiladi, keyingi yangilanishlar e'tiborsiz qoldiriladi.
+// GracefulStop dan oldin chaqiriladi, shunda chaqiruvchilar yangi so'rov yubormaydi.
+func (c *Checker) Shutdown() {
+	c.server.Shutdown()
+}
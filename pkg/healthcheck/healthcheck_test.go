@@ -0,0 +1,48 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func status(t *testing.T, server *health.Server, service string) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+	resp, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.Status
+}
+
+func TestChecker(t *testing.T) {
+	server := health.NewServer()
+	checker := New(server, "test.Service")
+	if got := status(t, server, "test.Service"); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("before the first check: %s, want NOT_SERVING", got)
+	}
+
+	var failing error
+	checker.Add("ok", func(context.Context) error { return nil })
+	checker.Add("db", func(context.Context) error { return failing })
+	if !checker.CheckNow(context.Background()) {
+		t.Fatal("healthy checks reported as failing")
+	}
+	for _, service := range []string{"", "test.Service"} {
+		if got := status(t, server, service); got != healthpb.HealthCheckResponse_SERVING {
+			t.Errorf("service %q: %s, want SERVING", service, got)
+		}
+	}
+
+	// bitta tekshiruv yiqilsa butun servis NOT_SERVING
+	failing = errors.New("connection refused")
+	if checker.CheckNow(context.Background()) {
+		t.Fatal("failing check reported as healthy")
+	}
+	if got := status(t, server, "test.Service"); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("after a failed check: %s, want NOT_SERVING", got)
+	}
+}
@@ -0,0 +1,120 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// MetadataUserID gateway qo'yadigan autentifikatsiya qilingan foydalanuvchi ID si
+const MetadataUserID = "x-user-id"
+
+// userIDGetter so'rovida user_id maydoni bor proto xabarlar
+type userIDGetter interface {
+	GetUserId() int32
+}
+
+type userID64Getter interface {
+	GetUserId() int64
+}
+
+// UnaryServerInterceptor har bir chaqiruvni metod, status kodi va davomiyligi bilan log qiladi.
+// Gateway uzatgan x-user-id (bo'lmasa so'rovdagi user_id) handler ichidagi loglarga ham
+// qo'shilishi uchun context ga qo'yiladi.
+// requestid.UnaryServerInterceptor dan keyin turishi kerak.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if id, ok := callerID(ctx); ok {
+			ctx = WithUserID(ctx, id)
+		} else {
+			ctx = requestUserID(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		attrs := []any{
+			slog.String("method", info.FullMethod),
+			slog.String("grpc_code", status.Code(err).String()),
+			slog.Duration("duration", time.Since(start)),
+		}
+		if p, ok := peer.FromContext(ctx); ok {
+			attrs = append(attrs, slog.String("peer", p.Addr.String()))
+		}
+		if err != nil {
+			attrs = append(attrs, slog.Any("error", err))
+		}
+		slog.Log(ctx, levelFor(status.Code(err)), "grpc call", attrs...)
+		return resp, err
+	}
+}
+
+// UnaryClientInterceptor chiquvchi chaqiruvlarni log qiladi: muvaffaqiyatlilarini debug,
+// xatolarini warn darajasida
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		level := slog.LevelDebug
+		attrs := []any{
+			slog.String("method", method),
+			slog.String("target", cc.Target()),
+			slog.String("grpc_code", status.Code(err).String()),
+			slog.Duration("duration", time.Since(start)),
+		}
+		if err != nil {
+			level = slog.LevelWarn
+			attrs = append(attrs, slog.Any("error", err))
+		}
+		slog.Log(ctx, level, "grpc client call", attrs...)
+		return err
+	}
+}
+
+// callerID gateway autentifikatsiyadan keyin qo'yadigan x-user-id metadata si
+func callerID(ctx context.Context) (int64, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, false
+	}
+	values := md.Get(MetadataUserID)
+	if len(values) == 0 {
+		return 0, false
+	}
+	id, err := strconv.ParseInt(values[0], 10, 64)
+	return id, err == nil && id > 0
+}
+
+func requestUserID(ctx context.Context, req interface{}) context.Context {
+	switch r := req.(type) {
+	case userIDGetter:
+		if id := r.GetUserId(); id > 0 {
+			return WithUserID(ctx, int64(id))
+		}
+	case userID64Getter:
+		if id := r.GetUserId(); id > 0 {
+			return WithUserID(ctx, id)
+		}
+	}
+	return ctx
+}
+
+// levelFor mijoz xatolari (NotFound, InvalidArgument, ...) warn, server xatolari error
+func levelFor(code codes.Code) slog.Level {
+	switch code {
+	case codes.OK:
+		return slog.LevelInfo
+	case codes.Unknown, codes.Internal, codes.DataLoss, codes.Unimplemented, codes.Unavailable, codes.DeadlineExceeded:
+		return slog.LevelError
+	default:
+		return slog.LevelWarn
+	}
+}
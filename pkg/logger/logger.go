@@ -0,0 +1,88 @@
+// Package logger log/slog asosidagi umumiy JSON logger. Daraja config dan
+// olinadi, request va user ID context dan avtomatik qo'shiladi, parollar,
+// kodlar, email, telefon va xabar matnlari yashiriladi.
+package logger
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"pkg/requestid"
+)
+
+type userIDKey struct{}
+
+// Init service nomi bilan JSON logger yaratadi va uni slog hamda standart
+// log paketi uchun default qiladi
+func Init(service, level string) *slog.Logger {
+	l := New(os.Stdout, service, level)
+	slog.SetDefault(l)
+	return l
+}
+
+func New(w io.Writer, service, level string) *slog.Logger {
+	handler := slog.NewJSONHandler(w, &slog.HandlerOptions{
+		Level:       ParseLevel(level),
+		ReplaceAttr: redactAttr,
+	})
+	l := slog.New(contextHandler{handler})
+	if service != "" {
+		l = l.With("service", service)
+	}
+	return l
+}
+
+// ParseLevel "debug", "info", "warn" yoki "error"; noma'lum qiymat info bo'ladi
+func ParseLevel(level string) slog.Level {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// Fatal xatoni error darajasida yozadi va dasturni to'xtatadi
+func Fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
+
+func WithUserID(ctx context.Context, userID int64) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userID)
+}
+
+func UserIDFromContext(ctx context.Context) (int64, bool) {
+	id, ok := ctx.Value(userIDKey{}).(int64)
+	return id, ok && id > 0
+}
+
+// contextHandler har bir yozuvga context dagi request_id va user_id ni qo'shadi
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := requestid.FromContext(ctx); id != "" {
+		r.AddAttrs(slog.String("request_id", id))
+	}
+	if id, ok := UserIDFromContext(ctx); ok {
+		r.AddAttrs(slog.Int64("user_id", id))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
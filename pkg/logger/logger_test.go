@@ -0,0 +1,150 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"pkg/requestid"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// record bitta log yozuvini JSON dan o'qiydi
+func record(t *testing.T, buf *bytes.Buffer) map[string]any {
+	t.Helper()
+	var rec map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &rec); err != nil {
+		t.Fatalf("log is not JSON: %v: %s", err, buf)
+	}
+	buf.Reset()
+	return rec
+}
+
+func TestRedaction(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, "user-service", "info")
+
+	l.Info("login",
+		"password", "hunter2",
+		"new_password", "hunter3",
+		"recovery_code", "abcde-12345",
+		"grpc_code", "NotFound",
+		"username", "alice",
+		"note", "write to alice@example.com or +998 90 123 45 67",
+		"error", errors.New("user bob@example.com not found"),
+		"user_id", 42,
+	)
+	rec := record(t, &buf)
+	for key, want := range map[string]any{
+		"password":      redacted,
+		"new_password":  redacted,
+		"recovery_code": redacted,
+		"grpc_code":     "NotFound",
+		"username":      "alice",
+		"note":          "write to " + redacted + " or " + redacted,
+		"error":         "user " + redacted + " not found",
+		"user_id":       float64(42),
+		"service":       "user-service",
+	} {
+		if rec[key] != want {
+			t.Errorf("%s = %v, want %v", key, rec[key], want)
+		}
+	}
+	if strings.Contains(buf.String(), "hunter") {
+		t.Error("password leaked")
+	}
+}
+
+func TestContextAttributes(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, "", "debug")
+	ctx := WithUserID(requestid.NewContext(context.Background(), "req-42"), 7)
+
+	l.DebugContext(ctx, "debug message")
+	rec := record(t, &buf)
+	if rec["request_id"] != "req-42" || rec["user_id"] != float64(7) {
+		t.Errorf("record = %v", rec)
+	}
+
+	l.With("component", "test").InfoContext(context.Background(), "no context")
+	rec = record(t, &buf)
+	if _, ok := rec["request_id"]; ok {
+		t.Errorf("request_id without context: %v", rec)
+	}
+	if rec["component"] != "test" {
+		t.Errorf("record = %v", rec)
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	for level, want := range map[string]slog.Level{
+		"debug": slog.LevelDebug, " WARN ": slog.LevelWarn, "warning": slog.LevelWarn,
+		"error": slog.LevelError, "info": slog.LevelInfo, "": slog.LevelInfo, "verbose": slog.LevelInfo,
+	} {
+		if got := ParseLevel(level); got != want {
+			t.Errorf("ParseLevel(%q) = %s, want %s", level, got, want)
+		}
+	}
+	var buf bytes.Buffer
+	New(&buf, "", "warn").Info("dropped")
+	if buf.Len() != 0 {
+		t.Errorf("info logged at warn level: %s", buf.String())
+	}
+}
+
+type getUserRequest struct{ UserId int32 }
+
+func (r getUserRequest) GetUserId() int32 { return r.UserId }
+
+func TestUnaryServerInterceptorUserID(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/user.UserService/GetUser"}
+	var got int64
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		got, _ = UserIDFromContext(ctx)
+		return nil, nil
+	}
+
+	tests := []struct {
+		name string
+		md   metadata.MD
+		req  interface{}
+		want int64
+	}{
+		{"gateway principal", metadata.Pairs(MetadataUserID, "7"), getUserRequest{UserId: 9}, 7},
+		{"request field", nil, getUserRequest{UserId: 9}, 9},
+		{"invalid principal", metadata.Pairs(MetadataUserID, "x"), getUserRequest{}, 0},
+		{"no user", nil, struct{}{}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+			got = 0
+			interceptor(ctx, tt.req, info, handler)
+			if got != tt.want {
+				t.Errorf("user id = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLevelFor(t *testing.T) {
+	for code, want := range map[codes.Code]slog.Level{
+		codes.OK: slog.LevelInfo, codes.NotFound: slog.LevelWarn, codes.PermissionDenied: slog.LevelWarn,
+		codes.Internal: slog.LevelError, codes.Unavailable: slog.LevelError,
+	} {
+		if got := levelFor(code); got != want {
+			t.Errorf("levelFor(%s) = %s, want %s", code, got, want)
+		}
+	}
+}
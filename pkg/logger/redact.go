@@ -0,0 +1,67 @@
+package logger
+
+import (
+	"errors"
+	"log/slog"
+	"regexp"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveKeys qiymati butunlay yashiriladigan kalitlar. Kalit shu so'z bilan
+// tugasa ham yashiriladi, masalan new_password yoki recovery_code.
+var sensitiveKeys = []string{
+	"password", "code", "otp", "secret", "token", "authorization", "cookie",
+	"email", "phone", "text", "content", "body",
+}
+
+// publicKeys sezgir so'z bilan tugasa ham yashirilmaydigan kalitlar
+var publicKeys = map[string]bool{
+	"grpc_code": true, "status_code": true, "error_code": true,
+}
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	// faqat xalqaro ko'rinishdagi raqamlar (+998 90 123 45 67), sanalar va ID lar tegilmaydi
+	phonePattern = regexp.MustCompile(`\+\d[\d \-()]{6,}\d`)
+)
+
+func sensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	if publicKeys[key] {
+		return false
+	}
+	for _, s := range sensitiveKeys {
+		if key == s || strings.HasSuffix(key, "_"+s) || strings.HasSuffix(key, "-"+s) {
+			return true
+		}
+	}
+	return false
+}
+
+// Redact matndagi email va telefon raqamlarini yashiradi
+func Redact(s string) string {
+	s = emailPattern.ReplaceAllString(s, redacted)
+	return phonePattern.ReplaceAllString(s, redacted)
+}
+
+// redactAttr slog.HandlerOptions.ReplaceAttr: sezgir kalitlarni butunlay,
+// qolgan matnlardagi email va telefonlarni qisman yashiradi
+func redactAttr(groups []string, a slog.Attr) slog.Attr {
+	if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey || a.Key == "request_id") {
+		return a
+	}
+	if sensitiveKey(a.Key) {
+		return slog.String(a.Key, redacted)
+	}
+	switch a.Value.Kind() {
+	case slog.KindString:
+		return slog.String(a.Key, Redact(a.Value.String()))
+	case slog.KindAny:
+		if err, ok := a.Value.Any().(error); ok {
+			return slog.Any(a.Key, errors.New(Redact(err.Error())))
+		}
+	}
+	return a
+}
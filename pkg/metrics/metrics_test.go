@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSplitMethod(t *testing.T) {
+	for method, want := range map[string][2]string{
+		"/user.UserService/GetUser": {"user.UserService", "GetUser"},
+		"GetUser":                   {"unknown", "GetUser"},
+	} {
+		if service, name := splitMethod(method); service != want[0] || name != want[1] {
+			t.Errorf("splitMethod(%q) = %s, %s", method, service, name)
+		}
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.MetricsService/Get"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "missing")
+	}
+
+	before := testutil.ToFloat64(grpcServerHandled.WithLabelValues("test.MetricsService", "Get", "NotFound"))
+	interceptor(context.Background(), nil, info, handler)
+	if got := testutil.ToFloat64(grpcServerHandled.WithLabelValues("test.MetricsService", "Get", "NotFound")); got != before+1 {
+		t.Errorf("grpc_server_handled_total = %v, want %v", got, before+1)
+	}
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	interceptor := UnaryClientInterceptor()
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		return nil
+	}
+
+	before := testutil.ToFloat64(grpcClientHandled.WithLabelValues("test.MetricsService", "List", "OK"))
+	interceptor(context.Background(), "/test.MetricsService/List", nil, nil, nil, invoker)
+	if got := testutil.ToFloat64(grpcClientHandled.WithLabelValues("test.MetricsService", "List", "OK")); got != before+1 {
+		t.Errorf("grpc_client_handled_total = %v, want %v", got, before+1)
+	}
+}
+
+func TestKafka(t *testing.T) {
+	KafkaProduced("test-topic", nil)
+	KafkaProduced("test-topic", errors.New("broker down"))
+	KafkaConsumed("test-topic", nil)
+	if got := testutil.ToFloat64(kafkaProduced.WithLabelValues("test-topic", "error")); got != 1 {
+		t.Errorf("produced errors = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(kafkaConsumed.WithLabelValues("test-topic", "success")); got != 1 {
+		t.Errorf("consumed = %v, want 1", got)
+	}
+}
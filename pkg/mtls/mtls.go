@@ -0,0 +1,140 @@
+// Package mtls servislararo gRPC ulanishlari uchun transport credentials beradi.
+//
+// "mtls" rejimida har bir tomon ichki CA imzolagan sertifikat ko'rsatadi va
+// qarshi tomon sertifikatini shu CA bilan tekshiradi. Server qo'shimcha ravishda
+// chaqiruvchining identifikatorini (sertifikat CN yoki DNS SAN) ruxsat etilganlar
+// ro'yxati bilan solishtiradi. "insecure" rejimi faqat lokal ishlab chiqish uchun.
+package mtls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	ModeMTLS     = "mtls"
+	ModeInsecure = "insecure"
+)
+
+type Config struct {
+	// Mode bo'sh bo'lsa mTLS ishlatiladi
+	Mode     string
+	CAFile   string
+	CertFile string
+	KeyFile  string
+	// AllowedCallers bo'sh bo'lsa CA imzolagan har qanday sertifikat qabul qilinadi
+	AllowedCallers []string
+}
+
+// ParseIdentities "api-gateway, like-service" ko'rinishidagi ro'yxatni ajratadi
+func ParseIdentities(s string) []string {
+	var ids []string
+	for _, id := range strings.Split(s, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func (c Config) Insecure() bool {
+	return c.Mode == ModeInsecure
+}
+
+// ServerCredentials gRPC server uchun credentials qaytaradi
+func ServerCredentials(c Config) (credentials.TransportCredentials, error) {
+	if c.Insecure() {
+		return insecure.NewCredentials(), nil
+	}
+	cert, pool, err := c.load()
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConf := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}
+	if len(c.AllowedCallers) > 0 {
+		allowed := c.AllowedCallers
+		// VerifyConnection zanjir CA bilan tekshirilgandan keyin chaqiriladi
+		tlsConf.VerifyConnection = func(cs tls.ConnectionState) error {
+			if len(cs.PeerCertificates) == 0 {
+				return errors.New("mtls: no client certificate")
+			}
+			return checkIdentity(cs.PeerCertificates[0], allowed)
+		}
+	}
+	return credentials.NewTLS(tlsConf), nil
+}
+
+// ClientCredentials serverName ga ulanish uchun credentials qaytaradi.
+// Server sertifikati serverName uchun berilgan bo'lishi kerak.
+func ClientCredentials(c Config, serverName string) (credentials.TransportCredentials, error) {
+	if c.Insecure() {
+		return insecure.NewCredentials(), nil
+	}
+	cert, pool, err := c.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		ServerName:   serverName,
+	}), nil
+}
+
+func (c Config) load() (tls.Certificate, *x509.CertPool, error) {
+	if c.Mode != "" && c.Mode != ModeMTLS {
+		return tls.Certificate{}, nil, fmt.Errorf("mtls: unknown mode %q", c.Mode)
+	}
+	if c.CAFile == "" || c.CertFile == "" || c.KeyFile == "" {
+		return tls.Certificate{}, nil, errors.New("mtls: CA, certificate and key files are required")
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("mtls: error loading key pair: %v", err)
+	}
+	caPEM, err := os.ReadFile(c.CAFile)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("mtls: error reading CA: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return tls.Certificate{}, nil, errors.New("mtls: no certificates found in CA file")
+	}
+	return cert, pool, nil
+}
+
+// Identities sertifikat egasining identifikatorlari: CN va DNS SAN lar
+func Identities(cert *x509.Certificate) []string {
+	ids := make([]string, 0, len(cert.DNSNames)+1)
+	if cert.Subject.CommonName != "" {
+		ids = append(ids, cert.Subject.CommonName)
+	}
+	return append(ids, cert.DNSNames...)
+}
+
+func checkIdentity(cert *x509.Certificate, allowed []string) error {
+	for _, id := range Identities(cert) {
+		for _, a := range allowed {
+			if id == a {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("mtls: caller %q is not allowed", cert.Subject.CommonName)
+}
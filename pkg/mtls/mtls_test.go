@@ -0,0 +1,198 @@
+package mtls
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue service nomi uchun CN va DNS SAN li sertifikat chiqaradi va
+// CA, sertifikat va kalitni dir ga yozib Config qaytaradi
+func (ca *testCA) issue(t *testing.T, dir, name string, allowed ...string) Config {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conf := Config{
+		Mode:           ModeMTLS,
+		CAFile:         filepath.Join(dir, name+"-ca.pem"),
+		CertFile:       filepath.Join(dir, name+".pem"),
+		KeyFile:        filepath.Join(dir, name+"-key.pem"),
+		AllowedCallers: allowed,
+	}
+	writeFile(t, conf.CAFile, ca.pem)
+	writeFile(t, conf.CertFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	writeFile(t, conf.KeyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	return conf
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// startServer health servisi bilan gRPC server ishga tushiradi va manzilini qaytaradi
+func startServer(t *testing.T, conf Config) string {
+	t.Helper()
+	creds, err := ServerCredentials(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer(grpc.Creds(creds))
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String()
+}
+
+func call(t *testing.T, addr string, conf Config, serverName string) error {
+	t.Helper()
+	creds, err := ClientCredentials(conf, serverName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	return err
+}
+
+func TestMTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, "minitwitter-internal-ca")
+	rogueCA := newTestCA(t, "rogue-ca")
+
+	server := ca.issue(t, dir, "user-service", "api-gateway", "tweet-service")
+	addr := startServer(t, server)
+
+	tests := []struct {
+		name       string
+		client     Config
+		serverName string
+		wantErr    bool
+	}{
+		{name: "allowed caller", client: ca.issue(t, dir, "api-gateway"), serverName: "user-service"},
+		{name: "caller not in allow list", client: ca.issue(t, dir, "direct-service"), serverName: "user-service", wantErr: true},
+		{name: "caller signed by another CA", client: rogueCA.issue(t, dir, "tweet-service"), serverName: "user-service", wantErr: true},
+		{name: "server name mismatch", client: ca.issue(t, dir, "api-gateway"), serverName: "like-service", wantErr: true},
+		{name: "plaintext caller", client: Config{Mode: ModeInsecure}, serverName: "user-service", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := call(t, addr, tt.client, tt.serverName)
+			if tt.wantErr && err == nil {
+				t.Fatal("expected the call to be rejected")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestMTLSAnyCallerWithoutAllowList(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, "minitwitter-internal-ca")
+
+	addr := startServer(t, ca.issue(t, dir, "user-service"))
+	if err := call(t, addr, ca.issue(t, dir, "direct-service"), "user-service"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestInsecureMode(t *testing.T) {
+	addr := startServer(t, Config{Mode: ModeInsecure})
+	if err := call(t, addr, Config{Mode: ModeInsecure}, "user-service"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestMissingFiles(t *testing.T) {
+	if _, err := ServerCredentials(Config{Mode: ModeMTLS}); err == nil {
+		t.Fatal("expected an error without certificate files")
+	}
+	if _, err := ClientCredentials(Config{Mode: "tls"}, "user-service"); err == nil {
+		t.Fatal("expected an error for an unknown mode")
+	}
+}
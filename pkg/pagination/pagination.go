@@ -0,0 +1,97 @@
+// Package pagination ro'yxat RPC lari uchun (created_at, id) bo'yicha keyset
+// sahifalash. page_token mijoz uchun shaffof emas: ichida oldingi sahifaning
+// oxirgi qatori kaliti base64 ko'rinishida saqlanadi.
+package pagination
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"time"
+
+	"pkg/grpcerr"
+)
+
+const (
+	// DefaultPageSize page_size berilmaganda ishlatiladi
+	DefaultPageSize = 20
+	// MaxPageSize dan katta page_size shu qiymatgacha kesiladi
+	MaxPageSize = 100
+)
+
+var (
+	ErrInvalidPageSize  = grpcerr.InvalidArgument("INVALID_PAGE_SIZE", "page_size", "page_size must not be negative")
+	ErrInvalidPageToken = grpcerr.InvalidArgument("INVALID_PAGE_TOKEN", "page_token", "invalid page_token")
+)
+
+// start birinchi sahifa uchun kalit: har qanday (created_at, id) undan kichik
+var start = Cursor{CreatedAt: time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC), ID: math.MaxInt64}
+
+// Cursor sahifadagi oxirgi qatorning kaliti
+type Cursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+// Encode cursor ni page_token ga aylantiradi
+func (c Cursor) Encode() string {
+	raw := fmt.Sprintf("%d:%d", c.CreatedAt.UnixNano(), c.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// Decode page_token ni cursor ga qaytaradi
+func Decode(token string) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return Cursor{}, ErrInvalidPageToken
+	}
+	var nanos, id int64
+	if n, err := fmt.Sscanf(string(raw), "%d:%d", &nanos, &id); err != nil || n != 2 {
+		return Cursor{}, ErrInvalidPageToken
+	}
+	return Cursor{CreatedAt: time.Unix(0, nanos).UTC(), ID: id}, nil
+}
+
+// Page so'rovdagi page_size va page_token dan olingan sahifa
+type Page struct {
+	Size  int
+	After Cursor
+}
+
+// New so'rov maydonlarini tekshiradi va Page qaytaradi
+func New(pageSize int32, pageToken string) (Page, error) {
+	if pageSize < 0 {
+		return Page{}, ErrInvalidPageSize
+	}
+	size := int(pageSize)
+	if size == 0 {
+		size = DefaultPageSize
+	}
+	if size > MaxPageSize {
+		size = MaxPageSize
+	}
+	page := Page{Size: size, After: start}
+	if pageToken != "" {
+		after, err := Decode(pageToken)
+		if err != nil {
+			return Page{}, err
+		}
+		page.After = after
+	}
+	return page, nil
+}
+
+// Limit SQL LIMIT qiymati: keyingi sahifa borligini bilish uchun bitta ortiq qator olinadi
+func (p Page) Limit() int {
+	return p.Size + 1
+}
+
+// Trim ortiqcha qatorni olib tashlaydi va keyingi sahifa tokenini qaytaradi.
+// Oxirgi sahifada token bo'sh bo'ladi.
+func Trim[T any](items []T, p Page, key func(T) Cursor) ([]T, string) {
+	if len(items) <= p.Size {
+		return items, ""
+	}
+	items = items[:p.Size]
+	return items, key(items[len(items)-1]).Encode()
+}
@@ -0,0 +1,92 @@
+package pagination
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	cursor := Cursor{CreatedAt: time.Date(2026, 10, 17, 12, 30, 0, 123456789, time.UTC), ID: 42}
+	got, err := Decode(cursor.Encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.CreatedAt.Equal(cursor.CreatedAt) || got.ID != cursor.ID {
+		t.Errorf("Decode(Encode(%v)) = %v", cursor, got)
+	}
+
+	for _, token := range []string{"!!!", "bm90LWEtY3Vyc29y", "MTIz"} {
+		if _, err := Decode(token); !errors.Is(err, ErrInvalidPageToken) {
+			t.Errorf("Decode(%q): err = %v, want %v", token, err, ErrInvalidPageToken)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		size     int32
+		token    string
+		wantSize int
+		wantErr  error
+	}{
+		{0, "", DefaultPageSize, nil},
+		{5, "", 5, nil},
+		{MaxPageSize + 1, "", MaxPageSize, nil},
+		{-1, "", 0, ErrInvalidPageSize},
+		{5, "not a token", 0, ErrInvalidPageToken},
+	}
+	for _, tt := range tests {
+		page, err := New(tt.size, tt.token)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("New(%d, %q): err = %v, want %v", tt.size, tt.token, err, tt.wantErr)
+			continue
+		}
+		if err == nil && (page.Size != tt.wantSize || page.Limit() != tt.wantSize+1) {
+			t.Errorf("New(%d, %q) = %+v", tt.size, tt.token, page)
+		}
+	}
+
+	// birinchi sahifa har qanday haqiqiy qatordan keyin boshlanadi
+	page, _ := New(0, "")
+	if !page.After.CreatedAt.After(time.Now().AddDate(1000, 0, 0)) {
+		t.Errorf("first page starts after %v", page.After)
+	}
+	cursor := Cursor{CreatedAt: time.Unix(1700000000, 0).UTC(), ID: 7}
+	if page, _ := New(0, cursor.Encode()); page.After != cursor {
+		t.Errorf("page after %v, want %v", page.After, cursor)
+	}
+}
+
+type row struct {
+	id        int64
+	createdAt time.Time
+}
+
+func key(r row) Cursor {
+	return Cursor{CreatedAt: r.createdAt, ID: r.id}
+}
+
+func TestTrim(t *testing.T) {
+	now := time.Unix(1700000000, 0).UTC()
+	rows := []row{{5, now}, {4, now}, {3, now.Add(-time.Second)}}
+	page := Page{Size: 2}
+
+	// Limit() ta qator keldi: ortiqchasi tashlanadi, token oxirgi qoldirilgan qatorga ishora qiladi
+	items, next := Trim(rows, page, key)
+	if len(items) != 2 || items[1].id != 4 {
+		t.Fatalf("items = %v", items)
+	}
+	cursor, err := Decode(next)
+	if err != nil || cursor != key(rows[1]) {
+		t.Errorf("next cursor = %v, %v; want %v", cursor, err, key(rows[1]))
+	}
+
+	// oxirgi sahifa
+	if items, next := Trim(rows[:2], page, key); len(items) != 2 || next != "" {
+		t.Errorf("last page: %v, %q", items, next)
+	}
+	if items, next := Trim([]row{}, page, key); len(items) != 0 || next != "" {
+		t.Errorf("empty page: %v, %q", items, next)
+	}
+}
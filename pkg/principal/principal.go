@@ -0,0 +1,51 @@
+// Package principal gateway gRPC metadata orqali uzatgan foydalanuvchini
+// servislarda o'qiydi va ichki chaqiruvlarda uni keyingi servisga uzatadi.
+package principal
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// MetadataUserID gateway qo'yadigan autentifikatsiya qilingan foydalanuvchi ID si
+	MetadataUserID = "x-user-id"
+	// MetadataRole foydalanuvchining roli
+	MetadataRole = "x-user-role"
+)
+
+// CallerID gateway x-user-id da uzatgan foydalanuvchi ID si. Chaqiruv
+// autentifikatsiya qilingan route dan kelmagan bo'lsa ok=false.
+func CallerID(ctx context.Context) (int64, bool) {
+	id, err := strconv.ParseInt(first(ctx, MetadataUserID), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
+// CallerRole gateway x-user-role da uzatgan rol, bo'lmasa bo'sh
+func CallerRole(ctx context.Context) string {
+	return first(ctx, MetadataRole)
+}
+
+// AsCaller chiquvchi chaqiruvlarga id ni x-user-id qilib qo'shadi. Bloklash
+// kabi kaskadlar like va commentlarni muallifi nomidan o'chiradi, chunki like,
+// comment va tweet servislari caller siz o'zgartirishlarni rad etadi.
+func AsCaller(ctx context.Context, id int64) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, MetadataUserID, strconv.FormatInt(id, 10))
+}
+
+func first(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
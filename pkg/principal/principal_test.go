@@ -0,0 +1,32 @@
+package principal
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestCaller(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataUserID, "7", MetadataRole, "admin"))
+	if id, ok := CallerID(ctx); !ok || id != 7 {
+		t.Errorf("CallerID = %d, %v", id, ok)
+	}
+	if role := CallerRole(ctx); role != "admin" {
+		t.Errorf("CallerRole = %q", role)
+	}
+
+	for _, ctx := range []context.Context{
+		context.Background(),
+		metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataUserID, "abc")),
+	} {
+		if _, ok := CallerID(ctx); ok {
+			t.Errorf("CallerID without a valid x-user-id returned ok")
+		}
+	}
+
+	out, _ := metadata.FromOutgoingContext(AsCaller(context.Background(), 9))
+	if got := out.Get(MetadataUserID); len(got) != 1 || got[0] != "9" {
+		t.Errorf("AsCaller metadata = %v", got)
+	}
+}
@@ -0,0 +1,83 @@
+// Package requestid bitta so'rovni gateway, servislar va Kafka xabarlari
+// bo'ylab kuzatish uchun X-Request-ID ni context orqali uzatadi.
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// Header HTTP va Kafka header nomi
+	Header = "X-Request-ID"
+	// MetadataKey gRPC metadata kaliti (metadata kalitlari kichik harfda bo'ladi)
+	MetadataKey = "x-request-id"
+
+	maxLength = 128
+)
+
+type contextKey struct{}
+
+func New() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
+
+// Valid tashqaridan kelgan ID ni tekshiradi: bo'sh emas, uzun emas va faqat ko'rinadigan ASCII
+func Valid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// UnaryServerInterceptor kiruvchi metadata dagi ID ni (bo'lmasa yangisini) context ga qo'yadi
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		id := ""
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(MetadataKey); len(values) > 0 && Valid(values[0]) {
+				id = values[0]
+			}
+		}
+		if id == "" {
+			id = New()
+		}
+
+		return handler(NewContext(ctx, id), req)
+	}
+}
+
+// UnaryClientInterceptor context dagi ID ni chiquvchi metadata ga qo'shadi
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if id := FromContext(ctx); id != "" {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			if len(md.Get(MetadataKey)) == 0 {
+				ctx = metadata.AppendToOutgoingContext(ctx, MetadataKey, id)
+			}
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
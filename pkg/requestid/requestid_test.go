@@ -0,0 +1,81 @@
+package requestid
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestValid(t *testing.T) {
+	for id, want := range map[string]bool{
+		New():                    true,
+		"req-42":                 true,
+		"":                       false,
+		"has space":              false,
+		"new\nline":              false,
+		"ünïcode":                false,
+		strings.Repeat("a", 128): true,
+		strings.Repeat("a", 129): false,
+	} {
+		if got := Valid(id); got != want {
+			t.Errorf("Valid(%q) = %v, want %v", id, got, want)
+		}
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+	var got string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		got = FromContext(ctx)
+		return nil, nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, "req-42"))
+	interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	if got != "req-42" {
+		t.Errorf("incoming id = %q, want req-42", got)
+	}
+
+	// noto'g'ri yoki yo'q ID o'rniga yangisi yaratiladi
+	for _, md := range []metadata.MD{nil, metadata.Pairs(MetadataKey, "bad id")} {
+		ctx := context.Background()
+		if md != nil {
+			ctx = metadata.NewIncomingContext(ctx, md)
+		}
+		interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+		if !Valid(got) || got == "bad id" {
+			t.Errorf("generated id = %q", got)
+		}
+	}
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	interceptor := UnaryClientInterceptor()
+	var sent []string
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		sent = md.Get(MetadataKey)
+		return nil
+	}
+
+	interceptor(NewContext(context.Background(), "req-42"), "/user.UserService/GetUser", nil, nil, nil, invoker)
+	if len(sent) != 1 || sent[0] != "req-42" {
+		t.Errorf("sent %v, want [req-42]", sent)
+	}
+
+	// metadata da allaqachon bor ID takrorlanmaydi
+	ctx := metadata.AppendToOutgoingContext(NewContext(context.Background(), "req-42"), MetadataKey, "req-42")
+	interceptor(ctx, "/user.UserService/GetUser", nil, nil, nil, invoker)
+	if len(sent) != 1 {
+		t.Errorf("sent %v, want one id", sent)
+	}
+
+	interceptor(context.Background(), "/user.UserService/GetUser", nil, nil, nil, invoker)
+	if len(sent) != 0 {
+		t.Errorf("sent %v without an id in context", sent)
+	}
+}
@@ -0,0 +1,99 @@
+// Package tracing OpenTelemetry tracer provider ni sozlaydi. Kontekst
+// servislar orasida W3C trace-context (traceparent) headerlari orqali uzatiladi.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+)
+
+type Config struct {
+	ServiceName string
+	Environment string
+	// Exporter: none, stdout yoki otlp. Bo'sh bo'lsa none.
+	Exporter string
+	// Endpoint OTLP collector manzili (host:port, gRPC)
+	Endpoint string
+	Insecure bool
+	// SampleRatio 0..1 oralig'ida, bo'sh bo'lsa hamma trace yoziladi
+	SampleRatio string
+}
+
+// Init global tracer provider va propagatorni o'rnatadi. Qaytarilgan funksiya
+// dastur to'xtaganda buferdagi spanlarni yuborish uchun chaqiriladi.
+func Init(ctx context.Context, c Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch c.Exporter {
+	case "", ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterStdout:
+		exporter, err = stdouttrace.New()
+	case ExporterOTLP:
+		opts := []otlptracegrpc.Option{}
+		if c.Endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(c.Endpoint))
+		}
+		if c.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", c.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error creating trace exporter: %v", err)
+	}
+
+	ratio := 1.0
+	if c.SampleRatio != "" {
+		ratio, err = strconv.ParseFloat(c.SampleRatio, 64)
+		if err != nil || ratio < 0 || ratio > 1 {
+			return nil, fmt.Errorf("invalid sample ratio %q", c.SampleRatio)
+		}
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(c.ServiceName),
+		semconv.DeploymentEnvironment(c.Environment),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("error creating trace resource: %v", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		// yuqoridagi servis trace ni yozgan bo'lsa biz ham yozamiz
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Tracer servis ichidagi qo'lda ochiladigan spanlar uchun
+func Tracer() trace.Tracer {
+	return otel.Tracer("minitwitter")
+}
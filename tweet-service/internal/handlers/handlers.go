@@ -2,6 +2,10 @@ package handlers
 
 import (
 	"context"
+	"log/slog"
+	"pkg/grpcerr"
+	"pkg/pagination"
+	"pkg/principal"
 	"tweet-service/internal/methods"
 	"tweet-service/internal/models"
 	"tweet-service/internal/service"
@@ -20,6 +24,10 @@ func NewTweetHandler(service service.TweetService) *TweetHandler {
 }
 
 func (h *TweetHandler) CreateTweet(ctx context.Context, req *pb.CreateTweetRequest) (*pb.CreateTweetResponse, error) {
+	if err := checkCaller(ctx, int64(req.UserId)); err != nil {
+		return nil, err
+	}
+
 	userClient := methods.ConnectUser()
 	user, err := userClient.GetUser(ctx, &pb.GetUserRequest{UserId: req.UserId})
 	if err != nil {
@@ -29,7 +37,7 @@ func (h *TweetHandler) CreateTweet(ctx context.Context, req *pb.CreateTweetReque
 	tweet := &models.Tweet{
 		Content:  req.Content,
 		UserID:   int64(req.UserId),
-		Media:    req.Media,
+		MediaIDs: req.MediaIds,
 		Username: user.User.Username,
 	}
 
@@ -45,6 +53,10 @@ func (h *TweetHandler) CreateTweet(ctx context.Context, req *pb.CreateTweetReque
 }
 
 func (h *TweetHandler) UpdateTweet(ctx context.Context, req *pb.UpdateTweetRequest) (*pb.UpdateTweetResponse, error) {
+	if err := h.checkOwner(ctx, req.TweetId); err != nil {
+		return nil, err
+	}
+
 	tweet := &models.Tweet{
 		ID:      int64(req.TweetId),
 		Content: req.Content,
@@ -61,6 +73,10 @@ func (h *TweetHandler) UpdateTweet(ctx context.Context, req *pb.UpdateTweetReque
 }
 
 func (h *TweetHandler) DeleteTweet(ctx context.Context, req *pb.DeleteTweetRequest) (*pb.DeleteTweetResponse, error) {
+	if err := h.checkOwner(ctx, req.TweetId); err != nil {
+		return nil, err
+	}
+
 	err := h.service.DeleteTweet(ctx, req.TweetId)
 	if err != nil {
 		return nil, err
@@ -77,32 +93,96 @@ func (h *TweetHandler) DeleteTweet(ctx context.Context, req *pb.DeleteTweetReque
 	}, nil
 }
 
+// checkOwner gateway uzatgan foydalanuvchi tweet egasi ekanini tekshiradi.
+// x-user-id bo'lmasa so'rov rad etiladi.
+func (h *TweetHandler) checkOwner(ctx context.Context, tweetID int32) error {
+	callerID, ok := principal.CallerID(ctx)
+	if !ok {
+		return service.ErrUnauthenticated
+	}
+	ownerID, err := h.service.GetTweetOwner(ctx, tweetID)
+	if err != nil {
+		return err
+	}
+	if ownerID != callerID {
+		return service.ErrNotTweetOwner
+	}
+	return nil
+}
+
+// checkCaller so'rovdagi user_id gateway uzatgan foydalanuvchi ekanini tekshiradi
+func checkCaller(ctx context.Context, userID int64) error {
+	callerID, ok := principal.CallerID(ctx)
+	if !ok {
+		return service.ErrUnauthenticated
+	}
+	if callerID != userID {
+		return service.ErrNotTweetOwner
+	}
+	return nil
+}
+
 func (h *TweetHandler) GetTweetByID(ctx context.Context, req *pb.GetTweetByIDRequest) (*pb.GetTweetByIDResponse, error) {
-	tweet, err := h.service.GetTweetByID(ctx, req.TweetId, req.UserIdToGet)
+	tweet, err := h.service.GetTweetByID(ctx, req.TweetId, req.ViewerId)
 	if err != nil {
 		return nil, err
 	}
 
+	protoTweet := &pb.Tweet{
+		Id:        int32(tweet.ID),
+		Content:   tweet.Content,
+		UserId:    int32(tweet.UserID),
+		Username:  tweet.Username,
+		CreatedAt: timestamppb.New(tweet.CreatedAt),
+		Likes:     tweet.Likes,
+		Comments:  tweet.Comments,
+		Shares:    tweet.Shares,
+		Saves:     tweet.Saves,
+	}
+	setMedia(ctx, []*pb.Tweet{protoTweet}, []*models.Tweet{tweet})
+
 	return &pb.GetTweetByIDResponse{
 		Success: true,
 		Message: "Tweet fetched successfully",
-		Tweet: &pb.Tweet{
+		Tweet:   protoTweet,
+	}, nil
+}
+
+func (h *TweetHandler) GetTweetsByIds(ctx context.Context, req *pb.GetTweetsByIdsRequest) (*pb.GetTweetsByIdsResponse, error) {
+	tweets, err := h.service.GetTweetsByIDs(ctx, req.TweetIds, req.ViewerId)
+	if err != nil {
+		return nil, err
+	}
+
+	protoTweets := make([]*pb.Tweet, len(tweets))
+	for i, tweet := range tweets {
+		protoTweets[i] = &pb.Tweet{
 			Id:        int32(tweet.ID),
 			Content:   tweet.Content,
 			UserId:    int32(tweet.UserID),
 			Username:  tweet.Username,
 			CreatedAt: timestamppb.New(tweet.CreatedAt),
-			Media:     tweet.Media,
 			Likes:     tweet.Likes,
 			Comments:  tweet.Comments,
 			Shares:    tweet.Shares,
 			Saves:     tweet.Saves,
-		},
+		}
+	}
+	setMedia(ctx, protoTweets, tweets)
+
+	return &pb.GetTweetsByIdsResponse{
+		Success: true,
+		Message: "Tweets fetched successfully",
+		Tweets:  protoTweets,
 	}, nil
 }
 
 func (h *TweetHandler) GetSavedTweets(ctx context.Context, req *pb.GetSavedTweetsRequest) (*pb.GetSavedTweetsResponse, error) {
-	tweets, err := h.service.GetSavedTweets(ctx, req.UserId)
+	page, err := pagination.New(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	tweets, next, err := h.service.GetSavedTweets(ctx, req.UserId, page)
 	if err != nil {
 		return nil, err
 	}
@@ -115,23 +195,28 @@ func (h *TweetHandler) GetSavedTweets(ctx context.Context, req *pb.GetSavedTweet
 			UserId:    int32(tweet.UserID),
 			Username:  tweet.Username,
 			CreatedAt: timestamppb.New(tweet.CreatedAt),
-			Media:     tweet.Media,
 			Likes:     tweet.Likes,
 			Comments:  tweet.Comments,
 			Shares:    tweet.Shares,
 			Saves:     tweet.Saves,
 		}
 	}
+	setMedia(ctx, protoTweets, tweets)
 
 	return &pb.GetSavedTweetsResponse{
-		Success: true,
-		Message: "Saved tweets fetched successfully",
-		Tweets:  protoTweets,
+		Success:       true,
+		Message:       "Saved tweets fetched successfully",
+		Tweets:        protoTweets,
+		NextPageToken: next,
 	}, nil
 }
 
 func (h *TweetHandler) GetLikedTweets(ctx context.Context, req *pb.GetLikedTweetsRequest) (*pb.GetLikedTweetsResponse, error) {
-	tweets, err := h.service.GetLikedTweets(ctx, req.UserId)
+	page, err := pagination.New(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	tweets, next, err := h.service.GetLikedTweets(ctx, req.UserId, page)
 	if err != nil {
 		return nil, err
 	}
@@ -144,23 +229,28 @@ func (h *TweetHandler) GetLikedTweets(ctx context.Context, req *pb.GetLikedTweet
 			UserId:    int32(tweet.UserID),
 			Username:  tweet.Username,
 			CreatedAt: timestamppb.New(tweet.CreatedAt),
-			Media:     tweet.Media,
 			Likes:     tweet.Likes,
 			Comments:  tweet.Comments,
 			Shares:    tweet.Shares,
 			Saves:     tweet.Saves,
 		}
 	}
+	setMedia(ctx, protoTweets, tweets)
 
 	return &pb.GetLikedTweetsResponse{
-		Success: true,
-		Message: "Liked tweets fetched successfully",
-		Tweets:  protoTweets,
+		Success:       true,
+		Message:       "Liked tweets fetched successfully",
+		Tweets:        protoTweets,
+		NextPageToken: next,
 	}, nil
 }
 
 func (h *TweetHandler) GetTweetsByUser(ctx context.Context, req *pb.GetTweetsByUserRequest) (*pb.GetTweetsByUserResponse, error) {
-	tweets, err := h.service.GetTweetsByUser(ctx, req.UserId, req.UserIdToGet)
+	page, err := pagination.New(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	tweets, next, err := h.service.GetTweetsByUser(ctx, req.UserId, req.ViewerId, page)
 	if err != nil {
 		return nil, err
 	}
@@ -173,18 +263,19 @@ func (h *TweetHandler) GetTweetsByUser(ctx context.Context, req *pb.GetTweetsByU
 			UserId:    int32(tweet.UserID),
 			Username:  tweet.Username,
 			CreatedAt: timestamppb.New(tweet.CreatedAt),
-			Media:     tweet.Media,
 			Likes:     tweet.Likes,
 			Comments:  tweet.Comments,
 			Shares:    tweet.Shares,
 			Saves:     tweet.Saves,
 		}
 	}
+	setMedia(ctx, protoTweets, tweets)
 
 	return &pb.GetTweetsByUserResponse{
-		Success: true,
-		Message: "User tweets fetched successfully",
-		Tweets:  protoTweets,
+		Success:       true,
+		Message:       "User tweets fetched successfully",
+		Tweets:        protoTweets,
+		NextPageToken: next,
 	}, nil
 }
 
@@ -271,3 +362,31 @@ func (h *TweetHandler) RemoveSave(ctx context.Context, req *pb.RemoveSaveRequest
 		Message: "Save removed successfully",
 	}, nil
 }
+
+func (h *TweetHandler) AdminDeleteTweet(ctx context.Context, req *pb.AdminDeleteTweetRequest) (*pb.AdminDeleteTweetResponse, error) {
+	if role := principal.CallerRole(ctx); role != "moderator" && role != "admin" {
+		return nil, grpcerr.ErrPermissionDenied
+	}
+
+	ownerID, err := h.service.GetTweetOwner(ctx, req.TweetId)
+	if err != nil {
+		return nil, err
+	}
+	err = h.service.DeleteTweet(ctx, req.TweetId)
+	if err != nil {
+		return nil, err
+	}
+	userClient := methods.ConnectUser()
+	_, err = userClient.RemoveTweet(ctx, &pb.RemoveTweetRequest{TweetId: req.TweetId, UserId: int32(ownerID)})
+	if err != nil {
+		return nil, err
+	}
+
+	callerID, _ := principal.CallerID(ctx)
+	slog.InfoContext(ctx, "tweet deleted by moderator", "tweet_id", req.TweetId, "moderator_id", callerID, "reason", req.Reason)
+
+	return &pb.AdminDeleteTweetResponse{
+		Success: true,
+		Message: "Tweet deleted successfully",
+	}, nil
+}
@@ -0,0 +1,291 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"pkg/grpcerr"
+	"pkg/pagination"
+	"regexp"
+	"testing"
+	"time"
+	"tweet-service/internal/methods"
+	"tweet-service/internal/service"
+	pb "tweet-service/pkg/proto"
+	"tweet-service/utils"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// fakeUsers tweet egasini qaytaradigan user-service
+type fakeUsers struct {
+	pb.UserServiceClient
+	user *pb.User
+	// batches GetUsersByIds ga kelgan ID lar
+	batches [][]int32
+	// detached DetachMedia ga kelgan media ID lari
+	detached []string
+}
+
+func (f *fakeUsers) GetUser(ctx context.Context, req *pb.GetUserRequest, opts ...grpc.CallOption) (*pb.GetUserResponse, error) {
+	if req.UserId != f.user.Id {
+		return nil, service.ErrTweetNotFound
+	}
+	// user-service kabi: bloklangan viewer uchun foydalanuvchi topilmaydi
+	if req.ViewerId != 0 && req.ViewerId != f.user.Id && utils.Contains(f.user.BlockedUsers, req.ViewerId) {
+		return nil, grpcerr.NotFound("USER_NOT_FOUND", "user not found")
+	}
+	return &pb.GetUserResponse{User: f.user}, nil
+}
+
+func (f *fakeUsers) GetUsersByIds(ctx context.Context, req *pb.GetUsersByIdsRequest, opts ...grpc.CallOption) (*pb.GetUsersByIdsResponse, error) {
+	f.batches = append(f.batches, req.UserIds)
+	resp := &pb.GetUsersByIdsResponse{}
+	for _, id := range req.UserIds {
+		if id == f.user.Id {
+			resp.Users = append(resp.Users, f.user)
+		}
+	}
+	return resp, nil
+}
+
+func (f *fakeUsers) GetMediaByIds(ctx context.Context, req *pb.GetMediaByIdsRequest, opts ...grpc.CallOption) (*pb.GetMediaByIdsResponse, error) {
+	return &pb.GetMediaByIdsResponse{}, nil
+}
+
+func (f *fakeUsers) DetachMedia(ctx context.Context, req *pb.DetachMediaRequest, opts ...grpc.CallOption) (*pb.DetachMediaResponse, error) {
+	if req.UserId != f.user.Id {
+		return nil, errors.New("media of another user")
+	}
+	f.detached = append(f.detached, req.Ids...)
+	return &pb.DetachMediaResponse{Success: true}, nil
+}
+
+func (f *fakeUsers) RemoveTweet(ctx context.Context, req *pb.RemoveTweetRequest, opts ...grpc.CallOption) (*pb.RemoveTweetResponse, error) {
+	return &pb.RemoveTweetResponse{Success: true}, nil
+}
+
+func newTestHandler(t *testing.T, owner *pb.User) (*TweetHandler, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	methods.SetUserClient(&fakeUsers{user: owner})
+	return NewTweetHandler(*service.NewTweetService(db, nil)), mock
+}
+
+// tweetRows tweetColumns tartibidagi qatorlar; massivlar lib/pq qaytaradigan
+// ko'rinishda, media da eski tweetlardagi kabi URL
+func tweetRows(userID int64, ids ...int64) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "content", "user_id", "username", "created_at", "media", "media_ids", "likes", "comments", "shares", "saves"})
+	for _, id := range ids {
+		rows.AddRow(id, "hello", userID, "alice", time.Now(), []byte("{http://cdn/old.jpg}"), []byte("{}"), []byte("{3}"), []byte("{}"), []byte("{}"), []byte("{}"))
+	}
+	return rows
+}
+
+var viewerTests = []struct {
+	name    string
+	viewer  int32
+	owner   *pb.User
+	wantErr error
+}{
+	{"viewer is not blocked", 2, &pb.User{Id: 1, BlockedUsers: []int32{3}}, nil},
+	{"internal lookup", 0, &pb.User{Id: 1, BlockedUsers: []int32{2}, IsPrivate: true}, nil},
+	{"owner", 1, &pb.User{Id: 1, IsPrivate: true}, nil},
+	{"follower of a private account", 2, &pb.User{Id: 1, IsPrivate: true, Followers: []int32{2}}, nil},
+	{"viewer is blocked", 2, &pb.User{Id: 1, BlockedUsers: []int32{3, 2}}, service.ErrBlockedByUser},
+	{"blocked follower", 2, &pb.User{Id: 1, IsPrivate: true, Followers: []int32{2}, BlockedUsers: []int32{2}}, service.ErrBlockedByUser},
+	{"private account", 2, &pb.User{Id: 1, IsPrivate: true, Followers: []int32{3}}, service.ErrPrivateAccount},
+}
+
+func TestGetTweetByIDChecksViewer(t *testing.T) {
+	for _, tt := range viewerTests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, mock := newTestHandler(t, tt.owner)
+			mock.ExpectQuery(regexp.QuoteMeta("FROM tweets WHERE id = $1")).WithArgs(10).WillReturnRows(tweetRows(1, 10))
+
+			resp, err := h.GetTweetByID(context.Background(), &pb.GetTweetByIDRequest{TweetId: 10, ViewerId: tt.viewer})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (resp.Tweet.Id != 10 || resp.Tweet.UserId != 1 || len(resp.Tweet.Likes) != 1 || len(resp.Tweet.Media) != 1) {
+				t.Errorf("unexpected tweet %+v", resp.Tweet)
+			}
+		})
+	}
+}
+
+func TestGetTweetsByUserListsRequestedUser(t *testing.T) {
+	for _, tt := range viewerTests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, mock := newTestHandler(t, tt.owner)
+			if tt.wantErr == nil {
+				// path dagi foydalanuvchi tweetlari, so'rov qiluvchiniki emas
+				mock.ExpectQuery(regexp.QuoteMeta("FROM tweets WHERE user_id = $1")).
+					WithArgs(1, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnRows(tweetRows(1, 12, 11))
+			}
+
+			resp, err := h.GetTweetsByUser(context.Background(), &pb.GetTweetsByUserRequest{UserId: 1, ViewerId: tt.viewer})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (len(resp.Tweets) != 2 || resp.Tweets[0].UserId != 1) {
+				t.Errorf("unexpected tweets %+v", resp.Tweets)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestUpdateTweetChecksOwner(t *testing.T) {
+	tests := []struct {
+		name    string
+		md      metadata.MD
+		wantErr error
+	}{
+		{"owner", metadata.Pairs("x-user-id", "1"), nil},
+		{"another user", metadata.Pairs("x-user-id", "2"), service.ErrNotTweetOwner},
+		{"missing caller", nil, service.ErrUnauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, mock := newTestHandler(t, &pb.User{Id: 1})
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT user_id FROM tweets WHERE id = $1")).WithArgs(10).
+					WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(1))
+			}
+			if tt.wantErr == nil {
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE tweets")).WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+
+			_, err := h.UpdateTweet(ctx, &pb.UpdateTweetRequest{TweetId: 10, Content: "edited"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestDeleteTweetReleasesMedia(t *testing.T) {
+	h, mock := newTestHandler(t, &pb.User{Id: 1})
+	users := methods.ConnectUser().(*fakeUsers)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "1"))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT user_id FROM tweets WHERE id = $1")).WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta("DELETE FROM tweets WHERE id = $1")).WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "media_ids"}).AddRow(1, []byte("{m1,m2}")))
+
+	if _, err := h.DeleteTweet(ctx, &pb.DeleteTweetRequest{TweetId: 10, UserId: 1}); err != nil {
+		t.Fatal(err)
+	}
+	// ajratilgan media ni gateway o'chiradi va kvota bo'shaydi
+	if len(users.detached) != 2 || users.detached[0] != "m1" || users.detached[1] != "m2" {
+		t.Errorf("detached media = %v, want [m1 m2]", users.detached)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAdminDeleteTweetChecksRole(t *testing.T) {
+	for _, role := range []string{"", "user"} {
+		t.Run("role "+role, func(t *testing.T) {
+			h, mock := newTestHandler(t, &pb.User{Id: 1})
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "2", "x-user-role", role))
+
+			_, err := h.AdminDeleteTweet(ctx, &pb.AdminDeleteTweetRequest{TweetId: 10, Reason: "spam"})
+			if !errors.Is(err, grpcerr.ErrPermissionDenied) {
+				t.Fatalf("err = %v, want %v", err, grpcerr.ErrPermissionDenied)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestGetTweetsByUserPaginates(t *testing.T) {
+	h, mock := newTestHandler(t, &pb.User{Id: 1})
+	// page_size+1 qator: keyingi sahifa bor
+	mock.ExpectQuery(regexp.QuoteMeta("FROM tweets WHERE user_id = $1 AND (created_at, id) < ($2, $3)")).
+		WithArgs(1, sqlmock.AnyArg(), sqlmock.AnyArg(), 3).
+		WillReturnRows(tweetRows(1, 13, 12, 11))
+
+	resp, err := h.GetTweetsByUser(context.Background(), &pb.GetTweetsByUserRequest{UserId: 1, PageSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Tweets) != 2 || resp.Tweets[1].Id != 12 || resp.NextPageToken == "" {
+		t.Fatalf("first page: %d tweets, next %q", len(resp.Tweets), resp.NextPageToken)
+	}
+
+	// keyingi sahifa oldingi sahifaning oxirgi tweetidan keyin boshlanadi
+	cursor, err := pagination.Decode(resp.NextPageToken)
+	if err != nil || cursor.ID != 12 {
+		t.Fatalf("next page cursor = %+v, %v", cursor, err)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta("FROM tweets WHERE user_id = $1")).
+		WithArgs(1, cursor.CreatedAt, int64(12), 3).
+		WillReturnRows(tweetRows(1, 11))
+	resp, err = h.GetTweetsByUser(context.Background(), &pb.GetTweetsByUserRequest{UserId: 1, PageSize: 2, PageToken: resp.NextPageToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Tweets) != 1 || resp.NextPageToken != "" {
+		t.Errorf("last page: %d tweets, next %q", len(resp.Tweets), resp.NextPageToken)
+	}
+
+	if _, err := h.GetTweetsByUser(context.Background(), &pb.GetTweetsByUserRequest{UserId: 1, PageToken: "bogus"}); !errors.Is(err, pagination.ErrInvalidPageToken) {
+		t.Errorf("bogus token: err = %v, want %v", err, pagination.ErrInvalidPageToken)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetTweetsByIdsBatchesOwners(t *testing.T) {
+	h, mock := newTestHandler(t, &pb.User{Id: 1, IsPrivate: true, Followers: []int32{2}})
+	users := methods.ConnectUser().(*fakeUsers)
+	rows := tweetRows(1, 10, 11)
+	rows.AddRow(12, "hello", 9, "bob", time.Now(), nil, []byte("{}"), []byte("{}"), []byte("{}"), []byte("{}"), []byte("{}"))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM tweets WHERE id = ANY($1)")).WillReturnRows(rows)
+
+	// 12 ning egasi topilmadi (yoki bloklagan), 13 yo'q; javob so'rov tartibida
+	resp, err := h.GetTweetsByIds(context.Background(), &pb.GetTweetsByIdsRequest{TweetIds: []int32{11, 13, 12, 10, 11}, ViewerId: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Tweets) != 2 || resp.Tweets[0].Id != 11 || resp.Tweets[1].Id != 10 {
+		t.Errorf("unexpected tweets %+v", resp.Tweets)
+	}
+	if len(users.batches) != 1 || len(users.batches[0]) != 2 {
+		t.Errorf("owner lookups = %v, want one batch of 2", users.batches)
+	}
+
+	// yopiq akkauntga obuna bo'lmagan foydalanuvchi
+	mock.ExpectQuery(regexp.QuoteMeta("FROM tweets WHERE id = ANY($1)")).WillReturnRows(tweetRows(1, 10))
+	resp, err = h.GetTweetsByIds(context.Background(), &pb.GetTweetsByIdsRequest{TweetIds: []int32{10}, ViewerId: 3})
+	if err != nil || len(resp.Tweets) != 0 {
+		t.Errorf("private tweets: %d, %v; want none", len(resp.GetTweets()), err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+
+	_, err = h.GetTweetsByIds(context.Background(), &pb.GetTweetsByIdsRequest{TweetIds: make([]int32, service.MaxTweetsPerBatch+1)})
+	if !errors.Is(err, service.ErrTooManyTweetIDs) {
+		t.Errorf("oversized batch: err = %v, want %v", err, service.ErrTooManyTweetIDs)
+	}
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"tweet-service/internal/methods"
+	"tweet-service/internal/models"
+	pb "tweet-service/pkg/proto"
+)
+
+// setMedia eski tweetlarning URL larini qo'shadi va media ID larini bitta
+// GetMediaByIds so'rovi bilan URL va tavsifga aylantiradi. user-service javob bermasa tweet lar media siz
+// qaytariladi, o'chirilgan media esa tashlab ketiladi.
+func setMedia(ctx context.Context, protoTweets []*pb.Tweet, tweets []*models.Tweet) {
+	var ids []string
+	for i, tweet := range tweets {
+		protoTweets[i].Media = append(protoTweets[i].Media, tweet.Media...)
+		protoTweets[i].MediaCount = int32(len(tweet.Media) + len(tweet.MediaIDs))
+		ids = append(ids, tweet.MediaIDs...)
+	}
+	if len(ids) == 0 {
+		return
+	}
+	resp, err := methods.ConnectUser().GetMediaByIds(ctx, &pb.GetMediaByIdsRequest{Ids: ids})
+	if err != nil {
+		slog.WarnContext(ctx, "failed to get tweet media", "error", err)
+		return
+	}
+	media := make(map[string]*pb.Media, len(resp.Media))
+	for _, m := range resp.Media {
+		media[m.Id] = m
+	}
+	for i, tweet := range tweets {
+		for _, id := range tweet.MediaIDs {
+			m, ok := media[id]
+			if !ok {
+				continue
+			}
+			protoTweets[i].Media = append(protoTweets[i].Media, m.Url)
+			protoTweets[i].Attachments = append(protoTweets[i].Attachments, &pb.TweetMedia{
+				Id:         m.Id,
+				Url:        m.Url,
+				MimeType:   m.MimeType,
+				Width:      m.Width,
+				Height:     m.Height,
+				DurationMs: m.DurationMs,
+				Blurhash:   m.Blurhash,
+				AltText:    m.AltText,
+				Renditions: m.Renditions,
+			})
+		}
+	}
+}
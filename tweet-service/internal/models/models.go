@@ -8,7 +8,9 @@ type Tweet struct {
 	UserID    int64     `json:"user_id"`
 	Username  string    `json:"username"`
 	CreatedAt time.Time `json:"created_at"`
-	Media     []string  `json:"media"`	
+	// Media eski tweetlardagi URL lar, yangi tweetlarda MediaIDs
+	Media     []string  `json:"media"`
+	MediaIDs  []string  `json:"media_ids"`
 	Likes     []int32   `json:"likes"`
 	Comments  []int32   `json:"comments"`
 	Shares    []int32   `json:"shares"`
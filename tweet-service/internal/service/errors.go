@@ -0,0 +1,15 @@
+package service
+
+import "pkg/grpcerr"
+
+// Servis xatolari. Reason qiymatlari gateway javobida "code" bo'lib chiqadi.
+var (
+	ErrUnauthenticated = grpcerr.Unauthenticated("UNAUTHENTICATED", "caller is not authenticated")
+	ErrTweetNotFound   = grpcerr.NotFound("TWEET_NOT_FOUND", "tweet not found")
+	ErrNotTweetOwner   = grpcerr.PermissionDenied("NOT_TWEET_OWNER", "you are not the owner of this tweet")
+	ErrPrivateAccount  = grpcerr.PermissionDenied("PRIVATE_ACCOUNT", "user is private and you are not following")
+	ErrBlockedByUser   = grpcerr.PermissionDenied("BLOCKED_BY_USER", "user has blocked you")
+	ErrTooManyMedia    = grpcerr.InvalidArgument("TOO_MANY_MEDIA", "media_ids", "a tweet can have at most 4 media")
+	ErrMediaNotFound   = grpcerr.NotFound("MEDIA_NOT_FOUND", "media not found")
+	ErrTooManyTweetIDs = grpcerr.InvalidArgument("TOO_MANY_TWEET_IDS", "tweet_ids", "at most 100 tweet ids per request")
+)
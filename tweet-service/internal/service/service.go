@@ -3,13 +3,17 @@ package service
 import (
 	"context"
 	"database/sql"
-	"errors"
+	"log/slog"
+	"pkg/pagination"
 	"tweet-service/internal/methods"
 	"tweet-service/internal/models"
 	"tweet-service/pkg/proto"
 	"tweet-service/utils"
 
 	"github.com/go-redis/redis/v8"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type TweetService struct {
@@ -21,24 +25,31 @@ func NewTweetService(db *sql.DB, redisClient *redis.Client) *TweetService {
 	return &TweetService{db: db, RedisClient: redisClient}
 }
 
+// MaxTweetMedia bitta tweet dagi media soni chegarasi
+const MaxTweetMedia = 4
+
+// tweetColumns scanTweet tartibida. media eski tweetlardagi URL lar, yangi
+// tweetlar media_ids ga yoziladi.
+const tweetColumns = "id, content, user_id, username, created_at, media, media_ids, likes, comments, shares, saves"
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanTweet(row rowScanner, tweet *models.Tweet) error {
+	return row.Scan(&tweet.ID, &tweet.Content, &tweet.UserID, &tweet.Username, &tweet.CreatedAt, pq.Array(&tweet.Media),
+		pq.Array(&tweet.MediaIDs), pq.Array(&tweet.Likes), pq.Array(&tweet.Comments), pq.Array(&tweet.Shares), pq.Array(&tweet.Saves))
+}
+
 func (s *TweetService) CreateTweet(ctx context.Context, tweet *models.Tweet) error {
+	if err := checkMedia(ctx, tweet.UserID, tweet.MediaIDs); err != nil {
+		return err
+	}
 	query := `
-		INSERT INTO tweets (user_id, content, created_at)
-		VALUES ($1, $2, $3)
-		RETURNING *
-	`
-	err := s.db.QueryRowContext(ctx, query, tweet.UserID, tweet.Content, tweet.CreatedAt).Scan(
-		&tweet.ID,
-		&tweet.Content,
-		&tweet.UserID,
-		&tweet.Username,
-		&tweet.CreatedAt,
-		&tweet.Media,
-		&tweet.Likes,
-		&tweet.Comments,
-		&tweet.Shares,
-		&tweet.Saves,
-	)
+		INSERT INTO tweets (user_id, content, created_at, media_ids)
+		VALUES ($1, $2, $3, $4)
+		RETURNING ` + tweetColumns
+	err := scanTweet(s.db.QueryRowContext(ctx, query, tweet.UserID, tweet.Content, tweet.CreatedAt, pq.Array(tweet.MediaIDs)), tweet)
 
 	if err != nil {
 		return err
@@ -46,55 +57,42 @@ func (s *TweetService) CreateTweet(ctx context.Context, tweet *models.Tweet) err
 	return nil
 }
 
-func (s *TweetService) GetTweetsByUser(ctx context.Context, userID, userIDToGet int32) ([]*models.Tweet, error) {
+// GetTweetsByUser userID ning tweetlari, viewerID ko'ra oladigan bo'lsa
+func (s *TweetService) GetTweetsByUser(ctx context.Context, userID, viewerID int32, page pagination.Page) ([]*models.Tweet, string, error) {
 	query := `
-		SELECT * FROM tweets WHERE user_id = $1
+		SELECT ` + tweetColumns + ` FROM tweets WHERE user_id = $1 AND (created_at, id) < ($2, $3)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $4
 	`
-	users := methods.ConnectUser()
-	user, err := users.GetUser(ctx, &proto.GetUserRequest{UserId: userID})
+	owner, err := getOwner(ctx, userID, viewerID)
 	if err != nil {
-		return nil, err
-	}
-	if user.User.IsPrivate {
-		if !utils.Contains(user.User.Followers, userIDToGet) {
-			return nil, errors.New("user is private and you are blocked")
-		}
+		return nil, "", err
 	}
-
-	if !utils.Contains(user.User.BlockedUsers, userIDToGet) {
-		return nil, errors.New("user is block you")
+	if err := checkViewer(owner, viewerID); err != nil {
+		return nil, "", err
 	}
-	rows, err := s.db.QueryContext(ctx, query, userIDToGet)
+	rows, err := s.db.QueryContext(ctx, query, userID, page.After.CreatedAt, page.After.ID, page.Limit())
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	defer rows.Close()
 
 	tweets := []*models.Tweet{}
 	for rows.Next() {
 		var tweet models.Tweet
-		err := rows.Scan(
-			&tweet.ID,
-			&tweet.Content,
-			&tweet.UserID,
-			&tweet.Username,
-			&tweet.CreatedAt,
-			&tweet.Media,
-			&tweet.Likes,
-			&tweet.Comments,
-			&tweet.Shares,
-			&tweet.Saves,
-		)
+		err := scanTweet(rows, &tweet)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
+		tweet.MediaCount = int64(len(tweet.Media) + len(tweet.MediaIDs))
 		tweet.LikeCount = int64(len(tweet.Likes))
 		tweet.CommentCount = int64(len(tweet.Comments))
 		tweet.ShareCount = int64(len(tweet.Shares))
 		tweet.SaveCount = int64(len(tweet.Saves))
 		tweets = append(tweets, &tweet)
 	}
-	return tweets, nil
+	tweets, next := pagination.Trim(tweets, page, tweetCursor)
+	return tweets, next, nil
 }
 
 func (s *TweetService) UpdateTweet(ctx context.Context, tweet *models.Tweet) error {
@@ -108,21 +106,42 @@ func (s *TweetService) UpdateTweet(ctx context.Context, tweet *models.Tweet) err
 	return nil
 }
 
+func (s *TweetService) GetTweetOwner(ctx context.Context, tweetID int32) (int64, error) {
+	query := `SELECT user_id FROM tweets WHERE id = $1`
+	var userID int64
+	err := s.db.QueryRowContext(ctx, query, tweetID).Scan(&userID)
+	if err == sql.ErrNoRows {
+		return 0, ErrTweetNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+	return userID, nil
+}
+
+// DeleteTweet tweetni o'chiradi va uning media larini bo'shatadi
 func (s *TweetService) DeleteTweet(ctx context.Context, tweetID int32) error {
 	query := `
 		DELETE FROM tweets WHERE id = $1
+		RETURNING user_id, media_ids
 	`
-	_, err := s.db.ExecContext(ctx, query, tweetID)
+	var userID int64
+	var mediaIDs []string
+	err := s.db.QueryRowContext(ctx, query, tweetID).Scan(&userID, pq.Array(&mediaIDs))
+	if err == sql.ErrNoRows {
+		return ErrTweetNotFound
+	}
 	if err != nil {
 		return err
 	}
+	releaseMedia(ctx, userID, mediaIDs)
 	return nil
 }
 
 func (s *TweetService) AddLike(ctx context.Context, tweetID int32, userID int32) error {
-	query := `SELECT * FROM tweets WHERE id = $1 RETURNING *`
+	query := "SELECT " + tweetColumns + " FROM tweets WHERE id = $1"
 	var tweet models.Tweet
-	err := s.db.QueryRowContext(ctx, query, tweetID).Scan(&tweet.ID, &tweet.Content, &tweet.UserID, &tweet.Username, &tweet.CreatedAt, &tweet.Media, &tweet.Likes, &tweet.Comments, &tweet.Shares, &tweet.Saves)
+	err := scanTweet(s.db.QueryRowContext(ctx, query, tweetID), &tweet)
 	if err != nil {
 		return err
 	}
@@ -137,9 +156,9 @@ func (s *TweetService) AddLike(ctx context.Context, tweetID int32, userID int32)
 }
 
 func (s *TweetService) RemoveLike(ctx context.Context, tweetID int32, userID int32) error {
-	query := `SELECT * FROM tweets WHERE id = $1 RETURNING *`
+	query := "SELECT " + tweetColumns + " FROM tweets WHERE id = $1"
 	var tweet models.Tweet
-	err := s.db.QueryRowContext(ctx, query, tweetID).Scan(&tweet.ID, &tweet.Content, &tweet.UserID, &tweet.Username, &tweet.CreatedAt, &tweet.Media, &tweet.Likes, &tweet.Comments, &tweet.Shares, &tweet.Saves)
+	err := scanTweet(s.db.QueryRowContext(ctx, query, tweetID), &tweet)
 	if err != nil {
 		return err
 	}
@@ -154,9 +173,9 @@ func (s *TweetService) RemoveLike(ctx context.Context, tweetID int32, userID int
 }
 
 func (s *TweetService) AddComment(ctx context.Context, tweetID int32, userID int32, commentID int32) error {
-	query := `SELECT * FROM tweets WHERE id = $1 RETURNING *`
+	query := "SELECT " + tweetColumns + " FROM tweets WHERE id = $1"
 	var tweet models.Tweet
-	err := s.db.QueryRowContext(ctx, query, tweetID).Scan(&tweet.ID, &tweet.Content, &tweet.UserID, &tweet.Username, &tweet.CreatedAt, &tweet.Media, &tweet.Likes, &tweet.Comments, &tweet.Shares, &tweet.Saves)
+	err := scanTweet(s.db.QueryRowContext(ctx, query, tweetID), &tweet)
 	if err != nil {
 		return err
 	}
@@ -171,9 +190,9 @@ func (s *TweetService) AddComment(ctx context.Context, tweetID int32, userID int
 }
 
 func (s *TweetService) RemoveComment(ctx context.Context, tweetID int32, userID int32, commentID int32) error {
-	query := `SELECT * FROM tweets WHERE id = $1 RETURNING *`
+	query := "SELECT " + tweetColumns + " FROM tweets WHERE id = $1"
 	var tweet models.Tweet
-	err := s.db.QueryRowContext(ctx, query, tweetID).Scan(&tweet.ID, &tweet.Content, &tweet.UserID, &tweet.Username, &tweet.CreatedAt, &tweet.Media, &tweet.Likes, &tweet.Comments, &tweet.Shares, &tweet.Saves)
+	err := scanTweet(s.db.QueryRowContext(ctx, query, tweetID), &tweet)
 	if err != nil {
 		return err
 	}
@@ -188,9 +207,9 @@ func (s *TweetService) RemoveComment(ctx context.Context, tweetID int32, userID
 }
 
 func (s *TweetService) AddShare(ctx context.Context, tweetID int32, userID int32) error {
-	query := `SELECT * FROM tweets WHERE id = $1 RETURNING *`
+	query := "SELECT " + tweetColumns + " FROM tweets WHERE id = $1"
 	var tweet models.Tweet
-	err := s.db.QueryRowContext(ctx, query, tweetID).Scan(&tweet.ID, &tweet.Content, &tweet.UserID, &tweet.Username, &tweet.CreatedAt, &tweet.Media, &tweet.Likes, &tweet.Comments, &tweet.Shares, &tweet.Saves)
+	err := scanTweet(s.db.QueryRowContext(ctx, query, tweetID), &tweet)
 	if err != nil {
 		return err
 	}
@@ -205,9 +224,9 @@ func (s *TweetService) AddShare(ctx context.Context, tweetID int32, userID int32
 }
 
 func (s *TweetService) SaveTweet(ctx context.Context, tweetID int32, userID int32) error {
-	query := `SELECT * FROM tweets WHERE id = $1 RETURNING *`
+	query := "SELECT " + tweetColumns + " FROM tweets WHERE id = $1"
 	var tweet models.Tweet
-	err := s.db.QueryRowContext(ctx, query, tweetID).Scan(&tweet.ID, &tweet.Content, &tweet.UserID, &tweet.Username, &tweet.CreatedAt, &tweet.Media, &tweet.Likes, &tweet.Comments, &tweet.Shares, &tweet.Saves)
+	err := scanTweet(s.db.QueryRowContext(ctx, query, tweetID), &tweet)
 	if err != nil {
 		return err
 	}
@@ -222,9 +241,9 @@ func (s *TweetService) SaveTweet(ctx context.Context, tweetID int32, userID int3
 }
 
 func (s *TweetService) RemoveSave(ctx context.Context, tweetID int32, userID int32) error {
-	query := `SELECT * FROM tweets WHERE id = $1 RETURNING *`
+	query := "SELECT " + tweetColumns + " FROM tweets WHERE id = $1"
 	var tweet models.Tweet
-	err := s.db.QueryRowContext(ctx, query, tweetID).Scan(&tweet.ID, &tweet.Content, &tweet.UserID, &tweet.Username, &tweet.CreatedAt, &tweet.Media, &tweet.Likes, &tweet.Comments, &tweet.Shares, &tweet.Saves)
+	err := scanTweet(s.db.QueryRowContext(ctx, query, tweetID), &tweet)
 	if err != nil {
 		return err
 	}
@@ -238,95 +257,224 @@ func (s *TweetService) RemoveSave(ctx context.Context, tweetID int32, userID int
 	return nil
 }
 
-func (s *TweetService) GetSavedTweets(ctx context.Context, userID int32) ([]*models.Tweet, error) {
-	query := `SELECT * FROM tweets WHERE id IN (SELECT unnest(saves) FROM tweets WHERE user_id = $1)`
-	rows, err := s.db.QueryContext(ctx, query, userID)
+func (s *TweetService) GetSavedTweets(ctx context.Context, userID int32, page pagination.Page) ([]*models.Tweet, string, error) {
+	query := `
+		SELECT ` + tweetColumns + ` FROM tweets WHERE id IN (SELECT unnest(saves) FROM tweets WHERE user_id = $1)
+		AND (created_at, id) < ($2, $3)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $4
+	`
+	rows, err := s.db.QueryContext(ctx, query, userID, page.After.CreatedAt, page.After.ID, page.Limit())
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	defer rows.Close()
 
 	tweets := []*models.Tweet{}
 	for rows.Next() {
 		var tweet models.Tweet
-		err := rows.Scan(
-			&tweet.ID,
-			&tweet.Content,
-			&tweet.UserID,
-			&tweet.Username,
-			&tweet.CreatedAt,
-			&tweet.Media,
-			&tweet.Likes,
-			&tweet.Comments,
-			&tweet.Shares,
-			&tweet.Saves,
-		)
+		err := scanTweet(rows, &tweet)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
+		tweet.MediaCount = int64(len(tweet.Media) + len(tweet.MediaIDs))
 		tweet.LikeCount = int64(len(tweet.Likes))
 		tweet.CommentCount = int64(len(tweet.Comments))
 		tweet.ShareCount = int64(len(tweet.Shares))
 		tweet.SaveCount = int64(len(tweet.Saves))
 		tweets = append(tweets, &tweet)
 	}
-	return tweets, nil
+	tweets, next := pagination.Trim(tweets, page, tweetCursor)
+	return tweets, next, nil
 }
 
-func (s *TweetService) GetTweetByID(ctx context.Context, tweetID int32, userIDToGet int32) (*models.Tweet, error) {
-	query := `SELECT * FROM tweets WHERE id = $1`
-	users := methods.ConnectUser()
+func (s *TweetService) GetTweetByID(ctx context.Context, tweetID int32, viewerID int32) (*models.Tweet, error) {
+	query := "SELECT " + tweetColumns + " FROM tweets WHERE id = $1"
 	var tweet models.Tweet
-	err := s.db.QueryRowContext(ctx, query, tweetID).Scan(&tweet.ID, &tweet.Content, &tweet.UserID, &tweet.Username, &tweet.CreatedAt, &tweet.Media, &tweet.Likes, &tweet.Comments, &tweet.Shares, &tweet.Saves)
+	err := scanTweet(s.db.QueryRowContext(ctx, query, tweetID), &tweet)
+	if err == sql.ErrNoRows {
+		return nil, ErrTweetNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
-	user, err := users.GetUser(ctx, &proto.GetUserRequest{UserId: int32(tweet.UserID)})
+	owner, err := getOwner(ctx, int32(tweet.UserID), viewerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkViewer(owner, viewerID); err != nil {
+		return nil, err
+	}
+	return &tweet, nil
+}
+
+// MaxTweetsPerBatch GetTweetsByIDs bir so'rovda qabul qiladigan ID lar soni
+const MaxTweetsPerBatch = 100
+
+// GetTweetsByIDs ids tartibida qaytaradi. Topilmagan tweetlar va viewerID
+// ko'ra olmaydigan (bloklagan yoki yopiq akkaunt) egalarning tweetlari tashlab
+// ketiladi. Egalar bitta GetUsersByIds bilan olinadi.
+func (s *TweetService) GetTweetsByIDs(ctx context.Context, ids []int32, viewerID int32) ([]*models.Tweet, error) {
+	if len(ids) > MaxTweetsPerBatch {
+		return nil, ErrTooManyTweetIDs
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	rows, err := s.db.QueryContext(ctx, "SELECT "+tweetColumns+" FROM tweets WHERE id = ANY($1)", pq.Array(ids))
 	if err != nil {
 		return nil, err
 	}
-	if user.User.IsPrivate {
-		if !utils.Contains(user.User.Followers, userIDToGet) {
-			return nil, errors.New("user is private and you are blocked")
+	defer rows.Close()
+
+	byID := make(map[int32]*models.Tweet)
+	var owners []int32
+	for rows.Next() {
+		var tweet models.Tweet
+		err := scanTweet(rows, &tweet)
+		if err != nil {
+			return nil, err
+		}
+		byID[int32(tweet.ID)] = &tweet
+		if !utils.Contains(owners, int32(tweet.UserID)) {
+			owners = append(owners, int32(tweet.UserID))
 		}
 	}
-	if !utils.Contains(user.User.BlockedUsers, userIDToGet) {
-		return nil, errors.New("user is block you")
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
-	return &tweet, nil
+	if len(owners) == 0 {
+		return nil, nil
+	}
+
+	// viewerID ni bloklagan egalarni user-service o'zi qaytarmaydi
+	resp, err := methods.ConnectUser().GetUsersByIds(ctx, &proto.GetUsersByIdsRequest{UserIds: owners, ViewerId: viewerID})
+	if err != nil {
+		return nil, err
+	}
+	visible := make(map[int32]bool, len(resp.Users))
+	for _, owner := range resp.Users {
+		visible[owner.Id] = checkViewer(owner, viewerID) == nil
+	}
+
+	tweets := make([]*models.Tweet, 0, len(byID))
+	for _, id := range ids {
+		tweet, ok := byID[id]
+		if !ok || !visible[int32(tweet.UserID)] {
+			continue
+		}
+		tweets = append(tweets, tweet)
+		// takrorlangan ID bir marta qaytariladi
+		delete(byID, id)
+	}
+	return tweets, nil
 }
 
-func (s *TweetService) GetLikedTweets(ctx context.Context, userID int32) ([]*models.Tweet, error) {
-	query := `SELECT * FROM tweets WHERE id IN (SELECT unnest(likes) FROM tweets WHERE user_id = $1)`
-	rows, err := s.db.QueryContext(ctx, query, userID)
+// getOwner tweet egasini viewerID nomidan oladi. user-service GetUser javobida
+// blocked_users bo'lmaydi: viewerID ni bloklagan foydalanuvchini u topilmagan deb
+// qaytaradi, shuning uchun blok shu yerda ErrBlockedByUser ga aylanadi.
+func getOwner(ctx context.Context, ownerID, viewerID int32) (*proto.User, error) {
+	users := methods.ConnectUser()
+	resp, err := users.GetUser(ctx, &proto.GetUserRequest{UserId: ownerID, ViewerId: viewerID})
+	if status.Code(err) == codes.NotFound && viewerID != 0 {
+		// ega mavjud bo'lsa, u viewerID ni bloklagan
+		if _, lookupErr := users.GetUser(ctx, &proto.GetUserRequest{UserId: ownerID}); lookupErr == nil {
+			return nil, ErrBlockedByUser
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
+	return resp.User, nil
+}
+
+// checkViewer viewerID owner ning tweetlarini ko'ra olishini tekshiradi: owner
+// uni bloklamagan va akkaunt yopiq bo'lsa unga obuna bo'lgan bo'lishi kerak.
+// viewerID 0 ichki so'rovlar uchun, ular tekshirilmaydi.
+func checkViewer(owner *proto.User, viewerID int32) error {
+	if viewerID == 0 || viewerID == owner.Id {
+		return nil
+	}
+	if utils.Contains(owner.BlockedUsers, viewerID) {
+		return ErrBlockedByUser
+	}
+	if owner.IsPrivate && !utils.Contains(owner.Followers, viewerID) {
+		return ErrPrivateAccount
+	}
+	return nil
+}
+
+func (s *TweetService) GetLikedTweets(ctx context.Context, userID int32, page pagination.Page) ([]*models.Tweet, string, error) {
+	query := `
+		SELECT ` + tweetColumns + ` FROM tweets WHERE id IN (SELECT unnest(likes) FROM tweets WHERE user_id = $1)
+		AND (created_at, id) < ($2, $3)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $4
+	`
+	rows, err := s.db.QueryContext(ctx, query, userID, page.After.CreatedAt, page.After.ID, page.Limit())
+	if err != nil {
+		return nil, "", err
+	}
 	defer rows.Close()
 
 	tweets := []*models.Tweet{}
 	for rows.Next() {
 		var tweet models.Tweet
-		err := rows.Scan(
-			&tweet.ID,
-			&tweet.Content,
-			&tweet.UserID,
-			&tweet.Username,
-			&tweet.CreatedAt,
-			&tweet.Media,
-			&tweet.Likes,
-			&tweet.Comments,
-			&tweet.Shares,
-			&tweet.Saves,
-		)
+		err := scanTweet(rows, &tweet)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
+		tweet.MediaCount = int64(len(tweet.Media) + len(tweet.MediaIDs))
 		tweet.LikeCount = int64(len(tweet.Likes))
 		tweet.CommentCount = int64(len(tweet.Comments))
 		tweet.ShareCount = int64(len(tweet.Shares))
 		tweet.SaveCount = int64(len(tweet.Saves))
 		tweets = append(tweets, &tweet)
 	}
-	return tweets, nil
+	tweets, next := pagination.Trim(tweets, page, tweetCursor)
+	return tweets, next, nil
+}
+
+// checkMedia media muallifga tegishli va biriktirilgan ekanini tekshiradi.
+// Gateway media ni tweet uchun biriktiradi; tekshiruv servis to'g'ridan-to'g'ri
+// chaqirilganda begona media ishlatilmasligi uchun.
+func checkMedia(ctx context.Context, userID int64, ids []string) error {
+	if len(ids) > MaxTweetMedia {
+		return ErrTooManyMedia
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	resp, err := methods.ConnectUser().GetMediaByIds(ctx, &proto.GetMediaByIdsRequest{Ids: ids})
+	if err != nil {
+		return err
+	}
+	// takrorlangan ID bir marta qaytadi
+	if len(resp.Media) != len(ids) {
+		return ErrMediaNotFound
+	}
+	for _, m := range resp.Media {
+		if int64(m.OwnerId) != userID || !m.Attached {
+			return ErrMediaNotFound
+		}
+	}
+	return nil
+}
+
+// releaseMedia o'chirilgan tweetning media larini ajratadi: gateway ularni
+// biriktirilmagan media kabi o'chiradi va egasining kvotasi bo'shaydi. Tweet
+// allaqachon o'chirilgan, shuning uchun xato faqat yoziladi.
+func releaseMedia(ctx context.Context, userID int64, ids []string) {
+	if len(ids) == 0 {
+		return
+	}
+	_, err := methods.ConnectUser().DetachMedia(ctx, &proto.DetachMediaRequest{UserId: int32(userID), Ids: ids})
+	if err != nil {
+		slog.WarnContext(ctx, "failed to release tweet media", "user_id", userID, "error", err)
+	}
+}
+
+// tweetCursor ro'yxat sahifasining (created_at, id) kaliti
+func tweetCursor(tweet *models.Tweet) pagination.Cursor {
+	return pagination.Cursor{CreatedAt: tweet.CreatedAt, ID: tweet.ID}
 }
@@ -0,0 +1 @@
+ALTER TABLE tweets DROP COLUMN IF EXISTS media_ids;
@@ -2,77 +2,151 @@ package main
 
 import (
 	"context"
-	"database/sql"
-	"user-service/config"
-	"user-service/internal/handlers"
-	"user-service/internal/service"
-	pb "user-service/pkg/proto"
 	"fmt"
-	"log"
+	"log/slog"
 	"net"
 	"os"
 	"os/signal"
+	"pkg/grpcerr"
+	"pkg/healthcheck"
+	"pkg/logger"
+	"pkg/metrics"
+	"pkg/mtls"
+	"pkg/requestid"
+	"pkg/tracing"
 	"syscall"
 	"time"
+	"user-service/config"
+	"user-service/internal/handlers"
+	"user-service/internal/kafka"
+	"user-service/internal/keys"
+	"user-service/internal/service"
+	pb "user-service/pkg/proto"
+	"user-service/utils"
 
 	"user-service/internal/redis"
 
+	"github.com/XSAM/otelsql"
 	_ "github.com/lib/pq"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
 func main() {
 	conf, err := config.LoadConfig()
 	if err != nil {
-		log.Fatal(err)
+		logger.Fatal("failed to load config", "error", err)
+	}
+	logger.Init(conf.SERVER_NAME, conf.LOG_LEVEL)
+
+	shutdownTracing, err := tracing.Init(context.Background(), conf.Tracing())
+	if err != nil {
+		logger.Fatal("failed to set up tracing", "error", err)
 	}
 
 	postgresUrl := fmt.Sprintf("postgresql://%s:%s@%s:%s/%s?sslmode=disable", conf.DB_USER, conf.DB_PASSWORD, conf.DB_HOST, conf.DB_PORT, conf.DB_NAME)
-	db, err := sql.Open("postgres", postgresUrl)
+	db, err := otelsql.Open("postgres", postgresUrl, otelsql.WithAttributes(semconv.DBSystemPostgreSQL))
 	if err != nil {
-		log.Fatalf("Ma'lumotlar bazasiga ulanishda xato: %v", err)
+		logger.Fatal("failed to connect to database", "error", err)
 	}
 	defer db.Close()
+	metrics.RegisterDB(db, conf.DB_NAME)
 
 	redisClient := redis.NewRedisClient()
 
-	userService := service.NewUserService(db, redisClient)
-	userHandler := handlers.NewUserHandler(*userService)
+	signingKeys, err := keys.NewStore(conf.JWT_KEYS_DIR, conf.JWT_ACTIVE_KID)
+	if err != nil {
+		logger.Fatal("failed to load JWT keys", "error", err)
+	}
+	stopKeys := make(chan struct{})
+	go signingKeys.Watch(time.Minute, stopKeys)
+
+	totpBox, err := utils.NewSecretBox(conf.TOTP_ENCRYPTION_KEY)
+	if err != nil {
+		logger.Fatal("failed to load TOTP encryption key", "error", err)
+	}
 
-	grpcServer := grpc.NewServer()
+	userService := service.NewUserService(db, redisClient, totpBox)
+	encrypted, err := userService.EncryptTOTPSecrets(context.Background())
+	if err != nil {
+		logger.Fatal("failed to encrypt TOTP secrets", "error", err)
+	}
+	if encrypted > 0 {
+		slog.Info("encrypted plaintext TOTP secrets", "count", encrypted)
+	}
+	userHandler := handlers.NewUserHandler(*userService, signingKeys)
+
+	creds, err := mtls.ServerCredentials(conf.MTLS())
+	if err != nil {
+		logger.Fatal("failed to load TLS credentials", "error", err)
+	}
+	grpcServer := grpc.NewServer(
+		grpc.Creds(creds),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(requestid.UnaryServerInterceptor(), logger.UnaryServerInterceptor(), metrics.UnaryServerInterceptor(), grpcerr.UnaryServerInterceptor(conf.SERVER_NAME)),
+	)
 	pb.RegisterUserServiceServer(grpcServer, userHandler)
+
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	checker := healthcheck.New(healthServer, pb.UserService_ServiceDesc.ServiceName)
+	checker.Add("postgres", db.PingContext)
+	checker.Add("redis", func(ctx context.Context) error {
+		return redisClient.Ping(ctx).Err()
+	})
+	checker.Add("kafka", func(ctx context.Context) error {
+		return kafka.Ping(ctx, conf.KAFKA_BROKERS)
+	})
+	stopHealth := make(chan struct{})
+	go checker.Run(10*time.Second, stopHealth)
+
 	reflection.Register(grpcServer)
 	lis, err := net.Listen("tcp", ":"+conf.SERVER_PORT)
 	if err != nil {
-		log.Fatalf("Portni tinglashda xato: %v", err)
+		logger.Fatal("failed to listen", "error", err)
 	}
 
 	go func() {
-		log.Println("gRPC server :" + conf.SERVER_PORT + " portida ishga tushdi")
+		slog.Info("grpc server started", "port", conf.SERVER_PORT)
 		if err := grpcServer.Serve(lis); err != nil {
-			log.Fatalf("gRPC serverni ishga tushirishda xato: %v", err)
+			logger.Fatal("failed to serve gRPC", "error", err)
 		}
 	}()
 
+	metricsServer := metrics.Serve(conf.METRICS_PORT)
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	log.Println("Serverni to'xtatish boshlanmoqda...")
+	slog.Info("shutting down")
 
+	checker.Shutdown()
+	close(stopHealth)
 	grpcServer.GracefulStop()
+	close(stopKeys)
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
 
+	if err := metricsServer.Shutdown(ctx); err != nil {
+		slog.Error("failed to stop metrics server", "error", err)
+	}
+
 	if err := db.Close(); err != nil {
-		log.Printf("Ma'lumotlar bazasi ulanishini yopishda xato: %v", err)
+		slog.Error("failed to close database", "error", err)
 	}
 
 	if err := redisClient.Close(); err != nil {
-		log.Printf("Redis ulanishini yopishda xato: %v", err)
+		slog.Error("failed to close redis", "error", err)
+	}
+	if err := shutdownTracing(ctx); err != nil {
+		slog.Error("failed to flush traces", "error", err)
 	}
 	<-ctx.Done()
 
-	log.Println("Server to'xtatildi")
+	slog.Info("server stopped")
 }
@@ -3,7 +3,10 @@ package handlers
 import (
 	"context"
 	"errors"
+	"pkg/grpcerr"
+	"pkg/principal"
 	"time"
+	"user-service/internal/keys"
 	"user-service/internal/models"
 	"user-service/internal/service"
 	pb "user-service/pkg/proto"
@@ -15,10 +18,11 @@ import (
 type UserHandler struct {
 	pb.UnimplementedUserServiceServer
 	service service.UserService
+	keys    *keys.Store
 }
 
-func NewUserHandler(service service.UserService) *UserHandler {
-	return &UserHandler{service: service}
+func NewUserHandler(service service.UserService, keys *keys.Store) *UserHandler {
+	return &UserHandler{service: service, keys: keys}
 }
 
 func (h *UserHandler) RegisterUser(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
@@ -27,7 +31,7 @@ func (h *UserHandler) RegisterUser(ctx context.Context, req *pb.RegisterRequest)
 		return nil, err
 	}
 	if req.Email == "" && req.Phone == "" {
-		return nil, errors.New("email or phone is required")
+		return nil, service.ErrContactMissing
 	}
 	
 	
@@ -65,18 +69,70 @@ func (h *UserHandler) RegisterUser(ctx context.Context, req *pb.RegisterRequest)
 	}, nil
 }
 
-func (h *UserHandler) LoginUser(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+func (h *UserHandler) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	// foydalanuvchi yo'qligi va noto'g'ri parol bir xil javob qaytaradi
 	user, err := h.service.LoginUser(ctx, req.LoginIdentifier)
+	if errors.Is(err, service.ErrUserNotFound) {
+		return nil, service.ErrInvalidCredentials
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	err = utils.VerifyPassword(user.Password, req.Password)
+	if err != nil {
+		return nil, service.ErrInvalidCredentials
+	}
+
+	// 2FA yoqilgan bo'lsa token o'rniga challenge qaytariladi
+	enabled, err := h.service.TOTPEnabled(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if enabled {
+		challenge, err := h.service.CreateLoginChallenge(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.LoginResponse{
+			Success:           true,
+			Message:           "Two-factor authentication required",
+			TwoFactorRequired: true,
+			ChallengeToken:    challenge,
+			ExpiresIn:         int64(service.LoginChallengeTTL.Seconds()),
+		}, nil
+	}
+
+	return h.issueTokens(ctx, user)
+}
+
+func (h *UserHandler) VerifyLoginChallenge(ctx context.Context, req *pb.VerifyLoginChallengeRequest) (*pb.LoginResponse, error) {
+	userID, err := h.service.CompleteLoginChallenge(ctx, req.ChallengeToken, req.Code)
 	if err != nil {
 		return nil, err
 	}
 
-	token, err := utils.GenerateJWT(int(user.ID), user.Username)
+	user, err := h.service.GetUser(ctx, userID, 0)
+	if err != nil {
+		return nil, err
+	}
+	return h.issueTokens(ctx, user)
+}
+
+// issueTokens sessiya ochadi va access/refresh tokenlarni qaytaradi
+func (h *UserHandler) issueTokens(ctx context.Context, user models.User) (*pb.LoginResponse, error) {
+	session, err := h.service.CreateSession(ctx, user.ID, user.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := h.service.GetRole(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	kid, key := h.keys.Active()
+	token, err := utils.GenerateJWT(kid, key, int(user.ID), user.Username, role, session.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -98,39 +154,62 @@ func (h *UserHandler) LoginUser(ctx context.Context, req *pb.LoginRequest) (*pb.
 			Followers:      user.Followers,
 			Following:      user.Following,
 			IsPrivate:      user.IsPrivate,
+			Role:           role,
 		},
-		Token: token,
+		Token:        token,
+		RefreshToken: session.RefreshToken,
+		ExpiresIn:    int64(utils.AccessTokenTTL.Seconds()),
 	}, nil
 }
 
 func (h *UserHandler) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
-	user, err := h.service.GetUser(ctx, int64(req.UserId), int64(req.UserIdToGet))
+	user, err := h.service.GetUser(ctx, int64(req.UserId), int64(req.ViewerId))
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.GetUserResponse{
 		Message: "User fetched successfully",
-		User: &pb.User{
-			Id:             int32(user.ID),
-			Username:       user.Username,
-			Email:          user.Email,
-			Name:           user.Name,
-			Phone:          user.Phone,
-			CreatedAt:      timestamppb.New(user.CreatedAt),
-			Bio:            user.Bio,
-			TweetCount:     int32(user.TweetCount),
-			FollowerCount:  int32(user.FollowerCount),
-			FollowingCount: int32(user.FollowingCount),
-			Tweets:         user.Tweets,
-			Followers:      user.Followers,
-			Following:      user.Following,
-			IsPrivate:      user.IsPrivate,
-		},
+		User:    userToProto(user),
 	}, nil
 }
 
+func (h *UserHandler) GetUsersByIds(ctx context.Context, req *pb.GetUsersByIdsRequest) (*pb.GetUsersByIdsResponse, error) {
+	users, err := h.service.GetUsersByIDs(ctx, req.UserIds, int64(req.ViewerId))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GetUsersByIdsResponse{Success: true, Message: "Users fetched successfully"}
+	for _, user := range users {
+		resp.Users = append(resp.Users, userToProto(user))
+	}
+	return resp, nil
+}
+
+// userToProto GetUser javobidagi maydonlar; parol va bloklanganlar ro'yxati chiqmaydi
+func userToProto(user models.User) *pb.User {
+	return &pb.User{
+		Id:             int32(user.ID),
+		Username:       user.Username,
+		Email:          user.Email,
+		Name:           user.Name,
+		Phone:          user.Phone,
+		CreatedAt:      timestamppb.New(user.CreatedAt),
+		Bio:            user.Bio,
+		TweetCount:     int32(user.TweetCount),
+		FollowerCount:  int32(user.FollowerCount),
+		FollowingCount: int32(user.FollowingCount),
+		Tweets:         user.Tweets,
+		Followers:      user.Followers,
+		Following:      user.Following,
+		IsPrivate:      user.IsPrivate,
+	}
+}
+
 func (h *UserHandler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
+	if err := checkCaller(ctx, int64(req.UserId)); err != nil {
+		return nil, err
+	}
 	user, err := h.service.UpdateUser(ctx, models.User{
 		ID:        int64(req.UserId),
 		Username:  req.Username,
@@ -163,6 +242,9 @@ func (h *UserHandler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 }
 
 func (h *UserHandler) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
+	if err := checkCaller(ctx, int64(req.UserId)); err != nil {
+		return nil, err
+	}
 	err := h.service.DeleteUser(ctx, int64(req.UserId))
 	if err != nil {
 		return nil, err
@@ -171,6 +253,9 @@ func (h *UserHandler) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest)
 }
 
 func (h *UserHandler) FollowUser(ctx context.Context, req *pb.AddFollowingRequest) (*pb.AddFollowingResponse, error) {
+	if err := checkCaller(ctx, int64(req.UserId)); err != nil {
+		return nil, err
+	}
 	user, err := h.service.GetUser(ctx, int64(req.FollowingId), int64(req.UserId))
 	if err != nil {
 		return nil, err
@@ -192,10 +277,16 @@ func (h *UserHandler) FollowUser(ctx context.Context, req *pb.AddFollowingReques
 }
 
 func (h *UserHandler) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.ChangePasswordResponse, error) {
+	if err := checkCaller(ctx, int64(req.UserId)); err != nil {
+		return nil, err
+	}
 	err := h.service.ChangePassword(ctx, int64(req.UserId), req.OldPassword, req.NewPassword)
 	if err != nil {
 		return nil, err
 	}
+	if _, err := h.service.RevokeAllSessions(ctx, int64(req.UserId)); err != nil {
+		return nil, err
+	}
 	return &pb.ChangePasswordResponse{Message: "Password changed successfully"}, nil
 }
 
@@ -204,10 +295,20 @@ func (h *UserHandler) ForgotPassword(ctx context.Context, req *pb.ForgotPassword
 	if err != nil {
 		return nil, err
 	}
+	user, err := h.service.LoginUser(ctx, req.LoginIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := h.service.RevokeAllSessions(ctx, user.ID); err != nil {
+		return nil, err
+	}
 	return &pb.ForgotPasswordResponse{Message: "Password reset successfully"}, nil
 }
 
 func (h *UserHandler) UnfollowUser(ctx context.Context, req *pb.RemoveFollowingRequest) (*pb.RemoveFollowingResponse, error) {
+	if err := checkCaller(ctx, int64(req.UserId)); err != nil {
+		return nil, err
+	}
 	err := h.service.RemoveFollowing(ctx, int64(req.UserId), int64(req.FollowingId))
 	if err != nil {
 		return nil, err
@@ -216,6 +317,9 @@ func (h *UserHandler) UnfollowUser(ctx context.Context, req *pb.RemoveFollowingR
 }
 
 func (h *UserHandler) AddFollower(ctx context.Context, req *pb.AddFollowerRequest) (*pb.AddFollowerResponse, error) {
+	if err := checkCaller(ctx, int64(req.UserId)); err != nil {
+		return nil, err
+	}
 	err := h.service.AddFollower(ctx, int64(req.UserId), int64(req.FollowerId))
 	if err != nil {
 		return nil, err
@@ -224,6 +328,9 @@ func (h *UserHandler) AddFollower(ctx context.Context, req *pb.AddFollowerReques
 }
 
 func (h *UserHandler) RemoveFollower(ctx context.Context, req *pb.RemoveFollowerRequest) (*pb.RemoveFollowerResponse, error) {
+	if err := checkCaller(ctx, int64(req.UserId)); err != nil {
+		return nil, err
+	}
 	err := h.service.RemoveFollower(ctx, int64(req.UserId), int64(req.FollowerId))
 	if err != nil {
 		return nil, err
@@ -232,6 +339,9 @@ func (h *UserHandler) RemoveFollower(ctx context.Context, req *pb.RemoveFollower
 }
 
 func (h *UserHandler) AddAvatar(ctx context.Context, req *pb.AddAvatarRequest) (*pb.AddAvatarResponse, error) {
+	if err := checkCaller(ctx, int64(req.UserId)); err != nil {
+		return nil, err
+	}
 	err := h.service.AddAvatar(ctx, int64(req.UserId), req.AvatarUrl)
 	if err != nil {
 		return nil, err
@@ -255,6 +365,9 @@ func (h *UserHandler) GetAvatar(ctx context.Context, req *pb.GetAvatarRequest) (
 }
 
 func (h *UserHandler) UpdateAvatar(ctx context.Context, req *pb.UpdateAvatarRequest) (*pb.UpdateAvatarResponse, error) {
+	if err := checkCaller(ctx, int64(req.UserId)); err != nil {
+		return nil, err
+	}
 	err := h.service.UpdateAvatar(ctx, int64(req.UserId), req.AvatarUrl)
 	if err != nil {
 		return nil, err
@@ -263,6 +376,9 @@ func (h *UserHandler) UpdateAvatar(ctx context.Context, req *pb.UpdateAvatarRequ
 }
 
 func (h *UserHandler) RemoveAvatar(ctx context.Context, req *pb.RemoveAvatarRequest) (*pb.RemoveAvatarResponse, error) {
+	if err := checkCaller(ctx, int64(req.UserId)); err != nil {
+		return nil, err
+	}
 	err := h.service.DeleteAvatar(ctx, int64(req.UserId))
 	if err != nil {
 		return nil, err
@@ -365,6 +481,9 @@ func (h *UserHandler) GetUserByPhone(ctx context.Context, req *pb.GetUserByPhone
 }
 
 func (h *UserHandler) AcceptFollowRequest(ctx context.Context, req *pb.AcceptFollowRequestRequest) (*pb.AcceptFollowRequestResponse, error) {
+	if err := checkCaller(ctx, int64(req.UserId)); err != nil {
+		return nil, err
+	}
 	err := h.service.AcceptFollowRequest(ctx, int64(req.UserId), int64(req.FollowerId))
 	if err != nil {
 		return nil, err
@@ -373,6 +492,9 @@ func (h *UserHandler) AcceptFollowRequest(ctx context.Context, req *pb.AcceptFol
 }
 
 func (h *UserHandler) RejectFollowRequest(ctx context.Context, req *pb.RejectFollowRequestRequest) (*pb.RejectFollowRequestResponse, error) {
+	if err := checkCaller(ctx, int64(req.UserId)); err != nil {
+		return nil, err
+	}
 	err := h.service.RejectFollowRequest(ctx, int64(req.UserId), int64(req.FollowerId))
 	if err != nil {
 		return nil, err
@@ -406,7 +528,7 @@ func (h *UserHandler) VerifyCode(ctx context.Context, req *pb.VerifyCodeRequest)
 				return nil, err
 			}
 		}
-		return nil, errors.New("code expired")
+		return nil, service.ErrCodeExpired
 	}
 
 	return &pb.VerifyCodeResponse{
@@ -414,3 +536,338 @@ func (h *UserHandler) VerifyCode(ctx context.Context, req *pb.VerifyCodeRequest)
 		Message: "Code verified successfully",
 	}, nil
 }
+
+func (h *UserHandler) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	if err := checkCaller(ctx, int64(req.UserId)); err != nil {
+		return nil, err
+	}
+	if req.SessionId == "" {
+		return nil, grpcerr.InvalidArgument("SESSION_ID_REQUIRED", "session_id", "session id is required")
+	}
+	err := h.service.RevokeSession(ctx, int64(req.UserId), req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LogoutResponse{Success: true, Message: "Logged out successfully"}, nil
+}
+
+func (h *UserHandler) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	session, err := h.service.RotateSession(ctx, req.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := h.service.GetRole(ctx, session.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	kid, key := h.keys.Active()
+	token, err := utils.GenerateJWT(kid, key, int(session.UserID), session.Username, role, session.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RefreshTokenResponse{
+		Success:      true,
+		Message:      "Token refreshed successfully",
+		Token:        token,
+		RefreshToken: session.RefreshToken,
+		ExpiresIn:    int64(utils.AccessTokenTTL.Seconds()),
+	}, nil
+}
+
+func (h *UserHandler) RevokeAllSessions(ctx context.Context, req *pb.RevokeAllSessionsRequest) (*pb.RevokeAllSessionsResponse, error) {
+	if err := checkCaller(ctx, int64(req.UserId)); err != nil {
+		return nil, err
+	}
+	revoked, err := h.service.RevokeAllSessions(ctx, int64(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RevokeAllSessionsResponse{
+		Success: true,
+		Message: "All sessions revoked successfully",
+		Revoked: int32(revoked),
+	}, nil
+}
+
+func (h *UserHandler) GetJWKS(ctx context.Context, req *pb.GetJWKSRequest) (*pb.GetJWKSResponse, error) {
+	jwks := h.keys.JWKS()
+	resp := &pb.GetJWKSResponse{Keys: make([]*pb.JWK, 0, len(jwks))}
+	for _, key := range jwks {
+		resp.Keys = append(resp.Keys, &pb.JWK{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   key.N,
+			E:   key.E,
+		})
+	}
+	return resp, nil
+}
+
+// requireRole gateway uzatgan rol ruxsat etilganlar orasida ekanini tekshiradi
+// checkCaller so'rovdagi user_id gateway uzatgan foydalanuvchi ekanini
+// tekshiradi. x-user-id bo'lmasa so'rov rad etiladi.
+func checkCaller(ctx context.Context, userID int64) error {
+	callerID, ok := principal.CallerID(ctx)
+	if !ok {
+		return service.ErrUnauthenticated
+	}
+	if callerID != userID {
+		return service.ErrNotCaller
+	}
+	return nil
+}
+
+func requireRole(ctx context.Context, roles ...string) error {
+	role := principal.CallerRole(ctx)
+	for _, r := range roles {
+		if role == r {
+			return nil
+		}
+	}
+	return grpcerr.ErrPermissionDenied
+}
+
+func (h *UserHandler) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	if err := requireRole(ctx, models.RoleModerator, models.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	users, err := h.service.ListUsers(ctx, req.Query, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListUsersResponse{
+		Success: true,
+		Message: "Users fetched successfully",
+		Users:   make([]*pb.User, 0, len(users)),
+	}
+	for _, user := range users {
+		resp.Users = append(resp.Users, &pb.User{
+			Id:        int32(user.ID),
+			Username:  user.Username,
+			Email:     user.Email,
+			Name:      user.Name,
+			Phone:     user.Phone,
+			CreatedAt: timestamppb.New(user.CreatedAt),
+			Bio:       user.Bio,
+			IsPrivate: user.IsPrivate,
+			Role:      user.Role,
+		})
+	}
+	return resp, nil
+}
+
+func (h *UserHandler) SetUserRole(ctx context.Context, req *pb.SetUserRoleRequest) (*pb.SetUserRoleResponse, error) {
+	if err := requireRole(ctx, models.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	err := h.service.SetRole(ctx, int64(req.UserId), req.Role)
+	if err != nil {
+		return nil, err
+	}
+	// yangi rol keyingi tokenlarda bo'lishi uchun sessiyalar bekor qilinadi
+	if _, err := h.service.RevokeAllSessions(ctx, int64(req.UserId)); err != nil {
+		return nil, err
+	}
+	return &pb.SetUserRoleResponse{Success: true, Message: "Role updated successfully"}, nil
+}
+
+func (h *UserHandler) ResetAccount(ctx context.Context, req *pb.ResetAccountRequest) (*pb.ResetAccountResponse, error) {
+	if err := requireRole(ctx, models.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	revoked, err := h.service.ResetAccount(ctx, int64(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ResetAccountResponse{
+		Success:         true,
+		Message:         "Account reset successfully",
+		RevokedSessions: int32(revoked),
+	}, nil
+}
+
+func (h *UserHandler) EnrollTOTP(ctx context.Context, req *pb.EnrollTOTPRequest) (*pb.EnrollTOTPResponse, error) {
+	if err := checkCaller(ctx, int64(req.UserId)); err != nil {
+		return nil, err
+	}
+	user, err := h.service.GetUser(ctx, int64(req.UserId), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment, err := h.service.EnrollTOTP(ctx, user.ID, user.Username)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EnrollTOTPResponse{
+		Success:         true,
+		Message:         "Scan the QR code and confirm with a code from the app",
+		Secret:          enrollment.Secret,
+		ProvisioningUri: enrollment.ProvisioningURI,
+		RecoveryCodes:   enrollment.RecoveryCodes,
+	}, nil
+}
+
+func (h *UserHandler) ConfirmTOTP(ctx context.Context, req *pb.ConfirmTOTPRequest) (*pb.ConfirmTOTPResponse, error) {
+	if err := checkCaller(ctx, int64(req.UserId)); err != nil {
+		return nil, err
+	}
+	err := h.service.ConfirmTOTP(ctx, int64(req.UserId), req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ConfirmTOTPResponse{Success: true, Message: "Two-factor authentication enabled"}, nil
+}
+
+func (h *UserHandler) DisableTOTP(ctx context.Context, req *pb.DisableTOTPRequest) (*pb.DisableTOTPResponse, error) {
+	if err := checkCaller(ctx, int64(req.UserId)); err != nil {
+		return nil, err
+	}
+	err := h.service.DisableTOTP(ctx, int64(req.UserId), req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DisableTOTPResponse{Success: true, Message: "Two-factor authentication disabled"}, nil
+}
+
+func mediaToProto(m models.Media) *pb.Media {
+	return &pb.Media{
+		Id:         m.ID,
+		OwnerId:    int32(m.OwnerID),
+		Url:        m.URL,
+		MimeType:   m.MimeType,
+		SizeBytes:  m.SizeBytes,
+		Width:      m.Width,
+		Height:     m.Height,
+		DurationMs: m.DurationMS,
+		Blurhash:   m.Blurhash,
+		AltText:    m.AltText,
+		Renditions: m.Renditions,
+		CreatedAt:  timestamppb.New(m.CreatedAt),
+		Attached:   m.AttachedAt != nil,
+	}
+}
+
+func mediaListToProto(media []models.Media) []*pb.Media {
+	result := make([]*pb.Media, len(media))
+	for i, m := range media {
+		result[i] = mediaToProto(m)
+	}
+	return result
+}
+
+func (h *UserHandler) CreateMedia(ctx context.Context, req *pb.CreateMediaRequest) (*pb.CreateMediaResponse, error) {
+	m := req.GetMedia()
+	if m.GetId() == "" || m.GetUrl() == "" {
+		return nil, grpcerr.InvalidArgument("MEDIA_REQUIRED", "media", "media id and url are required")
+	}
+	created, err := h.service.CreateMedia(ctx, models.Media{
+		ID:         m.Id,
+		OwnerID:    int64(m.OwnerId),
+		URL:        m.Url,
+		MimeType:   m.MimeType,
+		SizeBytes:  m.SizeBytes,
+		Width:      m.Width,
+		Height:     m.Height,
+		DurationMS: m.DurationMs,
+		Blurhash:   m.Blurhash,
+		AltText:    m.AltText,
+		Renditions: m.Renditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateMediaResponse{Success: true, Message: "Media created successfully", Media: mediaToProto(created)}, nil
+}
+
+func (h *UserHandler) GetMedia(ctx context.Context, req *pb.GetMediaRequest) (*pb.GetMediaResponse, error) {
+	m, err := h.service.GetMedia(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetMediaResponse{Success: true, Message: "Media retrieved successfully", Media: mediaToProto(m)}, nil
+}
+
+func (h *UserHandler) GetMediaByIds(ctx context.Context, req *pb.GetMediaByIdsRequest) (*pb.GetMediaByIdsResponse, error) {
+	media, err := h.service.GetMediaByIDs(ctx, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetMediaByIdsResponse{Success: true, Message: "Media retrieved successfully", Media: mediaListToProto(media)}, nil
+}
+
+func (h *UserHandler) UpdateMedia(ctx context.Context, req *pb.UpdateMediaRequest) (*pb.UpdateMediaResponse, error) {
+	if err := checkCaller(ctx, int64(req.UserId)); err != nil {
+		return nil, err
+	}
+	m, err := h.service.UpdateAltText(ctx, int64(req.UserId), req.Id, req.AltText)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateMediaResponse{Success: true, Message: "Media updated successfully", Media: mediaToProto(m)}, nil
+}
+
+func (h *UserHandler) AttachMedia(ctx context.Context, req *pb.AttachMediaRequest) (*pb.AttachMediaResponse, error) {
+	media, err := h.service.AttachMedia(ctx, int64(req.UserId), req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AttachMediaResponse{Success: true, Message: "Media attached successfully", Media: mediaListToProto(media)}, nil
+}
+
+func (h *UserHandler) DetachMedia(ctx context.Context, req *pb.DetachMediaRequest) (*pb.DetachMediaResponse, error) {
+	if err := h.service.DetachMedia(ctx, int64(req.UserId), req.Ids); err != nil {
+		return nil, err
+	}
+	return &pb.DetachMediaResponse{Success: true, Message: "Media detached successfully"}, nil
+}
+
+func (h *UserHandler) ListUnattachedMedia(ctx context.Context, req *pb.ListUnattachedMediaRequest) (*pb.ListUnattachedMediaResponse, error) {
+	media, err := h.service.ListUnattachedMedia(ctx, req.CreatedBefore.AsTime(), req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListUnattachedMediaResponse{Success: true, Message: "Media retrieved successfully", Media: mediaListToProto(media)}, nil
+}
+
+func (h *UserHandler) DeleteMedia(ctx context.Context, req *pb.DeleteMediaRequest) (*pb.DeleteMediaResponse, error) {
+	deleted, err := h.service.DeleteMedia(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteMediaResponse{Success: true, Message: "Media deleted successfully", Deleted: deleted}, nil
+}
+
+func (h *UserHandler) GetMediaQuota(ctx context.Context, req *pb.GetMediaQuotaRequest) (*pb.GetMediaQuotaResponse, error) {
+	quota, err := h.service.GetMediaQuota(ctx, int64(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetMediaQuotaResponse{
+		Success:        true,
+		Message:        "Media quota retrieved successfully",
+		UsedBytes:      quota.UsedBytes,
+		QuotaBytes:     quota.QuotaBytes,
+		RemainingBytes: max(quota.QuotaBytes-quota.UsedBytes, 0),
+		MediaCount:     quota.MediaCount,
+	}, nil
+}
+
+func (h *UserHandler) SetMediaQuota(ctx context.Context, req *pb.SetMediaQuotaRequest) (*pb.SetMediaQuotaResponse, error) {
+	if err := requireRole(ctx, models.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if err := h.service.SetMediaQuota(ctx, int64(req.UserId), req.QuotaBytes); err != nil {
+		return nil, err
+	}
+	return &pb.SetMediaQuotaResponse{Success: true, Message: "Media quota updated successfully"}, nil
+}
@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"pkg/grpcerr"
+	"regexp"
+	"testing"
+	"time"
+	"user-service/internal/service"
+	pb "user-service/pkg/proto"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"google.golang.org/grpc/metadata"
+)
+
+func newTestHandler(t *testing.T) (*UserHandler, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return NewUserHandler(*service.NewUserService(db, nil, nil), nil), mock
+}
+
+// expectUser GetUser dagi SELECT * FROM users uchun users jadvali tartibidagi
+// qator; massivlar lib/pq qaytaradigan ko'rinishda
+func expectUser(mock sqlmock.Sqlmock, id int64, blocked string) {
+	columns := []string{"id", "username", "email", "name", "phone", "password", "created_at", "bio", "is_private",
+		"followers", "following", "tweets", "blocked_users"}
+	rows := sqlmock.NewRows(columns).AddRow(id, "alice", "alice@example.com", "Alice", "", "hash", time.Now(), "", false,
+		[]byte("{2}"), []byte("{}"), []byte("{}"), []byte(blocked))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM users")).WithArgs(id).WillReturnRows(rows)
+}
+
+func TestGetUserChecksViewer(t *testing.T) {
+	tests := []struct {
+		name    string
+		viewer  int32
+		blocked string
+		want    error
+	}{
+		{"viewer is not blocked", 2, "{3}", nil},
+		{"nobody is blocked", 2, "{}", nil},
+		{"internal lookup", 0, "{2}", nil},
+		{"own profile", 1, "{1}", nil},
+		{"viewer is blocked", 2, "{3,2}", service.ErrUserNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, mock := newTestHandler(t)
+			expectUser(mock, 1, tt.blocked)
+
+			resp, err := h.GetUser(context.Background(), &pb.GetUserRequest{UserId: 1, ViewerId: tt.viewer})
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+			if tt.want == nil && (resp.User.Id != 1 || resp.User.Username != "alice" || resp.User.FollowerCount != 1) {
+				t.Errorf("unexpected user %+v", resp.User)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestAdminRPCsCheckRole(t *testing.T) {
+	tests := []struct {
+		name string
+		role string
+		call func(*UserHandler, context.Context) error
+		want error
+	}{
+		{"user lists users", "user", func(h *UserHandler, ctx context.Context) error {
+			_, err := h.ListUsers(ctx, &pb.ListUsersRequest{})
+			return err
+		}, grpcerr.ErrPermissionDenied},
+		{"moderator sets a role", "moderator", func(h *UserHandler, ctx context.Context) error {
+			_, err := h.SetUserRole(ctx, &pb.SetUserRoleRequest{UserId: 2, Role: "admin"})
+			return err
+		}, grpcerr.ErrPermissionDenied},
+		{"moderator resets an account", "moderator", func(h *UserHandler, ctx context.Context) error {
+			_, err := h.ResetAccount(ctx, &pb.ResetAccountRequest{UserId: 2})
+			return err
+		}, grpcerr.ErrPermissionDenied},
+		{"no role sets a media quota", "", func(h *UserHandler, ctx context.Context) error {
+			_, err := h.SetMediaQuota(ctx, &pb.SetMediaQuotaRequest{UserId: 2, QuotaBytes: 1})
+			return err
+		}, grpcerr.ErrPermissionDenied},
+		// rol tekshiruvidan o'tgan so'rov validatsiyaga yetib boradi
+		{"admin sets an unknown role", "admin", func(h *UserHandler, ctx context.Context) error {
+			_, err := h.SetUserRole(ctx, &pb.SetUserRoleRequest{UserId: 2, Role: "owner"})
+			return err
+		}, service.ErrInvalidRole},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, mock := newTestHandler(t)
+			ctx := context.Background()
+			if tt.role != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-user-id", "1", "x-user-role", tt.role))
+			}
+			if err := tt.call(h, ctx); !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestSelfServiceRPCsCheckCaller(t *testing.T) {
+	calls := map[string]func(*UserHandler, context.Context) error{
+		"UpdateUser": func(h *UserHandler, ctx context.Context) error {
+			_, err := h.UpdateUser(ctx, &pb.UpdateUserRequest{UserId: 2, Name: "Mallory"})
+			return err
+		},
+		"DeleteUser": func(h *UserHandler, ctx context.Context) error {
+			_, err := h.DeleteUser(ctx, &pb.DeleteUserRequest{UserId: 2})
+			return err
+		},
+		"ChangePassword": func(h *UserHandler, ctx context.Context) error {
+			_, err := h.ChangePassword(ctx, &pb.ChangePasswordRequest{UserId: 2, OldPassword: "a", NewPassword: "b"})
+			return err
+		},
+		"Logout": func(h *UserHandler, ctx context.Context) error {
+			_, err := h.Logout(ctx, &pb.LogoutRequest{UserId: 2, SessionId: "s"})
+			return err
+		},
+		"RevokeAllSessions": func(h *UserHandler, ctx context.Context) error {
+			_, err := h.RevokeAllSessions(ctx, &pb.RevokeAllSessionsRequest{UserId: 2})
+			return err
+		},
+		"DisableTOTP": func(h *UserHandler, ctx context.Context) error {
+			_, err := h.DisableTOTP(ctx, &pb.DisableTOTPRequest{UserId: 2, Code: "123456"})
+			return err
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			h, mock := newTestHandler(t)
+			if err := call(h, context.Background()); !errors.Is(err, service.ErrUnauthenticated) {
+				t.Errorf("without a caller: err = %v, want %v", err, service.ErrUnauthenticated)
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "1"))
+			if err := call(h, ctx); !errors.Is(err, service.ErrNotCaller) {
+				t.Errorf("as another user: err = %v, want %v", err, service.ErrNotCaller)
+			}
+			// rad etilgan so'rov bazaga yetmaydi
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestGetUsersByIdsSkipsHiddenUsers(t *testing.T) {
+	h, mock := newTestHandler(t)
+	columns := []string{"id", "username", "email", "name", "phone", "password", "created_at", "bio", "is_private",
+		"followers", "following", "tweets", "blocked_users"}
+	rows := sqlmock.NewRows(columns)
+	for _, u := range []struct {
+		id      int64
+		blocked string
+	}{{3, "{}"}, {1, "{}"}, {4, "{2}"}} {
+		rows.AddRow(u.id, "alice", "alice@example.com", "Alice", "", "hash", time.Now(), "", false,
+			[]byte("{2}"), []byte("{}"), []byte("{}"), []byte(u.blocked))
+	}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM users WHERE id = ANY($1)")).WillReturnRows(rows)
+
+	// 4 so'rov qiluvchini bloklagan, 5 yo'q; javob so'rov tartibida, takrorlar bittada
+	resp, err := h.GetUsersByIds(context.Background(), &pb.GetUsersByIdsRequest{UserIds: []int32{1, 4, 5, 3, 1}, ViewerId: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []int32
+	for _, u := range resp.Users {
+		got = append(got, u.Id)
+	}
+	if len(got) != 2 || got[0] != 1 || got[1] != 3 {
+		t.Errorf("users = %v, want [1 3]", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+
+	_, err = h.GetUsersByIds(context.Background(), &pb.GetUsersByIdsRequest{UserIds: make([]int32, service.MaxUsersPerBatch+1)})
+	if !errors.Is(err, service.ErrTooManyUserIDs) {
+		t.Errorf("oversized batch: err = %v, want %v", err, service.ErrTooManyUserIDs)
+	}
+}
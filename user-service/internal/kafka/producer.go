@@ -4,6 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
+	"pkg/metrics"
+	"pkg/requestid"
+	"pkg/tracing"
 	"strconv"
 	"user-service/config"
 
@@ -29,7 +33,7 @@ func PublishNotification(ctx context.Context, topic string, notification Notific
 
 	defer func() {
 		if err := producer.Close(); err != nil {
-			fmt.Printf("error closing kafka writer: %v\n", err)
+			slog.ErrorContext(ctx, "failed to close kafka writer", "error", err)
 		}
 	}()
 
@@ -42,7 +46,14 @@ func PublishNotification(ctx context.Context, topic string, notification Notific
 		Key:   []byte(strconv.Itoa(int(notification.UserID))), 
 		Value: valueBytes,
 	}
-	if err := producer.WriteMessages(ctx, msg); err != nil {
+	if id := requestid.FromContext(ctx); id != "" {
+		msg.Headers = append(msg.Headers, kafka.Header{Key: requestid.Header, Value: []byte(id)})
+	}
+	ctx, span := tracing.StartProducerSpan(ctx, producer.Topic, &msg)
+	err = producer.WriteMessages(ctx, msg)
+	tracing.End(span, err)
+	metrics.KafkaProduced(producer.Topic, err)
+	if err != nil {
 		return fmt.Errorf("error writing message to kafka: %v", err)
 	}
 
@@ -0,0 +1,231 @@
+package keys
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Store JWT imzolash kalitlarini saqlaydi.
+//
+// Kalitlar JWT_KEYS_DIR papkasidan o'qiladi, fayl nomi (".pem" siz) kalitning kid i bo'ladi.
+// Faylda RSA private key bo'lsa u token imzolashi mumkin, faqat public key bo'lsa kalit
+// JWKS da e'lon qilinadi xolos (rotatsiyadan chiqqan eski kalit).
+//
+// Rotatsiya: yangi private key qo'shiladi va JWT_ACTIVE_KID ga yoziladi, eski kalit public key
+// ga almashtiriladi va u bilan imzolangan tokenlar muddati tugagach o'chiriladi.
+type Store struct {
+	dir       string
+	activeKid string
+
+	mu      sync.RWMutex
+	active  string
+	private map[string]*rsa.PrivateKey
+	public  map[string]*rsa.PublicKey
+}
+
+// JWK - JWKS hujjatidagi bitta RSA kalit
+type JWK struct {
+	Kty string
+	Kid string
+	Use string
+	Alg string
+	N   string
+	E   string
+}
+
+// NewStore kalitlarni dir papkasidan o'qiydi. Papka bo'lmasa xato qaytaradi,
+// kalit yaratish tartibi keys/README.md da.
+func NewStore(dir, activeKid string) (*Store, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("JWT keys directory %q is not available (set JWT_KEYS_DIR, see keys/README.md): %v", dir, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("JWT keys path %q is not a directory", dir)
+	}
+	s := &Store{dir: dir, activeKid: activeKid}
+	if err := s.Reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Reload kalitlarni papkadan qayta o'qiydi. Papkada kalit bo'lmasa vaqtinchalik kalit yaratiladi.
+func (s *Store) Reload() error {
+	private := make(map[string]*rsa.PrivateKey)
+	public := make(map[string]*rsa.PublicKey)
+
+	files, err := filepath.Glob(filepath.Join(s.dir, "*.pem"))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		kid := strings.TrimSuffix(filepath.Base(file), ".pem")
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("error reading key %s: %v", kid, err)
+		}
+		privateKey, publicKey, err := parseKey(data)
+		if err != nil {
+			return fmt.Errorf("error parsing key %s: %v", kid, err)
+		}
+		if privateKey != nil {
+			private[kid] = privateKey
+		}
+		public[kid] = publicKey
+	}
+
+	active := s.activeKid
+	if active == "" {
+		active = newestKid(private)
+	}
+
+	s.mu.RLock()
+	current := s.active
+	currentKey := s.private[current]
+	s.mu.RUnlock()
+
+	if len(private) == 0 {
+		if currentKey != nil {
+			// vaqtinchalik kalit qayta ishga tushguncha saqlanadi
+			private[current] = currentKey
+			public[current] = &currentKey.PublicKey
+			active = current
+		} else {
+			slog.Warn("no JWT keys found, generating a temporary key", "dir", s.dir)
+			key, err := rsa.GenerateKey(rand.Reader, 2048)
+			if err != nil {
+				return err
+			}
+			active = "dev-" + time.Now().UTC().Format("20060102150405")
+			private[active] = key
+			public[active] = &key.PublicKey
+		}
+	}
+	if _, ok := private[active]; !ok {
+		return fmt.Errorf("active signing key %q not found in %s", active, s.dir)
+	}
+
+	s.mu.Lock()
+	s.active = active
+	s.private = private
+	s.public = public
+	s.mu.Unlock()
+	return nil
+}
+
+// Watch kalitlar papkasini interval bo'yicha qayta o'qib turadi
+func (s *Store) Watch(interval time.Duration, done <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.Reload(); err != nil {
+				slog.Error("failed to reload JWT keys", "error", err)
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// Active token imzolash uchun joriy kalitni qaytaradi
+func (s *Store) Active() (string, *rsa.PrivateKey) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.active, s.private[s.active]
+}
+
+// JWKS e'lon qilinadigan barcha public kalitlarni qaytaradi
+func (s *Store) JWKS() []JWK {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	kids := make([]string, 0, len(s.public))
+	for kid := range s.public {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+
+	jwks := make([]JWK, 0, len(kids))
+	for _, kid := range kids {
+		key := s.public[kid]
+		jwks = append(jwks, JWK{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			Alg: "RS256",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+	return jwks
+}
+
+func parseKey(data []byte) (*rsa.PrivateKey, *rsa.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, nil, errors.New("no PEM block found")
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		return key, &key.PublicKey, nil
+	case "PRIVATE KEY":
+		parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		key, ok := parsed.(*rsa.PrivateKey)
+		if !ok {
+			return nil, nil, errors.New("private key is not an RSA key")
+		}
+		return key, &key.PublicKey, nil
+	case "PUBLIC KEY":
+		parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		key, ok := parsed.(*rsa.PublicKey)
+		if !ok {
+			return nil, nil, errors.New("public key is not an RSA key")
+		}
+		return nil, key, nil
+	case "RSA PUBLIC KEY":
+		key, err := x509.ParsePKCS1PublicKey(block.Bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, key, nil
+	}
+	return nil, nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+}
+
+// newestKid kid lar nomi bo'yicha eng oxirgisini tanlaydi (masalan 2024-10-01, 2024-11-01)
+func newestKid(keys map[string]*rsa.PrivateKey) string {
+	newest := ""
+	for kid := range keys {
+		if kid > newest {
+			newest = kid
+		}
+	}
+	return newest
+}
@@ -0,0 +1,119 @@
+package keys
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeKey(t *testing.T, dir, kid string, key *rsa.PrivateKey, public bool) {
+	t.Helper()
+	block := &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}
+	if public {
+		der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		block = &pem.Block{Type: "PUBLIC KEY", Bytes: der}
+	}
+	if err := os.WriteFile(filepath.Join(dir, kid+".pem"), pem.EncodeToMemory(block), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestStoreRotation(t *testing.T) {
+	dir := t.TempDir()
+	first, second := newKey(t), newKey(t)
+	writeKey(t, dir, "2026-09-01", first, false)
+
+	store, err := NewStore(dir, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kid, key := store.Active(); kid != "2026-09-01" || !key.Equal(first) {
+		t.Fatalf("active key %s", kid)
+	}
+
+	// yangi kalit qo'shildi, eskisi faqat public key bo'lib qoldi
+	writeKey(t, dir, "2026-10-01", second, false)
+	writeKey(t, dir, "2026-09-01", first, true)
+	if err := store.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if kid, key := store.Active(); kid != "2026-10-01" || !key.Equal(second) {
+		t.Fatalf("active key after rotation %s", kid)
+	}
+
+	jwks := store.JWKS()
+	if len(jwks) != 2 || jwks[0].Kid != "2026-09-01" || jwks[1].Kid != "2026-10-01" {
+		t.Fatalf("jwks = %+v", jwks)
+	}
+	for i, key := range []*rsa.PrivateKey{first, second} {
+		n, _ := base64.RawURLEncoding.DecodeString(jwks[i].N)
+		e, _ := base64.RawURLEncoding.DecodeString(jwks[i].E)
+		if new(big.Int).SetBytes(n).Cmp(key.N) != 0 || int(new(big.Int).SetBytes(e).Int64()) != key.E {
+			t.Errorf("jwk %s does not match its key", jwks[i].Kid)
+		}
+		if jwks[i].Alg != "RS256" || jwks[i].Kty != "RSA" {
+			t.Errorf("jwk %s has alg %s and kty %s", jwks[i].Kid, jwks[i].Alg, jwks[i].Kty)
+		}
+	}
+}
+
+func TestStoreActiveKid(t *testing.T) {
+	dir := t.TempDir()
+	writeKey(t, dir, "a", newKey(t), false)
+	writeKey(t, dir, "b", newKey(t), true)
+
+	if store, err := NewStore(dir, "a"); err != nil {
+		t.Fatal(err)
+	} else if kid, _ := store.Active(); kid != "a" {
+		t.Errorf("active kid = %s, want a", kid)
+	}
+	// faqat public key bilan imzolab bo'lmaydi
+	if _, err := NewStore(dir, "b"); err == nil {
+		t.Error("public-only key accepted as the active key")
+	}
+	if _, err := NewStore(dir, "missing"); err == nil {
+		t.Error("missing active key accepted")
+	}
+}
+
+func TestStoreTemporaryKey(t *testing.T) {
+	store, err := NewStore(t.TempDir(), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	kid, key := store.Active()
+	if key == nil || len(store.JWKS()) != 1 {
+		t.Fatalf("no temporary key generated")
+	}
+	// qayta o'qishda vaqtinchalik kalit saqlanadi, aks holda tokenlar yaroqsiz bo'lardi
+	if err := store.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if reloaded, _ := store.Active(); reloaded != kid {
+		t.Errorf("temporary key changed from %s to %s on reload", kid, reloaded)
+	}
+}
+
+func TestStoreMissingDir(t *testing.T) {
+	if _, err := NewStore(filepath.Join(t.TempDir(), "missing"), ""); err == nil {
+		t.Error("missing keys directory accepted")
+	}
+}
@@ -1,24 +1,41 @@
 package methods
 
 import (
-	grp "user-service/pkg/proto"
+	"pkg/grpcclient"
+	"pkg/logger"
+	"pkg/metrics"
+	"pkg/mtls"
+	"pkg/requestid"
+	"sync"
 	"user-service/config"
-	"log"
+	grp "user-service/pkg/proto"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
-func ConnectLike() grp.LikeServiceClient {
-	conf, err := config.LoadConfig()
-	if err != nil {
-		log.Fatalf("Failed to load config: %v", err)
-	}
-	conn, err := grpc.NewClient(conf.LIKE_SERVER_NAME+":"+conf.LIKE_SERVER_PORT, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		log.Fatal("error connect like micro...", err)
-	}
+var (
+	likeOnce   sync.Once
+	likeClient grp.LikeServiceClient
+)
 
-	client := grp.NewLikeServiceClient(conn)
-	return client
+// ConnectLike like-service uchun umumiy clientni qaytaradi.
+// Ulanish birinchi chaqiruvda bir marta ochiladi va keyin qayta ishlatiladi.
+func ConnectLike() grp.LikeServiceClient {
+	likeOnce.Do(func() {
+		conf, err := config.LoadConfig()
+		if err != nil {
+			logger.Fatal("failed to load config", "error", err)
+		}
+		creds, err := mtls.ClientCredentials(conf.MTLS(), conf.LIKE_SERVER_NAME)
+		if err != nil {
+			logger.Fatal("failed to load TLS credentials", "error", err)
+		}
+		conn, err := grpcclient.Dial(conf.LIKE_SERVER_NAME+":"+conf.LIKE_SERVER_PORT, creds, grpcclient.DefaultOptions,
+			grpc.WithChainUnaryInterceptor(requestid.UnaryClientInterceptor(), logger.UnaryClientInterceptor(), metrics.UnaryClientInterceptor()))
+		if err != nil {
+			logger.Fatal("failed to connect to like service", "error", err)
+		}
+		likeClient = grp.NewLikeServiceClient(conn)
+	})
+	return likeClient
 }
@@ -19,6 +19,17 @@ type User struct {
 	Following []int32 `json:"following"`
 	IsPrivate bool `json:"is_private"`
 	BlockedUsers []int32 `json:"blocked_users"`
+	Role string `json:"role"`
+}
+
+const (
+	RoleUser      = "user"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
+func ValidRole(role string) bool {
+	return role == RoleUser || role == RoleModerator || role == RoleAdmin
 }
 
 type Avatar struct {
@@ -35,3 +46,29 @@ type Code struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+
+// Media gateway orqali yuklangan fayl. ID ni gateway beradi, Renditions
+// rendition nomidan URL ga. AttachedAt tweet, xabar yoki avatarga biriktirilganda
+// qo'yiladi; biriktirilmagan media gateway tomonidan tozalanadi.
+type Media struct {
+	ID string `json:"id"`
+	OwnerID int64 `json:"owner_id"`
+	URL string `json:"url"`
+	MimeType string `json:"mime_type"`
+	SizeBytes int64 `json:"size_bytes"`
+	Width int32 `json:"width"`
+	Height int32 `json:"height"`
+	DurationMS int64 `json:"duration_ms"`
+	Blurhash string `json:"blurhash"`
+	AltText string `json:"alt_text"`
+	Renditions map[string]string `json:"renditions"`
+	CreatedAt time.Time `json:"created_at"`
+	AttachedAt *time.Time `json:"attached_at"`
+}
+
+// MediaQuota foydalanuvchining media uchun joyi
+type MediaQuota struct {
+	UsedBytes int64 `json:"used_bytes"`
+	QuotaBytes int64 `json:"quota_bytes"`
+	MediaCount int64 `json:"media_count"`
+}
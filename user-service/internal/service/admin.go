@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"user-service/internal/models"
+	"user-service/utils"
+)
+
+func (s *UserService) GetRole(ctx context.Context, userID int64) (string, error) {
+	var role string
+	err := s.db.QueryRowContext(ctx, "SELECT role FROM user_roles WHERE user_id = $1", userID).Scan(&role)
+	if err == sql.ErrNoRows {
+		return models.RoleUser, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("error getting role: %v", err)
+	}
+	return role, nil
+}
+
+func (s *UserService) SetRole(ctx context.Context, userID int64, role string) error {
+	if !models.ValidRole(role) {
+		return ErrInvalidRole
+	}
+	query := `
+		INSERT INTO user_roles (user_id, role) VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET role = EXCLUDED.role, updated_at = CURRENT_TIMESTAMP`
+	_, err := s.db.ExecContext(ctx, query, userID, role)
+	if err != nil {
+		return fmt.Errorf("error setting role: %v", err)
+	}
+	return nil
+}
+
+// ListUsers foydalanuvchilarni username, email yoki ism bo'yicha qidiradi
+func (s *UserService) ListUsers(ctx context.Context, search string, limit, offset int32) ([]models.User, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	query := `
+		SELECT u.id, u.username, u.email, u.name, u.phone, u.created_at, COALESCE(u.bio, ''), u.is_private, COALESCE(r.role, 'user')
+		FROM users u
+		LEFT JOIN user_roles r ON r.user_id = u.id
+		WHERE $1 = '' OR u.username ILIKE '%' || $1 || '%' OR u.email ILIKE '%' || $1 || '%' OR u.name ILIKE '%' || $1 || '%'
+		ORDER BY u.id
+		LIMIT $2 OFFSET $3`
+
+	rows, err := s.db.QueryContext(ctx, query, search, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("error listing users: %v", err)
+	}
+	defer rows.Close()
+
+	var users []models.User
+	for rows.Next() {
+		var user models.User
+		err := rows.Scan(&user.ID, &user.Username, &user.Email, &user.Name, &user.Phone, &user.CreatedAt, &user.Bio, &user.IsPrivate, &user.Role)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning user: %v", err)
+		}
+		users = append(users, user)
+	}
+	return users, rows.Err()
+}
+
+// ResetAccount parolni tasodifiy qiymatga almashtiradi va barcha sessiyalarni bekor qiladi.
+// Foydalanuvchi hisobiga ForgotPassword orqali qaytadi.
+func (s *UserService) ResetAccount(ctx context.Context, userID int64) (int64, error) {
+	password, err := utils.GenerateToken(32)
+	if err != nil {
+		return 0, fmt.Errorf("error generating password: %v", err)
+	}
+	hashedPassword, err := utils.HashPassword(password)
+	if err != nil {
+		return 0, fmt.Errorf("error hashing password: %v", err)
+	}
+
+	result, err := s.db.ExecContext(ctx, "UPDATE users SET password = $1 WHERE id = $2", hashedPassword, userID)
+	if err != nil {
+		return 0, fmt.Errorf("error resetting account: %v", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return 0, ErrUserNotFound
+	}
+
+	return s.RevokeAllSessions(ctx, userID)
+}
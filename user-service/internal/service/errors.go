@@ -0,0 +1,34 @@
+package service
+
+import "pkg/grpcerr"
+
+// Servis xatolari. Reason qiymatlari gateway javobida "code" bo'lib chiqadi.
+var (
+	ErrUnauthenticated        = grpcerr.Unauthenticated("UNAUTHENTICATED", "caller is not authenticated")
+	ErrNotCaller              = grpcerr.PermissionDenied("NOT_CALLER", "you can only act as yourself")
+	ErrUserNotFound           = grpcerr.NotFound("USER_NOT_FOUND", "user not found")
+	ErrTooManyUserIDs         = grpcerr.InvalidArgument("TOO_MANY_USER_IDS", "user_ids", "at most 100 user ids per request")
+	ErrUserAlreadyExists      = grpcerr.AlreadyExists("USER_ALREADY_EXISTS", "user already exists")
+	ErrUsernameTaken          = grpcerr.AlreadyExists("USERNAME_TAKEN", "username already exists")
+	ErrLoginIdentifierMissing = grpcerr.InvalidArgument("LOGIN_IDENTIFIER_REQUIRED", "login_identifier", "login identifier is required")
+	ErrContactMissing         = grpcerr.InvalidArgument("EMAIL_OR_PHONE_REQUIRED", "email", "either email or phone must be provided")
+	ErrInvalidRole            = grpcerr.InvalidArgument("INVALID_ROLE", "role", "invalid role")
+	ErrInvalidCredentials     = grpcerr.Unauthenticated("INVALID_CREDENTIALS", "invalid login or password")
+	ErrInvalidCode            = grpcerr.InvalidArgument("INVALID_VERIFICATION_CODE", "code", "invalid verification code")
+	ErrCodeExpired            = grpcerr.FailedPrecondition("VERIFICATION_CODE_EXPIRED", "code expired")
+	ErrSessionNotOwned        = grpcerr.PermissionDenied("SESSION_NOT_OWNED", "session does not belong to user")
+	ErrInvalidRefreshToken    = grpcerr.Unauthenticated("INVALID_REFRESH_TOKEN", "invalid refresh token")
+	ErrInvalidChallenge       = grpcerr.Unauthenticated("INVALID_LOGIN_CHALLENGE", "invalid or expired login challenge")
+	ErrInvalidTOTPCode        = grpcerr.InvalidArgument("INVALID_TWO_FACTOR_CODE", "code", "invalid two-factor code")
+	ErrTOTPAlreadyEnabled     = grpcerr.FailedPrecondition("TWO_FACTOR_ALREADY_ENABLED", "two-factor authentication is already enabled")
+	ErrTOTPNotEnrolled        = grpcerr.FailedPrecondition("TWO_FACTOR_NOT_ENROLLED", "two-factor authentication is not enrolled")
+	ErrTOTPNotEnabled         = grpcerr.FailedPrecondition("TWO_FACTOR_NOT_ENABLED", "two-factor authentication is not enabled")
+)
+
+// Media xatolari
+var (
+	ErrMediaNotFound      = grpcerr.NotFound("MEDIA_NOT_FOUND", "media not found")
+	ErrMediaQuotaExceeded = grpcerr.FailedPrecondition("MEDIA_QUOTA_EXCEEDED", "media storage quota exceeded")
+	ErrAltTextTooLong     = grpcerr.InvalidArgument("ALT_TEXT_TOO_LONG", "alt_text", "alt text is too long")
+	ErrInvalidMediaQuota  = grpcerr.InvalidArgument("INVALID_MEDIA_QUOTA", "quota_bytes", "quota must not be negative")
+)
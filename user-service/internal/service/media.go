@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+	"unicode/utf8"
+	"user-service/internal/models"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// DefaultMediaQuota media_quotas da yozuvi bo'lmagan foydalanuvchining joyi
+	DefaultMediaQuota = 1 << 30
+	MaxAltTextLength  = 1000
+
+	maxMediaList = 100
+	mediaColumns = "id, owner_id, url, mime_type, size_bytes, width, height, duration_ms, blurhash, alt_text, renditions, created_at, attached_at"
+)
+
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanMedia(row rowScanner) (models.Media, error) {
+	var m models.Media
+	var renditions []byte
+	err := row.Scan(&m.ID, &m.OwnerID, &m.URL, &m.MimeType, &m.SizeBytes, &m.Width, &m.Height, &m.DurationMS,
+		&m.Blurhash, &m.AltText, &renditions, &m.CreatedAt, &m.AttachedAt)
+	if err != nil {
+		return m, err
+	}
+	if err := json.Unmarshal(renditions, &m.Renditions); err != nil {
+		return m, fmt.Errorf("error decoding renditions: %v", err)
+	}
+	return m, nil
+}
+
+// CreateMedia biriktirilmagan media yozuvini qo'shadi. Kvota tekshiruvi va
+// yozish bitta tranzaksiyada, users qatori qulflangani uchun bir vaqtdagi
+// yuklashlar kvotadan oshib keta olmaydi.
+func (s *UserService) CreateMedia(ctx context.Context, m models.Media) (models.Media, error) {
+	if utf8.RuneCountInString(m.AltText) > MaxAltTextLength {
+		return models.Media{}, ErrAltTextTooLong
+	}
+	renditions, err := json.Marshal(m.Renditions)
+	if err != nil {
+		return models.Media{}, fmt.Errorf("error encoding renditions: %v", err)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.Media{}, err
+	}
+	defer tx.Rollback()
+
+	var id int64
+	err = tx.QueryRowContext(ctx, "SELECT id FROM users WHERE id = $1 FOR UPDATE", m.OwnerID).Scan(&id)
+	if err == sql.ErrNoRows {
+		return models.Media{}, ErrUserNotFound
+	}
+	if err != nil {
+		return models.Media{}, fmt.Errorf("error locking user: %v", err)
+	}
+	quota, err := mediaQuota(ctx, tx, m.OwnerID)
+	if err != nil {
+		return models.Media{}, err
+	}
+	if quota.UsedBytes+m.SizeBytes > quota.QuotaBytes {
+		return models.Media{}, ErrMediaQuotaExceeded
+	}
+
+	query := `
+		INSERT INTO media (id, owner_id, url, mime_type, size_bytes, width, height, duration_ms, blurhash, alt_text, renditions)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING ` + mediaColumns
+	created, err := scanMedia(tx.QueryRowContext(ctx, query, m.ID, m.OwnerID, m.URL, m.MimeType, m.SizeBytes,
+		m.Width, m.Height, m.DurationMS, m.Blurhash, m.AltText, renditions))
+	if err != nil {
+		return models.Media{}, fmt.Errorf("error creating media: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return models.Media{}, err
+	}
+	return created, nil
+}
+
+func (s *UserService) GetMedia(ctx context.Context, id string) (models.Media, error) {
+	m, err := scanMedia(s.db.QueryRowContext(ctx, "SELECT "+mediaColumns+" FROM media WHERE id = $1", id))
+	if err == sql.ErrNoRows {
+		return models.Media{}, ErrMediaNotFound
+	}
+	if err != nil {
+		return models.Media{}, fmt.Errorf("error getting media: %v", err)
+	}
+	return m, nil
+}
+
+// GetMediaByIDs ids tartibida qaytaradi, topilmagan ID lar tashlab ketiladi
+func (s *UserService) GetMediaByIDs(ctx context.Context, ids []string) ([]models.Media, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	rows, err := s.db.QueryContext(ctx, "SELECT "+mediaColumns+" FROM media WHERE id = ANY($1)", pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("error getting media: %v", err)
+	}
+	return collectMedia(rows, ids)
+}
+
+// UpdateAltText faqat media egasi uchun
+func (s *UserService) UpdateAltText(ctx context.Context, userID int64, id, altText string) (models.Media, error) {
+	if utf8.RuneCountInString(altText) > MaxAltTextLength {
+		return models.Media{}, ErrAltTextTooLong
+	}
+	query := "UPDATE media SET alt_text = $1 WHERE id = $2 AND owner_id = $3 RETURNING " + mediaColumns
+	m, err := scanMedia(s.db.QueryRowContext(ctx, query, altText, id, userID))
+	if err == sql.ErrNoRows {
+		return models.Media{}, ErrMediaNotFound
+	}
+	if err != nil {
+		return models.Media{}, fmt.Errorf("error updating media: %v", err)
+	}
+	return m, nil
+}
+
+// AttachMedia userID ning biriktirilmagan media larini biriktiradi. Birortasi
+// topilmasa, boshqa foydalanuvchiniki, allaqachon biriktirilgan yoki so'rovda
+// ikki marta kelgan bo'lsa hech biri biriktirilmaydi.
+func (s *UserService) AttachMedia(ctx context.Context, userID int64, ids []string) ([]models.Media, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE media SET attached_at = CURRENT_TIMESTAMP
+		WHERE owner_id = $1 AND id = ANY($2) AND attached_at IS NULL
+		RETURNING ` + mediaColumns
+	rows, err := tx.QueryContext(ctx, query, userID, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("error attaching media: %v", err)
+	}
+	media, err := collectMedia(rows, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(media) != len(ids) {
+		return nil, ErrMediaNotFound
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return media, nil
+}
+
+// DetachMedia AttachMedia ni bekor qiladi: media yana GC qilinadi
+func (s *UserService) DetachMedia(ctx context.Context, userID int64, ids []string) error {
+	query := "UPDATE media SET attached_at = NULL WHERE owner_id = $1 AND id = ANY($2)"
+	if _, err := s.db.ExecContext(ctx, query, userID, pq.Array(ids)); err != nil {
+		return fmt.Errorf("error detaching media: %v", err)
+	}
+	return nil
+}
+
+// ListUnattachedMedia before dan oldin yaratilgan biriktirilmagan media, eng eskisi birinchi
+func (s *UserService) ListUnattachedMedia(ctx context.Context, before time.Time, limit int32) ([]models.Media, error) {
+	if limit <= 0 || limit > maxMediaList {
+		limit = maxMediaList
+	}
+	query := `
+		SELECT ` + mediaColumns + ` FROM media
+		WHERE attached_at IS NULL AND created_at < $1
+		ORDER BY created_at
+		LIMIT $2`
+	rows, err := s.db.QueryContext(ctx, query, before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error listing media: %v", err)
+	}
+	return collectMedia(rows, nil)
+}
+
+// DeleteMedia faqat biriktirilmagan yozuvni o'chiradi; o'chirilgan bo'lsa true
+func (s *UserService) DeleteMedia(ctx context.Context, id string) (bool, error) {
+	result, err := s.db.ExecContext(ctx, "DELETE FROM media WHERE id = $1 AND attached_at IS NULL", id)
+	if err != nil {
+		return false, fmt.Errorf("error deleting media: %v", err)
+	}
+	n, _ := result.RowsAffected()
+	return n > 0, nil
+}
+
+func (s *UserService) GetMediaQuota(ctx context.Context, userID int64) (models.MediaQuota, error) {
+	return mediaQuota(ctx, s.db, userID)
+}
+
+// SetMediaQuota foydalanuvchining kvotasini o'zgartiradi, 0 standart kvotani qaytaradi
+func (s *UserService) SetMediaQuota(ctx context.Context, userID, quotaBytes int64) error {
+	if quotaBytes < 0 {
+		return ErrInvalidMediaQuota
+	}
+	var exists bool
+	err := s.db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM users WHERE id = $1)", userID).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("error getting user: %v", err)
+	}
+	if !exists {
+		return ErrUserNotFound
+	}
+
+	if quotaBytes == 0 {
+		_, err = s.db.ExecContext(ctx, "DELETE FROM media_quotas WHERE user_id = $1", userID)
+	} else {
+		query := `
+			INSERT INTO media_quotas (user_id, quota_bytes) VALUES ($1, $2)
+			ON CONFLICT (user_id) DO UPDATE SET quota_bytes = EXCLUDED.quota_bytes, updated_at = CURRENT_TIMESTAMP`
+		_, err = s.db.ExecContext(ctx, query, userID, quotaBytes)
+	}
+	if err != nil {
+		return fmt.Errorf("error setting media quota: %v", err)
+	}
+	return nil
+}
+
+// mediaQuota biriktirilmagan media ham hisoblanadi, aks holda yuklab
+// biriktirmasdan kvotani chetlab o'tish mumkin
+func mediaQuota(ctx context.Context, q querier, userID int64) (models.MediaQuota, error) {
+	query := `
+		SELECT COALESCE((SELECT quota_bytes FROM media_quotas WHERE user_id = $1), $2), COALESCE(SUM(size_bytes), 0), COUNT(*)
+		FROM media WHERE owner_id = $1`
+	var quota models.MediaQuota
+	err := q.QueryRowContext(ctx, query, userID, DefaultMediaQuota).Scan(&quota.QuotaBytes, &quota.UsedBytes, &quota.MediaCount)
+	if err != nil {
+		return quota, fmt.Errorf("error getting media quota: %v", err)
+	}
+	return quota, nil
+}
+
+// collectMedia rows ni o'qib yopadi. order bo'sh bo'lmasa natija shu ID lar
+// tartibida bo'ladi.
+func collectMedia(rows *sql.Rows, order []string) ([]models.Media, error) {
+	defer rows.Close()
+	byID := make(map[string]models.Media)
+	var media []models.Media
+	for rows.Next() {
+		m, err := scanMedia(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning media: %v", err)
+		}
+		byID[m.ID] = m
+		media = append(media, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if order == nil {
+		return media, nil
+	}
+	media = media[:0]
+	for _, id := range order {
+		if m, ok := byID[id]; ok {
+			media = append(media, m)
+			// takrorlangan ID bir marta qaytariladi
+			delete(byID, id)
+		}
+	}
+	return media, nil
+}
@@ -3,8 +3,9 @@ package service
 import (
 	"context"
 	"database/sql"
-	"errors"
 	"fmt"
+	"log/slog"
+	"pkg/principal"
 	"strings"
 	"sync"
 	"user-service/internal/kafka"
@@ -14,15 +15,21 @@ import (
 	"user-service/utils"
 
 	"github.com/go-redis/redis/v8"
+	"github.com/lib/pq"
 )
 
+// MaxUsersPerBatch GetUsersByIDs bir so'rovda qabul qiladigan ID lar soni
+const MaxUsersPerBatch = 100
+
 type UserService struct {
 	db          *sql.DB
 	RedisClient *redis.Client
+	// totpBox user_totp.secret ni shifrlaydi
+	totpBox *utils.SecretBox
 }
 
-func NewUserService(db *sql.DB, redisClient *redis.Client) *UserService {
-	return &UserService{db: db, RedisClient: redisClient}
+func NewUserService(db *sql.DB, redisClient *redis.Client, totpBox *utils.SecretBox) *UserService {
+	return &UserService{db: db, RedisClient: redisClient, totpBox: totpBox}
 }
 
 func (s *UserService) RegisterUser(ctx context.Context, username, email, password, name, phone, code string) (models.User, error) {
@@ -40,7 +47,7 @@ func (s *UserService) RegisterUser(ctx context.Context, username, email, passwor
 	} else if phone != "" {
 		loginIdentifier = phone
 	} else {
-		return models.User{}, errors.New("login identifier is required")
+		return models.User{}, ErrLoginIdentifierMissing
 	}
 
 	queryCode := "INSERT INTO codes (login_identifier, code) VALUES ($1, $2) RETURNING *"
@@ -57,7 +64,7 @@ func (s *UserService) RegisterUser(ctx context.Context, username, email, passwor
 	if err != nil && err != sql.ErrNoRows {
 		return models.User{}, fmt.Errorf("error checking user existence: %v", err)
 	} else if err == nil {
-		return models.User{}, errors.New("user already exists")
+		return models.User{}, ErrUserAlreadyExists
 	}
 
 	queryInsert := `INSERT INTO users (username, email, password, name, phone) VALUES ($1, $2, $3, $4, $5) RETURNING *`
@@ -100,14 +107,14 @@ func (s *UserService) LoginUser(ctx context.Context, loginIdentifier string) (mo
 		&user.CreatedAt,
 		&user.Bio,
 		&user.IsPrivate,
-		&user.Followers,
-		&user.Following,
-		&user.Tweets,
-		&user.BlockedUsers,
+		pq.Array(&user.Followers),
+		pq.Array(&user.Following),
+		pq.Array(&user.Tweets),
+		pq.Array(&user.BlockedUsers),
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return models.User{}, errors.New("user not found")
+			return models.User{}, ErrUserNotFound
 		}
 		return models.User{}, fmt.Errorf("error querying user: %v", err)
 	}
@@ -117,36 +124,83 @@ func (s *UserService) LoginUser(ctx context.Context, loginIdentifier string) (mo
 	return user, nil
 }
 
-func (s *UserService) GetUser(ctx context.Context, id int64, userIDToGet int64) (models.User, error) {
-	user := models.User{}
+// GetUser viewerID bloklangan bo'lsa foydalanuvchi yo'qdek ErrUserNotFound
+// qaytaradi. viewerID 0 ichki so'rovlar uchun, u tekshirilmaydi.
+func (s *UserService) GetUser(ctx context.Context, id int64, viewerID int64) (models.User, error) {
 	query := `
 		SELECT * FROM users 
 		WHERE id = $1`
-	err := s.db.QueryRowContext(ctx, query, id).Scan(
+	user, err := scanUser(s.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.User{}, ErrUserNotFound
+		}
+		return models.User{}, fmt.Errorf("error querying user: %v", err)
+	}
+	if hidesFrom(user, viewerID) {
+		return models.User{}, ErrUserNotFound
+	}
+	return user, nil
+}
+
+// GetUsersByIDs ids tartibida qaytaradi. Topilmagan va viewerID ni bloklagan
+// foydalanuvchilar GetUser dagidek tashlab ketiladi.
+func (s *UserService) GetUsersByIDs(ctx context.Context, ids []int32, viewerID int64) ([]models.User, error) {
+	if len(ids) > MaxUsersPerBatch {
+		return nil, ErrTooManyUserIDs
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	rows, err := s.db.QueryContext(ctx, "SELECT * FROM users WHERE id = ANY($1)", pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("error querying users: %v", err)
+	}
+	defer rows.Close()
+	byID := make(map[int32]models.User)
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning user: %v", err)
+		}
+		if !hidesFrom(user, viewerID) {
+			byID[int32(user.ID)] = user
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	users := make([]models.User, 0, len(byID))
+	for _, id := range ids {
+		if user, ok := byID[id]; ok {
+			users = append(users, user)
+			// takrorlangan ID bir marta qaytariladi
+			delete(byID, id)
+		}
+	}
+	return users, nil
+}
+
+// scanUser users jadvali tartibidagi qatorni o'qiydi va hisoblagichlarni to'ldiradi
+func scanUser(row rowScanner) (models.User, error) {
+	user := models.User{}
+	err := row.Scan(
 		&user.ID,
 		&user.Username,
 		&user.Email,
-		&user.Password,
 		&user.Name,
 		&user.Phone,
+		&user.Password,
 		&user.CreatedAt,
 		&user.Bio,
 		&user.IsPrivate,
-		&user.Tweets,
-		&user.Followers,
-		&user.Following,
-		&user.BlockedUsers,
+		pq.Array(&user.Followers),
+		pq.Array(&user.Following),
+		pq.Array(&user.Tweets),
+		pq.Array(&user.BlockedUsers),
 	)
-	if userIDToGet != 0 {
-		if !utils.InSlice(user.BlockedUsers, int32(userIDToGet)) {
-			return models.User{}, errors.New("user is not blocked")
-		}
-	}
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return models.User{}, errors.New("user not found")
-		}
-		return models.User{}, fmt.Errorf("error querying user: %v", err)
+		return models.User{}, err
 	}
 	user.FollowerCount = int32(len(user.Followers))
 	user.FollowingCount = int32(len(user.Following))
@@ -154,6 +208,11 @@ func (s *UserService) GetUser(ctx context.Context, id int64, userIDToGet int64)
 	return user, nil
 }
 
+// hidesFrom user viewerID ni bloklaganini bildiradi; viewerID 0 tekshirilmaydi
+func hidesFrom(user models.User, viewerID int64) bool {
+	return viewerID != 0 && viewerID != user.ID && utils.InSlice(user.BlockedUsers, int32(viewerID))
+}
+
 func (s *UserService) GetUserByEmail(ctx context.Context, email string) (models.User, error) {
 	user := models.User{}
 	query := `
@@ -163,20 +222,20 @@ func (s *UserService) GetUserByEmail(ctx context.Context, email string) (models.
 		&user.ID,
 		&user.Username,
 		&user.Email,
-		&user.Password,
 		&user.Name,
 		&user.Phone,
+		&user.Password,
 		&user.CreatedAt,
 		&user.Bio,
 		&user.IsPrivate,
-		&user.Tweets,
-		&user.Followers,
-		&user.Following,
-		&user.BlockedUsers,
+		pq.Array(&user.Followers),
+		pq.Array(&user.Following),
+		pq.Array(&user.Tweets),
+		pq.Array(&user.BlockedUsers),
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return models.User{}, errors.New("user not found")
+			return models.User{}, ErrUserNotFound
 		}
 		return models.User{}, fmt.Errorf("error querying user: %v", err)
 	}
@@ -195,20 +254,20 @@ func (s *UserService) GetUserByUsername(ctx context.Context, username string) (m
 		&user.ID,
 		&user.Username,
 		&user.Email,
-		&user.Password,
 		&user.Name,
 		&user.Phone,
+		&user.Password,
 		&user.CreatedAt,
 		&user.Bio,
 		&user.IsPrivate,
-		&user.Tweets,
-		&user.Followers,
-		&user.Following,
-		&user.BlockedUsers,
+		pq.Array(&user.Followers),
+		pq.Array(&user.Following),
+		pq.Array(&user.Tweets),
+		pq.Array(&user.BlockedUsers),
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return models.User{}, errors.New("user not found")
+			return models.User{}, ErrUserNotFound
 		}
 		return models.User{}, fmt.Errorf("error querying user: %v", err)
 	}
@@ -227,20 +286,20 @@ func (s *UserService) GetUserByPhone(ctx context.Context, phone string) (models.
 		&user.ID,
 		&user.Username,
 		&user.Email,
-		&user.Password,
 		&user.Name,
 		&user.Phone,
+		&user.Password,
 		&user.CreatedAt,
 		&user.Bio,
 		&user.IsPrivate,
-		&user.Tweets,
-		&user.Followers,
-		&user.Following,
-		&user.BlockedUsers,
+		pq.Array(&user.Followers),
+		pq.Array(&user.Following),
+		pq.Array(&user.Tweets),
+		pq.Array(&user.BlockedUsers),
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return models.User{}, errors.New("user not found")
+			return models.User{}, ErrUserNotFound
 		}
 		return models.User{}, fmt.Errorf("error querying user: %v", err)
 	}
@@ -252,13 +311,13 @@ func (s *UserService) GetUserByPhone(ctx context.Context, phone string) (models.
 
 func (s *UserService) UpdateUser(ctx context.Context, user models.User) (models.User, error) {
 	if user.Email == "" && user.Phone == "" {
-		return models.User{}, errors.New("either email or phone must be provided")
+		return models.User{}, ErrContactMissing
 	}
 
 	var existingUsername string
 	err := s.db.QueryRowContext(ctx, "SELECT username FROM users WHERE username = $1 AND id != $2", user.Username, user.ID).Scan(&existingUsername)
 	if err == nil {
-		return models.User{}, errors.New("username already exists")
+		return models.User{}, ErrUsernameTaken
 	}
 
 	query := "UPDATE users SET "
@@ -299,10 +358,10 @@ func (s *UserService) UpdateUser(ctx context.Context, user models.User) (models.
 		&user.Phone,
 		&user.Bio,
 		&user.IsPrivate,
-		&user.Followers,
-		&user.Following,
-		&user.Tweets,
-		&user.BlockedUsers,
+		pq.Array(&user.Followers),
+		pq.Array(&user.Following),
+		pq.Array(&user.Tweets),
+		pq.Array(&user.BlockedUsers),
 	)
 	if err != nil {
 		return models.User{}, fmt.Errorf("error updating user: %v", err)
@@ -391,10 +450,10 @@ func (s *UserService) AddTweet(ctx context.Context, id int64, tweetID int64) err
 		&user.CreatedAt,
 		&user.Bio,
 		&user.IsPrivate,
-		&user.Followers,
-		&user.Following,
-		&user.Tweets,
-		&user.BlockedUsers,
+		pq.Array(&user.Followers),
+		pq.Array(&user.Following),
+		pq.Array(&user.Tweets),
+		pq.Array(&user.BlockedUsers),
 	)
 	if err != nil {
 		return fmt.Errorf("error adding tweet: %v", err)
@@ -424,10 +483,10 @@ func (s *UserService) RemoveTweet(ctx context.Context, id int64, tweetID int64)
 		&user.CreatedAt,
 		&user.Bio,
 		&user.IsPrivate,
-		&user.Followers,
-		&user.Following,
-		&user.Tweets,
-		&user.BlockedUsers,
+		pq.Array(&user.Followers),
+		pq.Array(&user.Following),
+		pq.Array(&user.Tweets),
+		pq.Array(&user.BlockedUsers),
 	)
 	if err != nil {
 		return fmt.Errorf("error removing tweet: %v", err)
@@ -457,10 +516,10 @@ func (s *UserService) AddFollower(ctx context.Context, id int64, followerID int6
 		&user.CreatedAt,
 		&user.Bio,
 		&user.IsPrivate,
-		&user.Followers,
-		&user.Following,
-		&user.Tweets,
-		&user.BlockedUsers,
+		pq.Array(&user.Followers),
+		pq.Array(&user.Following),
+		pq.Array(&user.Tweets),
+		pq.Array(&user.BlockedUsers),
 	)
 	if err != nil {
 		return fmt.Errorf("error adding follower: %v", err)
@@ -490,10 +549,10 @@ func (s *UserService) RemoveFollower(ctx context.Context, id int64, followerID i
 		&user.CreatedAt,
 		&user.Bio,
 		&user.IsPrivate,
-		&user.Followers,
-		&user.Following,
-		&user.Tweets,
-		&user.BlockedUsers,
+		pq.Array(&user.Followers),
+		pq.Array(&user.Following),
+		pq.Array(&user.Tweets),
+		pq.Array(&user.BlockedUsers),
 	)
 	if err != nil {
 		return fmt.Errorf("error removing follower: %v", err)
@@ -522,10 +581,10 @@ func (s *UserService) AddFollowing(ctx context.Context, id int64, followingID in
 		&user.CreatedAt,
 		&user.Bio,
 		&user.IsPrivate,
-		&user.Followers,
-		&user.Following,
-		&user.Tweets,
-		&user.BlockedUsers,
+		pq.Array(&user.Followers),
+		pq.Array(&user.Following),
+		pq.Array(&user.Tweets),
+		pq.Array(&user.BlockedUsers),
 	)
 	if err != nil {
 		return fmt.Errorf("error adding following: %v", err)
@@ -562,10 +621,10 @@ func (s *UserService) RemoveFollowing(ctx context.Context, id int64, followingID
 		&user.CreatedAt,
 		&user.Bio,
 		&user.IsPrivate,
-		&user.Followers,
-		&user.Following,
-		&user.Tweets,
-		&user.BlockedUsers,
+		pq.Array(&user.Followers),
+		pq.Array(&user.Following),
+		pq.Array(&user.Tweets),
+		pq.Array(&user.BlockedUsers),
 	)
 	if err != nil {
 		return fmt.Errorf("error removing following: %v", err)
@@ -629,6 +688,9 @@ func (s *UserService) VerifyCode(ctx context.Context, loginIdentifier, message s
 		&code.Code,
 		&code.CreatedAt,
 	)
+	if err == sql.ErrNoRows {
+		return models.Code{}, ErrInvalidCode
+	}
 	if err != nil {
 		return models.Code{}, fmt.Errorf("error verifying code: %v", err)
 	}
@@ -648,17 +710,18 @@ func (s *UserService) BlockUser(ctx context.Context, id int64, blockedID int64)
 		&user.CreatedAt,
 		&user.Bio,
 		&user.IsPrivate,
-		&user.Followers,
-		&user.Following,
-		&user.Tweets,
-		&user.BlockedUsers,
+		pq.Array(&user.Followers),
+		pq.Array(&user.Following),
+		pq.Array(&user.Tweets),
+		pq.Array(&user.BlockedUsers),
 	)
 	if err != nil {
 		return fmt.Errorf("error blocking user: %v", err)
 	}
 	user.BlockedUsers = append(user.BlockedUsers, int32(blockedID))
 
-	blockedUser, err := s.GetUser(ctx, blockedID, id)
+	// bloklagan foydalanuvchi ham javoban bloklay olishi kerak
+	blockedUser, err := s.GetUser(ctx, blockedID, 0)
 	if err != nil {
 		return fmt.Errorf("error blocking user: %v", err)
 	}
@@ -673,7 +736,7 @@ func (s *UserService) BlockUser(ctx context.Context, id int64, blockedID int64)
 		if utils.InSlice(user.Following, int32(blockedID)) {
 			err = s.RemoveFollowing(ctx, id, blockedID)
 			if err != nil {
-				fmt.Printf("error removing following: %v\n", err)
+				slog.ErrorContext(ctx, "failed to remove following", "error", err)
 			}
 		}
 	}()
@@ -683,7 +746,7 @@ func (s *UserService) BlockUser(ctx context.Context, id int64, blockedID int64)
 		if utils.InSlice(user.Followers, int32(blockedID)) {
 			err = s.RemoveFollower(ctx, id, blockedID)
 			if err != nil {
-				fmt.Printf("error removing follower: %v\n", err)
+				slog.ErrorContext(ctx, "failed to remove follower", "error", err)
 			}
 		}
 	}()
@@ -693,7 +756,7 @@ func (s *UserService) BlockUser(ctx context.Context, id int64, blockedID int64)
 		if utils.InSlice(blockedUser.Following, int32(id)) {
 			err = s.RemoveFollowing(ctx, blockedID, id)
 			if err != nil {
-				fmt.Printf("error removing following: %v\n", err)
+				slog.ErrorContext(ctx, "failed to remove following", "error", err)
 			}
 		}
 	}()
@@ -703,7 +766,7 @@ func (s *UserService) BlockUser(ctx context.Context, id int64, blockedID int64)
 		if utils.InSlice(blockedUser.Followers, int32(id)) {
 			err = s.RemoveFollower(ctx, blockedID, id)
 			if err != nil {
-				fmt.Printf("error removing follower: %v\n", err)
+				slog.ErrorContext(ctx, "failed to remove follower", "error", err)
 			}
 		}
 	}()
@@ -712,9 +775,7 @@ func (s *UserService) BlockUser(ctx context.Context, id int64, blockedID int64)
 
 	// Tweets va commentlarni o'chirish
 	tweetsConn := methods.ConnectTweet()
-	tweets, err := tweetsConn.GetTweetsByUser(ctx, &proto.GetTweetsByUserRequest{
-		UserId: int32(id),
-	})
+	tweets, err := allTweetsByUser(ctx, tweetsConn, int32(id))
 	if err != nil {
 		return fmt.Errorf("error getting tweets: %v", err)
 	}
@@ -724,18 +785,18 @@ func (s *UserService) BlockUser(ctx context.Context, id int64, blockedID int64)
 
 	var wgTweets sync.WaitGroup
 
-	for _, tweet := range tweets.Tweets {
+	for _, tweet := range tweets {
 		wgTweets.Add(2) // Like va commentlarni o'chirish uchun 2 ta operatsiya
 
 		go func(tweet *proto.Tweet) {
 			defer wgTweets.Done()
 			if utils.InSlice(tweet.Likes, int32(blockedID)) {
-				_, err := likesConn.DeleteLikeTweet(ctx, &proto.DeleteLikeTweetRequest{
+				_, err := likesConn.DeleteLikeTweet(principal.AsCaller(ctx, int64(blockedID)), &proto.DeleteLikeTweetRequest{
 					TweetId: tweet.Id,
 					UserId:  int32(blockedID),
 				})
 				if err != nil {
-					fmt.Printf("error removing like: %v\n", err)
+					slog.ErrorContext(ctx, "failed to remove like", "error", err)
 				}
 			}
 		}(tweet)
@@ -743,12 +804,12 @@ func (s *UserService) BlockUser(ctx context.Context, id int64, blockedID int64)
 		go func(tweet *proto.Tweet) {
 			defer wgTweets.Done()
 			if utils.InSlice(tweet.Comments, int32(blockedID)) {
-				_, err := commentsConn.DeleteComment(ctx, &proto.DeleteCommentRequest{
+				_, err := commentsConn.DeleteComment(principal.AsCaller(ctx, int64(blockedID)), &proto.DeleteCommentRequest{
 					TweetId: int64(tweet.Id),
 					UserId:  int64(blockedID),
 				})
 				if err != nil {
-					fmt.Printf("error removing comment: %v\n", err)
+					slog.ErrorContext(ctx, "failed to remove comment", "error", err)
 				}
 			}
 		}(tweet)
@@ -756,7 +817,7 @@ func (s *UserService) BlockUser(ctx context.Context, id int64, blockedID int64)
 
 	wgTweets.Wait() 
 
-	for _, tweet := range tweets.Tweets {
+	for _, tweet := range tweets {
 		var wgComments sync.WaitGroup
 
 		for _, com := range tweet.Comments {
@@ -768,17 +829,17 @@ func (s *UserService) BlockUser(ctx context.Context, id int64, blockedID int64)
 					UserId: int64(id),
 				})
 				if err != nil {
-					fmt.Printf("error getting comment: %v\n", err)
+					slog.ErrorContext(ctx, "failed to get comment", "error", err)
 					return
 				}
 				if comment.Comment.UserId == id {
 					if utils.InSliceInt64(comment.Comment.Likes, int64(blockedID)) {
-						_, err := likesConn.DeleteLikeComment(ctx, &proto.DeleteLikeCommentRequest{
+						_, err := likesConn.DeleteLikeComment(principal.AsCaller(ctx, int64(blockedID)), &proto.DeleteLikeCommentRequest{
 							CommentId: int32(comment.Comment.Id),
 							UserId:    int32(blockedID),
 						})
 						if err != nil {
-							fmt.Printf("error removing comment like: %v\n", err)
+							slog.ErrorContext(ctx, "failed to remove comment like", "error", err)
 						}
 					}
 				}
@@ -787,25 +848,23 @@ func (s *UserService) BlockUser(ctx context.Context, id int64, blockedID int64)
 		wgComments.Wait() 
 	}
 
-	tweetsBlocked, err := tweetsConn.GetTweetsByUser(ctx, &proto.GetTweetsByUserRequest{
-		UserId: int32(blockedID),
-	})
+	tweetsBlocked, err := allTweetsByUser(ctx, tweetsConn, int32(blockedID))
 	if err != nil {
 		return fmt.Errorf("error getting tweets: %v", err)
 	}
 
-	for _, tweet := range tweetsBlocked.Tweets {
+	for _, tweet := range tweetsBlocked {
 		wgTweets.Add(2) 
 
 		go func(tweet *proto.Tweet) {
 			defer wgTweets.Done()
 			if utils.InSlice(tweet.Likes, int32(id)) {
-				_, err := likesConn.DeleteLikeTweet(ctx, &proto.DeleteLikeTweetRequest{
+				_, err := likesConn.DeleteLikeTweet(principal.AsCaller(ctx, int64(id)), &proto.DeleteLikeTweetRequest{
 					TweetId: tweet.Id,
 					UserId:  int32(id),
 				})
 				if err != nil {
-					fmt.Printf("error removing like: %v\n", err)
+					slog.ErrorContext(ctx, "failed to remove like", "error", err)
 				}
 			}
 		}(tweet)
@@ -813,12 +872,12 @@ func (s *UserService) BlockUser(ctx context.Context, id int64, blockedID int64)
 		go func(tweet *proto.Tweet) {
 			defer wgTweets.Done()
 			if utils.InSlice(tweet.Comments, int32(id)) {
-				_, err := commentsConn.DeleteComment(ctx, &proto.DeleteCommentRequest{
+				_, err := commentsConn.DeleteComment(principal.AsCaller(ctx, int64(id)), &proto.DeleteCommentRequest{
 					TweetId: int64(tweet.Id),
 					UserId:  int64(id),
 				})
 				if err != nil {
-					fmt.Printf("error removing comment: %v\n", err)
+					slog.ErrorContext(ctx, "failed to remove comment", "error", err)
 				}
 			}
 		}(tweet)
@@ -847,10 +906,10 @@ func (s *UserService) UnblockUser(ctx context.Context, id int64, blockedID int64
 		&user.CreatedAt,
 		&user.Bio,
 		&user.IsPrivate,
-		&user.Followers,
-		&user.Following,
-		&user.Tweets,
-		&user.BlockedUsers,
+		pq.Array(&user.Followers),
+		pq.Array(&user.Following),
+		pq.Array(&user.Tweets),
+		pq.Array(&user.BlockedUsers),
 	)
 	if err != nil {
 		return fmt.Errorf("error unblocking user: %v", err)
@@ -865,3 +924,20 @@ func (s *UserService) UnblockUser(ctx context.Context, id int64, blockedID int64
 	}
 	return nil
 }
+
+// allTweetsByUser GetTweetsByUser sahifalarini next_page_token tugaguncha yig'adi
+func allTweetsByUser(ctx context.Context, client proto.TweetServiceClient, userID int32) ([]*proto.Tweet, error) {
+	var tweets []*proto.Tweet
+	req := &proto.GetTweetsByUserRequest{UserId: userID, PageSize: 100}
+	for {
+		resp, err := client.GetTweetsByUser(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		tweets = append(tweets, resp.Tweets...)
+		if resp.NextPageToken == "" {
+			return tweets, nil
+		}
+		req.PageToken = resp.NextPageToken
+	}
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"user-service/utils"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Sessiyalar Redisda saqlanadi:
+//
+//	session:<jti>          -> hash {user_id, username, refresh_hash}
+//	user_sessions:<userID> -> set {jti...}
+//
+// Refresh token "<jti>.<secret>" ko'rinishida, Redisda faqat secret hashi turadi.
+
+type Session struct {
+	ID           string
+	UserID       int64
+	Username     string
+	RefreshToken string
+}
+
+func sessionKey(sessionID string) string {
+	return "session:" + sessionID
+}
+
+func userSessionsKey(userID int64) string {
+	return "user_sessions:" + strconv.FormatInt(userID, 10)
+}
+
+func (s *UserService) CreateSession(ctx context.Context, userID int64, username string) (Session, error) {
+	sessionID, err := utils.GenerateToken(16)
+	if err != nil {
+		return Session{}, fmt.Errorf("error generating session id: %v", err)
+	}
+	secret, err := utils.GenerateToken(32)
+	if err != nil {
+		return Session{}, fmt.Errorf("error generating refresh token: %v", err)
+	}
+
+	pipe := s.RedisClient.TxPipeline()
+	pipe.HSet(ctx, sessionKey(sessionID), map[string]interface{}{
+		"user_id":      userID,
+		"username":     username,
+		"refresh_hash": utils.HashToken(secret),
+	})
+	pipe.Expire(ctx, sessionKey(sessionID), utils.RefreshTokenTTL)
+	pipe.SAdd(ctx, userSessionsKey(userID), sessionID)
+	pipe.Expire(ctx, userSessionsKey(userID), utils.RefreshTokenTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return Session{}, fmt.Errorf("error creating session: %v", err)
+	}
+
+	return Session{
+		ID:           sessionID,
+		UserID:       userID,
+		Username:     username,
+		RefreshToken: sessionID + "." + secret,
+	}, nil
+}
+
+// rotateScript refresh token hashini solishtiradi va almashtiradi. Ikkala qadam
+// bitta skriptda bajariladi, shuning uchun bir xil token bilan parallel kelgan
+// ikki so'rovdan faqat bittasi yangi token oladi, ikkinchisi qayta ishlatish
+// deb topilib sessiya bekor qilinadi.
+//
+// KEYS[1] - session:<jti>, KEYS[2] - user_sessions:<userID>
+// ARGV[1] - jti, ARGV[2] - eski hash, ARGV[3] - yangi hash, ARGV[4] - TTL (ms).
+// Qaytaradi: {1, username} almashtirildi, {0} sessiya yo'q, {-1} token qayta ishlatildi
+var rotateScript = redis.NewScript(`
+local values = redis.call('HMGET', KEYS[1], 'username', 'refresh_hash')
+if not values[2] then
+	return {0}
+end
+if values[2] ~= ARGV[2] then
+	redis.call('DEL', KEYS[1])
+	redis.call('SREM', KEYS[2], ARGV[1])
+	return {-1}
+end
+redis.call('HSET', KEYS[1], 'refresh_hash', ARGV[3])
+redis.call('PEXPIRE', KEYS[1], ARGV[4])
+redis.call('PEXPIRE', KEYS[2], ARGV[4])
+return {1, values[1]}
+`)
+
+// RotateSession refresh tokenni tekshiradi va uning o'rniga yangisini beradi.
+// Eski token qayta ishlatilsa sessiya butunlay bekor qilinadi.
+func (s *UserService) RotateSession(ctx context.Context, refreshToken string) (Session, error) {
+	sessionID, secret, ok := strings.Cut(refreshToken, ".")
+	if !ok || sessionID == "" || secret == "" {
+		return Session{}, ErrInvalidRefreshToken
+	}
+
+	// user_id sessiya yaratilgandan keyin o'zgarmaydi, skriptga kalit sifatida kerak
+	owner, err := s.RedisClient.HGet(ctx, sessionKey(sessionID), "user_id").Result()
+	if err == redis.Nil {
+		return Session{}, ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return Session{}, fmt.Errorf("error getting session: %v", err)
+	}
+	userID, err := strconv.ParseInt(owner, 10, 64)
+	if err != nil {
+		return Session{}, ErrInvalidRefreshToken
+	}
+
+	newSecret, err := utils.GenerateToken(32)
+	if err != nil {
+		return Session{}, fmt.Errorf("error generating refresh token: %v", err)
+	}
+
+	keys := []string{sessionKey(sessionID), userSessionsKey(userID)}
+	result, err := rotateScript.Run(ctx, s.RedisClient, keys,
+		sessionID, utils.HashToken(secret), utils.HashToken(newSecret), utils.RefreshTokenTTL.Milliseconds()).Slice()
+	if err != nil {
+		return Session{}, fmt.Errorf("error rotating session: %v", err)
+	}
+	if rotated, _ := result[0].(int64); rotated != 1 {
+		return Session{}, ErrInvalidRefreshToken
+	}
+	username, _ := result[1].(string)
+
+	return Session{
+		ID:           sessionID,
+		UserID:       userID,
+		Username:     username,
+		RefreshToken: sessionID + "." + newSecret,
+	}, nil
+}
+
+func (s *UserService) RevokeSession(ctx context.Context, userID int64, sessionID string) error {
+	owner, err := s.RedisClient.HGet(ctx, sessionKey(sessionID), "user_id").Result()
+	if err == redis.Nil {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting session: %v", err)
+	}
+	if owner != strconv.FormatInt(userID, 10) {
+		return ErrSessionNotOwned
+	}
+
+	pipe := s.RedisClient.TxPipeline()
+	pipe.Del(ctx, sessionKey(sessionID))
+	pipe.SRem(ctx, userSessionsKey(userID), sessionID)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("error revoking session: %v", err)
+	}
+	return nil
+}
+
+func (s *UserService) RevokeAllSessions(ctx context.Context, userID int64) (int64, error) {
+	sessionIDs, err := s.RedisClient.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return 0, fmt.Errorf("error getting sessions: %v", err)
+	}
+
+	keys := make([]string, 0, len(sessionIDs)+1)
+	for _, id := range sessionIDs {
+		keys = append(keys, sessionKey(id))
+	}
+	keys = append(keys, userSessionsKey(userID))
+
+	if _, err := s.RedisClient.Del(ctx, keys...).Result(); err != nil {
+		return 0, fmt.Errorf("error revoking sessions: %v", err)
+	}
+	return int64(len(sessionIDs)), nil
+}
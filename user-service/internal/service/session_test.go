@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+)
+
+func newSessionService(t *testing.T) (*UserService, *miniredis.Miniredis) {
+	t.Helper()
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+	return NewUserService(nil, client, nil), server
+}
+
+func TestRotateSession(t *testing.T) {
+	s, server := newSessionService(t)
+	ctx := context.Background()
+
+	session, err := s.CreateSession(ctx, 7, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rotated, err := s.RotateSession(ctx, session.RefreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rotated.ID != session.ID || rotated.UserID != 7 || rotated.Username != "alice" || rotated.RefreshToken == session.RefreshToken {
+		t.Fatalf("rotated session %+v from %+v", rotated, session)
+	}
+
+	// eski token qayta ishlatilsa butun sessiya bekor qilinadi
+	if _, err := s.RotateSession(ctx, session.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Fatalf("reuse: err = %v, want %v", err, ErrInvalidRefreshToken)
+	}
+	if _, err := s.RotateSession(ctx, rotated.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("rotate after reuse: err = %v, want %v", err, ErrInvalidRefreshToken)
+	}
+	if server.Exists(sessionKey(session.ID)) {
+		t.Error("session still exists after reuse")
+	}
+	if ok, _ := server.SIsMember(userSessionsKey(7), session.ID); ok {
+		t.Error("session is still listed for the user after reuse")
+	}
+
+	for _, token := range []string{"", "no-dot", session.ID + ".", "unknown.secret"} {
+		if _, err := s.RotateSession(ctx, token); !errors.Is(err, ErrInvalidRefreshToken) {
+			t.Errorf("RotateSession(%q): err = %v, want %v", token, err, ErrInvalidRefreshToken)
+		}
+	}
+}
+
+func TestRotateSessionConcurrently(t *testing.T) {
+	s, _ := newSessionService(t)
+	ctx := context.Background()
+
+	session, err := s.CreateSession(ctx, 7, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const workers = 20
+	var wg sync.WaitGroup
+	results := make(chan error, workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.RotateSession(ctx, session.RefreshToken)
+			results <- err
+		}()
+	}
+	wg.Wait()
+	close(results)
+
+	var rotated int
+	for err := range results {
+		switch {
+		case err == nil:
+			rotated++
+		case !errors.Is(err, ErrInvalidRefreshToken):
+			t.Errorf("unexpected error %v", err)
+		}
+	}
+	if rotated != 1 {
+		t.Errorf("%d concurrent refreshes succeeded, want exactly 1", rotated)
+	}
+}
+
+func TestRevokeSession(t *testing.T) {
+	s, server := newSessionService(t)
+	ctx := context.Background()
+
+	first, err := s.CreateSession(ctx, 7, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := s.CreateSession(ctx, 7, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.RevokeSession(ctx, 8, first.ID); !errors.Is(err, ErrSessionNotOwned) {
+		t.Errorf("revoke another user's session: err = %v, want %v", err, ErrSessionNotOwned)
+	}
+	if err := s.RevokeSession(ctx, 7, first.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.RotateSession(ctx, first.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("rotate revoked session: err = %v, want %v", err, ErrInvalidRefreshToken)
+	}
+
+	revoked, err := s.RevokeAllSessions(ctx, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revoked != 1 || server.Exists(sessionKey(second.ID)) {
+		t.Errorf("RevokeAllSessions revoked %d sessions, want the remaining one", revoked)
+	}
+}
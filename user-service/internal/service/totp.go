@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
+	"user-service/utils"
+)
+
+const (
+	LoginChallengeTTL      = 5 * time.Minute
+	loginChallengeAttempts = 5
+	recoveryCodeCount      = 10
+)
+
+type TOTPEnrollment struct {
+	Secret          string
+	ProvisioningURI string
+	RecoveryCodes   []string
+}
+
+func loginChallengeKey(token string) string {
+	return "login_challenge:" + token
+}
+
+// totpAAD shifrlangan secretni uning egasiga bog'laydi
+func totpAAD(userID int64) []byte {
+	return []byte("user_totp:" + strconv.FormatInt(userID, 10))
+}
+
+// EncryptTOTPSecrets shifrlash qo'shilishidan oldin ochiq saqlangan secretlarni
+// shifrlaydi. Servis ishga tushganda, so'rovlar qabul qilinishidan oldin chaqiriladi.
+func (s *UserService) EncryptTOTPSecrets(ctx context.Context) (int, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT user_id, secret FROM user_totp WHERE secret NOT LIKE 'v1:%'")
+	if err != nil {
+		return 0, fmt.Errorf("error getting totp secrets: %v", err)
+	}
+	type plainSecret struct {
+		userID int64
+		secret string
+	}
+	var plain []plainSecret
+	for rows.Next() {
+		var p plainSecret
+		if err := rows.Scan(&p.userID, &p.secret); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("error scanning totp secret: %v", err)
+		}
+		plain = append(plain, p)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("error getting totp secrets: %v", err)
+	}
+
+	for i, p := range plain {
+		sealed, err := s.totpBox.Seal(p.secret, totpAAD(p.userID))
+		if err != nil {
+			return i, fmt.Errorf("error encrypting totp secret: %v", err)
+		}
+		// parallel ishga tushgan boshqa nusxa allaqachon shifrlagan bo'lsa tegmaymiz
+		_, err = s.db.ExecContext(ctx, "UPDATE user_totp SET secret = $1 WHERE user_id = $2 AND secret = $3", sealed, p.userID, p.secret)
+		if err != nil {
+			return i, fmt.Errorf("error saving totp secret: %v", err)
+		}
+	}
+	return len(plain), nil
+}
+
+// EnrollTOTP yangi secret va recovery kodlar yaratadi. 2FA ConfirmTOTP dan keyin yoqiladi.
+func (s *UserService) EnrollTOTP(ctx context.Context, userID int64, account string) (TOTPEnrollment, error) {
+	enabled, err := s.TOTPEnabled(ctx, userID)
+	if err != nil {
+		return TOTPEnrollment{}, err
+	}
+	if enabled {
+		return TOTPEnrollment{}, ErrTOTPAlreadyEnabled
+	}
+
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		return TOTPEnrollment{}, fmt.Errorf("error generating totp secret: %v", err)
+	}
+	codes, err := utils.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return TOTPEnrollment{}, fmt.Errorf("error generating recovery codes: %v", err)
+	}
+
+	sealed, err := s.totpBox.Seal(secret, totpAAD(userID))
+	if err != nil {
+		return TOTPEnrollment{}, fmt.Errorf("error encrypting totp secret: %v", err)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return TOTPEnrollment{}, err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO user_totp (user_id, secret, enabled) VALUES ($1, $2, FALSE)
+		ON CONFLICT (user_id) DO UPDATE SET secret = EXCLUDED.secret, enabled = FALSE, created_at = CURRENT_TIMESTAMP, enabled_at = NULL`
+	if _, err := tx.ExecContext(ctx, query, userID, sealed); err != nil {
+		return TOTPEnrollment{}, fmt.Errorf("error saving totp secret: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM recovery_codes WHERE user_id = $1", userID); err != nil {
+		return TOTPEnrollment{}, fmt.Errorf("error deleting recovery codes: %v", err)
+	}
+	for _, code := range codes {
+		_, err := tx.ExecContext(ctx, "INSERT INTO recovery_codes (user_id, code_hash) VALUES ($1, $2)", userID, utils.HashToken(code))
+		if err != nil {
+			return TOTPEnrollment{}, fmt.Errorf("error saving recovery code: %v", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return TOTPEnrollment{}, err
+	}
+
+	return TOTPEnrollment{
+		Secret:          secret,
+		ProvisioningURI: utils.TOTPProvisioningURI(secret, account),
+		RecoveryCodes:   codes,
+	}, nil
+}
+
+// ConfirmTOTP birinchi kod bilan 2FA ni yoqadi
+func (s *UserService) ConfirmTOTP(ctx context.Context, userID int64, code string) error {
+	var sealed string
+	err := s.db.QueryRowContext(ctx, "SELECT secret FROM user_totp WHERE user_id = $1", userID).Scan(&sealed)
+	if err == sql.ErrNoRows {
+		return ErrTOTPNotEnrolled
+	}
+	if err != nil {
+		return fmt.Errorf("error getting totp secret: %v", err)
+	}
+	secret, err := s.totpBox.Open(sealed, totpAAD(userID))
+	if err != nil {
+		return fmt.Errorf("error decrypting totp secret: %v", err)
+	}
+
+	if err := s.checkTOTP(ctx, userID, secret, code); err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, "UPDATE user_totp SET enabled = TRUE, enabled_at = CURRENT_TIMESTAMP WHERE user_id = $1", userID)
+	if err != nil {
+		return fmt.Errorf("error enabling totp: %v", err)
+	}
+	return nil
+}
+
+// DisableTOTP TOTP yoki recovery kod bilan 2FA ni o'chiradi
+func (s *UserService) DisableTOTP(ctx context.Context, userID int64, code string) error {
+	if err := s.VerifySecondFactor(ctx, userID, code); err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_totp WHERE user_id = $1", userID); err != nil {
+		return fmt.Errorf("error disabling totp: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM recovery_codes WHERE user_id = $1", userID); err != nil {
+		return fmt.Errorf("error deleting recovery codes: %v", err)
+	}
+	return tx.Commit()
+}
+
+func (s *UserService) TOTPEnabled(ctx context.Context, userID int64) (bool, error) {
+	var enabled bool
+	err := s.db.QueryRowContext(ctx, "SELECT enabled FROM user_totp WHERE user_id = $1", userID).Scan(&enabled)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("error getting totp status: %v", err)
+	}
+	return enabled, nil
+}
+
+// VerifySecondFactor yoqilgan 2FA uchun TOTP kodni, u mos kelmasa recovery kodni tekshiradi
+func (s *UserService) VerifySecondFactor(ctx context.Context, userID int64, code string) error {
+	var sealed string
+	err := s.db.QueryRowContext(ctx, "SELECT secret FROM user_totp WHERE user_id = $1 AND enabled", userID).Scan(&sealed)
+	if err == sql.ErrNoRows {
+		return ErrTOTPNotEnabled
+	}
+	if err != nil {
+		return fmt.Errorf("error getting totp secret: %v", err)
+	}
+	secret, err := s.totpBox.Open(sealed, totpAAD(userID))
+	if err != nil {
+		return fmt.Errorf("error decrypting totp secret: %v", err)
+	}
+
+	if err := s.checkTOTP(ctx, userID, secret, code); err == nil {
+		return nil
+	}
+
+	result, err := s.db.ExecContext(ctx,
+		"UPDATE recovery_codes SET used_at = CURRENT_TIMESTAMP WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL",
+		userID, utils.HashToken(code))
+	if err != nil {
+		return fmt.Errorf("error using recovery code: %v", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return ErrInvalidTOTPCode
+	}
+	return nil
+}
+
+// checkTOTP kodni tekshiradi va bir qadamdagi kodni ikki marta ishlatishga yo'l qo'ymaydi
+func (s *UserService) checkTOTP(ctx context.Context, userID int64, secret, code string) error {
+	step, ok := utils.ValidateTOTP(secret, code, time.Now())
+	if !ok {
+		return ErrInvalidTOTPCode
+	}
+	key := "totp_used:" + strconv.FormatInt(userID, 10) + ":" + strconv.FormatInt(step, 10)
+	fresh, err := s.RedisClient.SetNX(ctx, key, 1, 3*utils.TOTPPeriod*time.Second).Result()
+	if err != nil {
+		return fmt.Errorf("error checking totp replay: %v", err)
+	}
+	if !fresh {
+		return ErrInvalidTOTPCode
+	}
+	return nil
+}
+
+// CreateLoginChallenge parol tekshirilgandan keyin beriladigan qisqa muddatli token
+func (s *UserService) CreateLoginChallenge(ctx context.Context, userID int64) (string, error) {
+	token, err := utils.GenerateToken(32)
+	if err != nil {
+		return "", fmt.Errorf("error generating challenge: %v", err)
+	}
+
+	pipe := s.RedisClient.TxPipeline()
+	pipe.HSet(ctx, loginChallengeKey(token), "user_id", userID, "attempts", 0)
+	pipe.Expire(ctx, loginChallengeKey(token), LoginChallengeTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return "", fmt.Errorf("error creating challenge: %v", err)
+	}
+	return token, nil
+}
+
+// CompleteLoginChallenge kodni tekshiradi va muvaffaqiyatli bo'lsa challenge ni o'chirib user ID ni qaytaradi
+func (s *UserService) CompleteLoginChallenge(ctx context.Context, token, code string) (int64, error) {
+	key := loginChallengeKey(token)
+	userIDStr, err := s.RedisClient.HGet(ctx, key, "user_id").Result()
+	if err != nil {
+		return 0, ErrInvalidChallenge
+	}
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidChallenge
+	}
+
+	attempts, err := s.RedisClient.HIncrBy(ctx, key, "attempts", 1).Result()
+	if err != nil {
+		return 0, fmt.Errorf("error updating challenge: %v", err)
+	}
+	if attempts > loginChallengeAttempts {
+		s.RedisClient.Del(ctx, key)
+		return 0, ErrInvalidChallenge
+	}
+
+	if err := s.VerifySecondFactor(ctx, userID, code); err != nil {
+		return 0, err
+	}
+
+	// challenge bir marta ishlatiladi
+	deleted, err := s.RedisClient.Del(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("error deleting challenge: %v", err)
+	}
+	if deleted == 0 {
+		return 0, ErrInvalidChallenge
+	}
+	return userID, nil
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql/driver"
+	"encoding/base64"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+	"user-service/utils"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+)
+
+// sealedArg bazaga yozilgan shifrlangan secretni ushlab qoladi
+type sealedArg struct {
+	value string
+}
+
+func (a *sealedArg) Match(v driver.Value) bool {
+	s, ok := v.(string)
+	a.value = s
+	return ok && utils.IsSealed(s)
+}
+
+func newTOTPService(t *testing.T) (*UserService, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatal(err)
+	}
+	box, err := utils.NewSecretBox(base64.StdEncoding.EncodeToString(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewUserService(db, client, box), mock
+}
+
+func currentCode(t *testing.T, secret string) string {
+	t.Helper()
+	code, err := utils.TOTPCode(secret, time.Now().Unix()/utils.TOTPPeriod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
+
+func expectSecret(mock sqlmock.Sqlmock, query, sealed string) {
+	mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"secret"}).AddRow(sealed))
+}
+
+// enroll secretni ro'yxatdan o'tkazadi va bazaga yozilgan shifrlangan qiymatni qaytaradi
+func enroll(t *testing.T, s *UserService, mock sqlmock.Sqlmock) (TOTPEnrollment, string) {
+	t.Helper()
+	stored := &sealedArg{}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT enabled FROM user_totp")).WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"enabled"}))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO user_totp")).WithArgs(7, stored).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM recovery_codes")).WithArgs(7).WillReturnResult(sqlmock.NewResult(0, 0))
+	for i := 0; i < recoveryCodeCount; i++ {
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO recovery_codes")).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectCommit()
+
+	enrollment, err := s.EnrollTOTP(context.Background(), 7, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+	return enrollment, stored.value
+}
+
+func TestEnrollTOTPStoresEncryptedSecret(t *testing.T) {
+	s, mock := newTOTPService(t)
+	enrollment, sealed := enroll(t, s, mock)
+
+	if sealed == enrollment.Secret || len(enrollment.RecoveryCodes) != recoveryCodeCount {
+		t.Fatalf("stored secret %q for enrollment %+v", sealed, enrollment)
+	}
+	if secret, err := s.totpBox.Open(sealed, totpAAD(7)); err != nil || secret != enrollment.Secret {
+		t.Fatalf("stored secret opens to %q, %v", secret, err)
+	}
+	// secret boshqa foydalanuvchi qatoriga ko'chirilsa ishlamaydi
+	if _, err := s.totpBox.Open(sealed, totpAAD(8)); err == nil {
+		t.Error("secret opened for another user")
+	}
+}
+
+func TestConfirmTOTPRejectsReplay(t *testing.T) {
+	s, mock := newTOTPService(t)
+	ctx := context.Background()
+	enrollment, sealed := enroll(t, s, mock)
+	code := currentCode(t, enrollment.Secret)
+
+	expectSecret(mock, "SELECT secret FROM user_totp WHERE user_id = $1", sealed)
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE user_totp SET enabled = TRUE")).WithArgs(7).WillReturnResult(sqlmock.NewResult(0, 1))
+	if err := s.ConfirmTOTP(ctx, 7, code); err != nil {
+		t.Fatal(err)
+	}
+
+	// xuddi shu kod ikkinchi marta qabul qilinmaydi, recovery kod ham emas
+	expectSecret(mock, "SELECT secret FROM user_totp WHERE user_id = $1 AND enabled", sealed)
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE recovery_codes")).WithArgs(7, utils.HashToken(code)).WillReturnResult(sqlmock.NewResult(0, 0))
+	if err := s.VerifySecondFactor(ctx, 7, code); !errors.Is(err, ErrInvalidTOTPCode) {
+		t.Fatalf("replayed code: err = %v, want %v", err, ErrInvalidTOTPCode)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestVerifySecondFactor(t *testing.T) {
+	s, mock := newTOTPService(t)
+	ctx := context.Background()
+	enrollment, sealed := enroll(t, s, mock)
+
+	expectSecret(mock, "SELECT secret FROM user_totp WHERE user_id = $1 AND enabled", sealed)
+	if err := s.VerifySecondFactor(ctx, 7, currentCode(t, enrollment.Secret)); err != nil {
+		t.Fatalf("totp code: %v", err)
+	}
+
+	recovery := enrollment.RecoveryCodes[0]
+	expectSecret(mock, "SELECT secret FROM user_totp WHERE user_id = $1 AND enabled", sealed)
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE recovery_codes")).WithArgs(7, utils.HashToken(recovery)).WillReturnResult(sqlmock.NewResult(0, 1))
+	if err := s.VerifySecondFactor(ctx, 7, recovery); err != nil {
+		t.Fatalf("recovery code: %v", err)
+	}
+
+	// ishlatilgan recovery kod
+	expectSecret(mock, "SELECT secret FROM user_totp WHERE user_id = $1 AND enabled", sealed)
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE recovery_codes")).WithArgs(7, utils.HashToken(recovery)).WillReturnResult(sqlmock.NewResult(0, 0))
+	if err := s.VerifySecondFactor(ctx, 7, recovery); !errors.Is(err, ErrInvalidTOTPCode) {
+		t.Fatalf("used recovery code: err = %v, want %v", err, ErrInvalidTOTPCode)
+	}
+
+	// boshqa foydalanuvchi qatoridan ko'chirilgan secret ochilmaydi
+	moved, err := s.totpBox.Seal(enrollment.Secret, totpAAD(8))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectSecret(mock, "SELECT secret FROM user_totp WHERE user_id = $1 AND enabled", moved)
+	if err := s.VerifySecondFactor(ctx, 7, currentCode(t, enrollment.Secret)); err == nil {
+		t.Error("secret sealed for another user accepted")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestEncryptTOTPSecrets(t *testing.T) {
+	s, mock := newTOTPService(t)
+	stored := &sealedArg{}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT user_id, secret FROM user_totp WHERE secret NOT LIKE 'v1:%'")).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "secret"}).AddRow(7, "JBSWY3DPEHPK3PXP"))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE user_totp SET secret = $1 WHERE user_id = $2 AND secret = $3")).
+		WithArgs(stored, 7, "JBSWY3DPEHPK3PXP").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	n, err := s.EncryptTOTPSecrets(context.Background())
+	if err != nil || n != 1 {
+		t.Fatalf("EncryptTOTPSecrets = %d, %v", n, err)
+	}
+	if secret, err := s.totpBox.Open(stored.value, totpAAD(7)); err != nil || secret != "JBSWY3DPEHPK3PXP" {
+		t.Errorf("encrypted secret opens to %q, %v", secret, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
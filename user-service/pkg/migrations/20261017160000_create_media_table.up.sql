@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS media (
+    id VARCHAR(64) PRIMARY KEY,
+    owner_id INT NOT NULL,
+    url TEXT NOT NULL,
+    mime_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    width INT NOT NULL DEFAULT 0,
+    height INT NOT NULL DEFAULT 0,
+    duration_ms BIGINT NOT NULL DEFAULT 0,
+    blurhash VARCHAR(100) NOT NULL DEFAULT '',
+    alt_text VARCHAR(1000) NOT NULL DEFAULT '',
+    renditions JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    attached_at TIMESTAMP,
+    FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_media_owner_id ON media (owner_id);
+CREATE INDEX IF NOT EXISTS idx_media_unattached ON media (created_at) WHERE attached_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS media_quotas (
+    user_id INT PRIMARY KEY,
+    quota_bytes BIGINT NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
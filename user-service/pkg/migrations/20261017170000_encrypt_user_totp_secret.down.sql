@@ -0,0 +1,5 @@
+-- Shifrlangan secretlar VARCHAR(64) ga sig'maydi va ularni SQL da ochib bo'lmaydi.
+-- Eski versiyaga qaytishdan oldin 2FA ni qayta ulash kerak, shuning uchun
+-- shifrlangan qatorlar o'chiriladi; ochiq (hali shifrlanmagan) qatorlar qoladi.
+DELETE FROM user_totp WHERE secret LIKE 'v1:%';
+ALTER TABLE user_totp ALTER COLUMN secret TYPE VARCHAR(64);
@@ -0,0 +1,3 @@
+-- shifrlangan secret (v1:base64(nonce|ciphertext|tag)) 64 belgidan uzun.
+-- Mavjud ochiq secretlarni servis ishga tushganda EncryptTOTPSecrets shifrlaydi.
+ALTER TABLE user_totp ALTER COLUMN secret TYPE TEXT;
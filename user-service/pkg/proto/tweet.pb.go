@@ -43,6 +43,8 @@ type Tweet struct {
 	SaveCount    int32                  `protobuf:"varint,15,opt,name=save_count,json=saveCount,proto3" json:"save_count,omitempty"`
 	IsSaved      bool                   `protobuf:"varint,16,opt,name=is_saved,json=isSaved,proto3" json:"is_saved,omitempty"`
 	IsLiked      bool                   `protobuf:"varint,17,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`
+	// Attached media; media holds their URLs.
+	Attachments []*TweetMedia `protobuf:"bytes,18,rep,name=attachments,proto3" json:"attachments,omitempty"`
 }
 
 func (x *Tweet) Reset() {
@@ -196,18 +198,140 @@ func (x *Tweet) GetIsLiked() bool {
 	return false
 }
 
+func (x *Tweet) GetAttachments() []*TweetMedia {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// TweetMedia is the media record of an attached file, see UserService.GetMedia.
+type TweetMedia struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url        string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	MimeType   string `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Width      int32  `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Height     int32  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	DurationMs int64  `protobuf:"varint,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Blurhash   string `protobuf:"bytes,7,opt,name=blurhash,proto3" json:"blurhash,omitempty"`
+	AltText    string `protobuf:"bytes,8,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	// Rendition name (e.g. "small" or "128") to URL.
+	Renditions map[string]string `protobuf:"bytes,9,rep,name=renditions,proto3" json:"renditions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *TweetMedia) Reset() {
+	*x = TweetMedia{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_tweet_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TweetMedia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TweetMedia) ProtoMessage() {}
+
+func (x *TweetMedia) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tweet_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TweetMedia.ProtoReflect.Descriptor instead.
+func (*TweetMedia) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TweetMedia) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TweetMedia) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *TweetMedia) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *TweetMedia) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *TweetMedia) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *TweetMedia) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *TweetMedia) GetBlurhash() string {
+	if x != nil {
+		return x.Blurhash
+	}
+	return ""
+}
+
+func (x *TweetMedia) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *TweetMedia) GetRenditions() map[string]string {
+	if x != nil {
+		return x.Renditions
+	}
+	return nil
+}
+
 type GetSavedTweetsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId    int32  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
 func (x *GetSavedTweetsRequest) Reset() {
 	*x = GetSavedTweetsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[1]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -220,7 +344,7 @@ func (x *GetSavedTweetsRequest) String() string {
 func (*GetSavedTweetsRequest) ProtoMessage() {}
 
 func (x *GetSavedTweetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[1]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -233,7 +357,7 @@ func (x *GetSavedTweetsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSavedTweetsRequest.ProtoReflect.Descriptor instead.
 func (*GetSavedTweetsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{1}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GetSavedTweetsRequest) GetUserId() int32 {
@@ -243,20 +367,35 @@ func (x *GetSavedTweetsRequest) GetUserId() int32 {
 	return 0
 }
 
+func (x *GetSavedTweetsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetSavedTweetsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type GetSavedTweetsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tweets  []*Tweet `protobuf:"bytes,1,rep,name=tweets,proto3" json:"tweets,omitempty"`
-	Success bool     `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	Message string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Tweets        []*Tweet `protobuf:"bytes,1,rep,name=tweets,proto3" json:"tweets,omitempty"`
+	Success       bool     `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	NextPageToken string   `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *GetSavedTweetsResponse) Reset() {
 	*x = GetSavedTweetsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[2]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -269,7 +408,7 @@ func (x *GetSavedTweetsResponse) String() string {
 func (*GetSavedTweetsResponse) ProtoMessage() {}
 
 func (x *GetSavedTweetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[2]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -282,7 +421,7 @@ func (x *GetSavedTweetsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSavedTweetsResponse.ProtoReflect.Descriptor instead.
 func (*GetSavedTweetsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{2}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetSavedTweetsResponse) GetTweets() []*Tweet {
@@ -306,18 +445,27 @@ func (x *GetSavedTweetsResponse) GetMessage() string {
 	return ""
 }
 
+func (x *GetSavedTweetsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetLikedTweetsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId    int32  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
 func (x *GetLikedTweetsRequest) Reset() {
 	*x = GetLikedTweetsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[3]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -330,7 +478,7 @@ func (x *GetLikedTweetsRequest) String() string {
 func (*GetLikedTweetsRequest) ProtoMessage() {}
 
 func (x *GetLikedTweetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[3]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,7 +491,7 @@ func (x *GetLikedTweetsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLikedTweetsRequest.ProtoReflect.Descriptor instead.
 func (*GetLikedTweetsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{3}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetLikedTweetsRequest) GetUserId() int32 {
@@ -353,20 +501,35 @@ func (x *GetLikedTweetsRequest) GetUserId() int32 {
 	return 0
 }
 
+func (x *GetLikedTweetsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetLikedTweetsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type GetLikedTweetsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tweets  []*Tweet `protobuf:"bytes,1,rep,name=tweets,proto3" json:"tweets,omitempty"`
-	Success bool     `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	Message string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Tweets        []*Tweet `protobuf:"bytes,1,rep,name=tweets,proto3" json:"tweets,omitempty"`
+	Success       bool     `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	NextPageToken string   `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *GetLikedTweetsResponse) Reset() {
 	*x = GetLikedTweetsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[4]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -379,7 +542,7 @@ func (x *GetLikedTweetsResponse) String() string {
 func (*GetLikedTweetsResponse) ProtoMessage() {}
 
 func (x *GetLikedTweetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[4]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -392,7 +555,7 @@ func (x *GetLikedTweetsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLikedTweetsResponse.ProtoReflect.Descriptor instead.
 func (*GetLikedTweetsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{4}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetLikedTweetsResponse) GetTweets() []*Tweet {
@@ -416,21 +579,30 @@ func (x *GetLikedTweetsResponse) GetMessage() string {
 	return ""
 }
 
+func (x *GetLikedTweetsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type CreateTweetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content  string   `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	UserId   int32    `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	UserId  int32  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Deprecated: Marked as deprecated in pkg/proto/tweet.proto.
 	Media    []string `protobuf:"bytes,3,rep,name=media,proto3" json:"media,omitempty"`
 	Username string   `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	MediaIds []string `protobuf:"bytes,5,rep,name=media_ids,json=mediaIds,proto3" json:"media_ids,omitempty"`
 }
 
 func (x *CreateTweetRequest) Reset() {
 	*x = CreateTweetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[5]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -443,7 +615,7 @@ func (x *CreateTweetRequest) String() string {
 func (*CreateTweetRequest) ProtoMessage() {}
 
 func (x *CreateTweetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[5]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -456,7 +628,7 @@ func (x *CreateTweetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTweetRequest.ProtoReflect.Descriptor instead.
 func (*CreateTweetRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{5}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateTweetRequest) GetContent() string {
@@ -473,6 +645,7 @@ func (x *CreateTweetRequest) GetUserId() int32 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in pkg/proto/tweet.proto.
 func (x *CreateTweetRequest) GetMedia() []string {
 	if x != nil {
 		return x.Media
@@ -487,6 +660,13 @@ func (x *CreateTweetRequest) GetUsername() string {
 	return ""
 }
 
+func (x *CreateTweetRequest) GetMediaIds() []string {
+	if x != nil {
+		return x.MediaIds
+	}
+	return nil
+}
+
 type CreateTweetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -499,7 +679,7 @@ type CreateTweetResponse struct {
 func (x *CreateTweetResponse) Reset() {
 	*x = CreateTweetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[6]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -512,7 +692,7 @@ func (x *CreateTweetResponse) String() string {
 func (*CreateTweetResponse) ProtoMessage() {}
 
 func (x *CreateTweetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[6]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,7 +705,7 @@ func (x *CreateTweetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTweetResponse.ProtoReflect.Descriptor instead.
 func (*CreateTweetResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{6}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateTweetResponse) GetSuccess() bool {
@@ -547,14 +727,17 @@ type GetTweetsByUserRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId      int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserIdToGet int32 `protobuf:"varint,2,opt,name=user_id_to_get,json=userIdToGet,proto3" json:"user_id_to_get,omitempty"`
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Caller; 0 skips the block and privacy checks for internal lookups.
+	ViewerId  int32  `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	PageSize  int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
 func (x *GetTweetsByUserRequest) Reset() {
 	*x = GetTweetsByUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[7]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -567,7 +750,7 @@ func (x *GetTweetsByUserRequest) String() string {
 func (*GetTweetsByUserRequest) ProtoMessage() {}
 
 func (x *GetTweetsByUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[7]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -580,7 +763,7 @@ func (x *GetTweetsByUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTweetsByUserRequest.ProtoReflect.Descriptor instead.
 func (*GetTweetsByUserRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{7}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetTweetsByUserRequest) GetUserId() int32 {
@@ -590,27 +773,42 @@ func (x *GetTweetsByUserRequest) GetUserId() int32 {
 	return 0
 }
 
-func (x *GetTweetsByUserRequest) GetUserIdToGet() int32 {
+func (x *GetTweetsByUserRequest) GetViewerId() int32 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *GetTweetsByUserRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.UserIdToGet
+		return x.PageSize
 	}
 	return 0
 }
 
+func (x *GetTweetsByUserRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type GetTweetsByUserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tweets  []*Tweet `protobuf:"bytes,1,rep,name=tweets,proto3" json:"tweets,omitempty"`
-	Success bool     `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	Message string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Tweets        []*Tweet `protobuf:"bytes,1,rep,name=tweets,proto3" json:"tweets,omitempty"`
+	Success       bool     `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	NextPageToken string   `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *GetTweetsByUserResponse) Reset() {
 	*x = GetTweetsByUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[8]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -623,7 +821,7 @@ func (x *GetTweetsByUserResponse) String() string {
 func (*GetTweetsByUserResponse) ProtoMessage() {}
 
 func (x *GetTweetsByUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[8]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -636,7 +834,7 @@ func (x *GetTweetsByUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTweetsByUserResponse.ProtoReflect.Descriptor instead.
 func (*GetTweetsByUserResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{8}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetTweetsByUserResponse) GetTweets() []*Tweet {
@@ -660,6 +858,13 @@ func (x *GetTweetsByUserResponse) GetMessage() string {
 	return ""
 }
 
+func (x *GetTweetsByUserResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type UpdateTweetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -672,7 +877,7 @@ type UpdateTweetRequest struct {
 func (x *UpdateTweetRequest) Reset() {
 	*x = UpdateTweetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[9]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -685,7 +890,7 @@ func (x *UpdateTweetRequest) String() string {
 func (*UpdateTweetRequest) ProtoMessage() {}
 
 func (x *UpdateTweetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[9]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,7 +903,7 @@ func (x *UpdateTweetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTweetRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTweetRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{9}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateTweetRequest) GetTweetId() int32 {
@@ -727,7 +932,7 @@ type UpdateTweetResponse struct {
 func (x *UpdateTweetResponse) Reset() {
 	*x = UpdateTweetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[10]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -740,7 +945,7 @@ func (x *UpdateTweetResponse) String() string {
 func (*UpdateTweetResponse) ProtoMessage() {}
 
 func (x *UpdateTweetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[10]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -753,7 +958,7 @@ func (x *UpdateTweetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTweetResponse.ProtoReflect.Descriptor instead.
 func (*UpdateTweetResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{10}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateTweetResponse) GetSuccess() bool {
@@ -782,7 +987,7 @@ type AddLikeRequest struct {
 func (x *AddLikeRequest) Reset() {
 	*x = AddLikeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[11]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -795,7 +1000,7 @@ func (x *AddLikeRequest) String() string {
 func (*AddLikeRequest) ProtoMessage() {}
 
 func (x *AddLikeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[11]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -808,7 +1013,7 @@ func (x *AddLikeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddLikeRequest.ProtoReflect.Descriptor instead.
 func (*AddLikeRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{11}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AddLikeRequest) GetTweetId() int32 {
@@ -837,7 +1042,7 @@ type AddLikeResponse struct {
 func (x *AddLikeResponse) Reset() {
 	*x = AddLikeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[12]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -850,7 +1055,7 @@ func (x *AddLikeResponse) String() string {
 func (*AddLikeResponse) ProtoMessage() {}
 
 func (x *AddLikeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[12]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -863,7 +1068,7 @@ func (x *AddLikeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddLikeResponse.ProtoReflect.Descriptor instead.
 func (*AddLikeResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{12}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AddLikeResponse) GetSuccess() bool {
@@ -892,7 +1097,7 @@ type RemoveLikeRequest struct {
 func (x *RemoveLikeRequest) Reset() {
 	*x = RemoveLikeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[13]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -905,7 +1110,7 @@ func (x *RemoveLikeRequest) String() string {
 func (*RemoveLikeRequest) ProtoMessage() {}
 
 func (x *RemoveLikeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[13]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -918,7 +1123,7 @@ func (x *RemoveLikeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveLikeRequest.ProtoReflect.Descriptor instead.
 func (*RemoveLikeRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{13}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RemoveLikeRequest) GetTweetId() int32 {
@@ -947,7 +1152,7 @@ type RemoveLikeResponse struct {
 func (x *RemoveLikeResponse) Reset() {
 	*x = RemoveLikeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[14]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -960,7 +1165,7 @@ func (x *RemoveLikeResponse) String() string {
 func (*RemoveLikeResponse) ProtoMessage() {}
 
 func (x *RemoveLikeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[14]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -973,7 +1178,7 @@ func (x *RemoveLikeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveLikeResponse.ProtoReflect.Descriptor instead.
 func (*RemoveLikeResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{14}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *RemoveLikeResponse) GetSuccess() bool {
@@ -1003,7 +1208,7 @@ type AddCommentRequest struct {
 func (x *AddCommentRequest) Reset() {
 	*x = AddCommentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[15]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1016,7 +1221,7 @@ func (x *AddCommentRequest) String() string {
 func (*AddCommentRequest) ProtoMessage() {}
 
 func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[15]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1029,7 +1234,7 @@ func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddCommentRequest.ProtoReflect.Descriptor instead.
 func (*AddCommentRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{15}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *AddCommentRequest) GetTweetId() int32 {
@@ -1065,7 +1270,7 @@ type AddCommentResponse struct {
 func (x *AddCommentResponse) Reset() {
 	*x = AddCommentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[16]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1078,7 +1283,7 @@ func (x *AddCommentResponse) String() string {
 func (*AddCommentResponse) ProtoMessage() {}
 
 func (x *AddCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[16]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1091,7 +1296,7 @@ func (x *AddCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddCommentResponse.ProtoReflect.Descriptor instead.
 func (*AddCommentResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{16}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *AddCommentResponse) GetSuccess() bool {
@@ -1121,7 +1326,7 @@ type RemoveCommentRequest struct {
 func (x *RemoveCommentRequest) Reset() {
 	*x = RemoveCommentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[17]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1134,7 +1339,7 @@ func (x *RemoveCommentRequest) String() string {
 func (*RemoveCommentRequest) ProtoMessage() {}
 
 func (x *RemoveCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[17]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,7 +1352,7 @@ func (x *RemoveCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveCommentRequest.ProtoReflect.Descriptor instead.
 func (*RemoveCommentRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{17}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *RemoveCommentRequest) GetTweetId() int32 {
@@ -1183,7 +1388,7 @@ type RemoveCommentResponse struct {
 func (x *RemoveCommentResponse) Reset() {
 	*x = RemoveCommentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[18]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1196,7 +1401,7 @@ func (x *RemoveCommentResponse) String() string {
 func (*RemoveCommentResponse) ProtoMessage() {}
 
 func (x *RemoveCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[18]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1209,7 +1414,7 @@ func (x *RemoveCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveCommentResponse.ProtoReflect.Descriptor instead.
 func (*RemoveCommentResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{18}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *RemoveCommentResponse) GetSuccess() bool {
@@ -1238,7 +1443,7 @@ type AddShareRequest struct {
 func (x *AddShareRequest) Reset() {
 	*x = AddShareRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[19]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1251,7 +1456,7 @@ func (x *AddShareRequest) String() string {
 func (*AddShareRequest) ProtoMessage() {}
 
 func (x *AddShareRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[19]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1264,7 +1469,7 @@ func (x *AddShareRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddShareRequest.ProtoReflect.Descriptor instead.
 func (*AddShareRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{19}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *AddShareRequest) GetTweetId() int32 {
@@ -1293,7 +1498,7 @@ type AddShareResponse struct {
 func (x *AddShareResponse) Reset() {
 	*x = AddShareResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[20]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1306,7 +1511,7 @@ func (x *AddShareResponse) String() string {
 func (*AddShareResponse) ProtoMessage() {}
 
 func (x *AddShareResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[20]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1319,7 +1524,7 @@ func (x *AddShareResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddShareResponse.ProtoReflect.Descriptor instead.
 func (*AddShareResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{20}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *AddShareResponse) GetSuccess() bool {
@@ -1348,7 +1553,7 @@ type SaveTweetRequest struct {
 func (x *SaveTweetRequest) Reset() {
 	*x = SaveTweetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[21]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1361,7 +1566,7 @@ func (x *SaveTweetRequest) String() string {
 func (*SaveTweetRequest) ProtoMessage() {}
 
 func (x *SaveTweetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[21]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1374,7 +1579,7 @@ func (x *SaveTweetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveTweetRequest.ProtoReflect.Descriptor instead.
 func (*SaveTweetRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{21}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *SaveTweetRequest) GetTweetId() int32 {
@@ -1403,7 +1608,7 @@ type SaveTweetResponse struct {
 func (x *SaveTweetResponse) Reset() {
 	*x = SaveTweetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[22]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1416,7 +1621,7 @@ func (x *SaveTweetResponse) String() string {
 func (*SaveTweetResponse) ProtoMessage() {}
 
 func (x *SaveTweetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[22]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1429,7 +1634,7 @@ func (x *SaveTweetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveTweetResponse.ProtoReflect.Descriptor instead.
 func (*SaveTweetResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{22}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *SaveTweetResponse) GetSuccess() bool {
@@ -1458,7 +1663,7 @@ type RemoveSaveRequest struct {
 func (x *RemoveSaveRequest) Reset() {
 	*x = RemoveSaveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[23]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1471,7 +1676,7 @@ func (x *RemoveSaveRequest) String() string {
 func (*RemoveSaveRequest) ProtoMessage() {}
 
 func (x *RemoveSaveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[23]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1484,7 +1689,7 @@ func (x *RemoveSaveRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveSaveRequest.ProtoReflect.Descriptor instead.
 func (*RemoveSaveRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{23}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *RemoveSaveRequest) GetTweetId() int32 {
@@ -1513,7 +1718,7 @@ type RemoveSaveResponse struct {
 func (x *RemoveSaveResponse) Reset() {
 	*x = RemoveSaveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[24]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1526,7 +1731,7 @@ func (x *RemoveSaveResponse) String() string {
 func (*RemoveSaveResponse) ProtoMessage() {}
 
 func (x *RemoveSaveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[24]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1539,7 +1744,7 @@ func (x *RemoveSaveResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveSaveResponse.ProtoReflect.Descriptor instead.
 func (*RemoveSaveResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{24}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *RemoveSaveResponse) GetSuccess() bool {
@@ -1568,7 +1773,7 @@ type DeleteTweetRequest struct {
 func (x *DeleteTweetRequest) Reset() {
 	*x = DeleteTweetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[25]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1581,7 +1786,7 @@ func (x *DeleteTweetRequest) String() string {
 func (*DeleteTweetRequest) ProtoMessage() {}
 
 func (x *DeleteTweetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[25]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1594,7 +1799,7 @@ func (x *DeleteTweetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTweetRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTweetRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{25}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *DeleteTweetRequest) GetTweetId() int32 {
@@ -1623,7 +1828,7 @@ type DeleteTweetResponse struct {
 func (x *DeleteTweetResponse) Reset() {
 	*x = DeleteTweetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[26]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1636,7 +1841,7 @@ func (x *DeleteTweetResponse) String() string {
 func (*DeleteTweetResponse) ProtoMessage() {}
 
 func (x *DeleteTweetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[26]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1649,7 +1854,7 @@ func (x *DeleteTweetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTweetResponse.ProtoReflect.Descriptor instead.
 func (*DeleteTweetResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{26}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *DeleteTweetResponse) GetSuccess() bool {
@@ -1671,14 +1876,15 @@ type GetTweetByIDRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TweetId     int32 `protobuf:"varint,1,opt,name=tweet_id,json=tweetId,proto3" json:"tweet_id,omitempty"`
-	UserIdToGet int32 `protobuf:"varint,2,opt,name=user_id_to_get,json=userIdToGet,proto3" json:"user_id_to_get,omitempty"`
+	TweetId int32 `protobuf:"varint,1,opt,name=tweet_id,json=tweetId,proto3" json:"tweet_id,omitempty"`
+	// Caller; 0 skips the block and privacy checks for internal lookups.
+	ViewerId int32 `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
 }
 
 func (x *GetTweetByIDRequest) Reset() {
 	*x = GetTweetByIDRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[27]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1691,7 +1897,7 @@ func (x *GetTweetByIDRequest) String() string {
 func (*GetTweetByIDRequest) ProtoMessage() {}
 
 func (x *GetTweetByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[27]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1704,7 +1910,7 @@ func (x *GetTweetByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTweetByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetTweetByIDRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{27}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetTweetByIDRequest) GetTweetId() int32 {
@@ -1714,9 +1920,9 @@ func (x *GetTweetByIDRequest) GetTweetId() int32 {
 	return 0
 }
 
-func (x *GetTweetByIDRequest) GetUserIdToGet() int32 {
+func (x *GetTweetByIDRequest) GetViewerId() int32 {
 	if x != nil {
-		return x.UserIdToGet
+		return x.ViewerId
 	}
 	return 0
 }
@@ -1734,7 +1940,7 @@ type GetTweetByIDResponse struct {
 func (x *GetTweetByIDResponse) Reset() {
 	*x = GetTweetByIDResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_tweet_proto_msgTypes[28]
+		mi := &file_pkg_proto_tweet_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1747,7 +1953,7 @@ func (x *GetTweetByIDResponse) String() string {
 func (*GetTweetByIDResponse) ProtoMessage() {}
 
 func (x *GetTweetByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tweet_proto_msgTypes[28]
+	mi := &file_pkg_proto_tweet_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1760,7 +1966,7 @@ func (x *GetTweetByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTweetByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetTweetByIDResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{28}
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetTweetByIDResponse) GetTweet() *Tweet {
@@ -1784,13 +1990,244 @@ func (x *GetTweetByIDResponse) GetMessage() string {
 	return ""
 }
 
+type GetTweetsByIdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TweetIds []int32 `protobuf:"varint,1,rep,packed,name=tweet_ids,json=tweetIds,proto3" json:"tweet_ids,omitempty"`
+	// Caller; 0 skips the block and privacy checks for internal lookups.
+	ViewerId int32 `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+}
+
+func (x *GetTweetsByIdsRequest) Reset() {
+	*x = GetTweetsByIdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_tweet_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTweetsByIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTweetsByIdsRequest) ProtoMessage() {}
+
+func (x *GetTweetsByIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tweet_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTweetsByIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetTweetsByIdsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetTweetsByIdsRequest) GetTweetIds() []int32 {
+	if x != nil {
+		return x.TweetIds
+	}
+	return nil
+}
+
+func (x *GetTweetsByIdsRequest) GetViewerId() int32 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+// Tweets in the order of the request; unknown tweets and tweets the viewer may
+// not see are skipped.
+type GetTweetsByIdsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Tweets  []*Tweet `protobuf:"bytes,3,rep,name=tweets,proto3" json:"tweets,omitempty"`
+}
+
+func (x *GetTweetsByIdsResponse) Reset() {
+	*x = GetTweetsByIdsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_tweet_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTweetsByIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTweetsByIdsResponse) ProtoMessage() {}
+
+func (x *GetTweetsByIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tweet_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTweetsByIdsResponse.ProtoReflect.Descriptor instead.
+func (*GetTweetsByIdsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetTweetsByIdsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetTweetsByIdsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetTweetsByIdsResponse) GetTweets() []*Tweet {
+	if x != nil {
+		return x.Tweets
+	}
+	return nil
+}
+
+type AdminDeleteTweetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TweetId int32  `protobuf:"varint,1,opt,name=tweet_id,json=tweetId,proto3" json:"tweet_id,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AdminDeleteTweetRequest) Reset() {
+	*x = AdminDeleteTweetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_tweet_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDeleteTweetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDeleteTweetRequest) ProtoMessage() {}
+
+func (x *AdminDeleteTweetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tweet_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDeleteTweetRequest.ProtoReflect.Descriptor instead.
+func (*AdminDeleteTweetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AdminDeleteTweetRequest) GetTweetId() int32 {
+	if x != nil {
+		return x.TweetId
+	}
+	return 0
+}
+
+func (x *AdminDeleteTweetRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminDeleteTweetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *AdminDeleteTweetResponse) Reset() {
+	*x = AdminDeleteTweetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_tweet_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDeleteTweetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDeleteTweetResponse) ProtoMessage() {}
+
+func (x *AdminDeleteTweetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tweet_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDeleteTweetResponse.ProtoReflect.Descriptor instead.
+func (*AdminDeleteTweetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tweet_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AdminDeleteTweetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AdminDeleteTweetResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_pkg_proto_tweet_proto protoreflect.FileDescriptor
 
 var file_pkg_proto_tweet_proto_rawDesc = []byte{
 	0x0a, 0x15, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x77, 0x65, 0x65,
 	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf2, 0x03, 0x0a, 0x05, 0x54, 0x77, 0x65,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa1, 0x04, 0x0a, 0x05, 0x54, 0x77, 0x65,
 	0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07,
@@ -1821,151 +2258,217 @@ var file_pkg_proto_tweet_proto_rawDesc = []byte{
 	0x76, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x73, 0x61,
 	0x76, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x53, 0x61, 0x76,
 	0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x11,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0x30, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x6c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x74, 0x77, 0x65,
-	0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54, 0x77, 0x65, 0x65,
-	0x74, 0x52, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x30, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x6c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x74, 0x77, 0x65,
-	0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54, 0x77, 0x65, 0x65,
-	0x74, 0x52, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x79, 0x0a,
-	0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x1a, 0x0a, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x56, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73,
-	0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x54, 0x6f, 0x47, 0x65, 0x74, 0x22, 0x6d, 0x0a, 0x17, 0x47,
-	0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x06,
-	0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x49, 0x0a, 0x12, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x49, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
-	0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x44, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x47, 0x0a,
-	0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x66, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x69, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77,
-	0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x77,
-	0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x4b, 0x0a,
-	0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x45, 0x0a, 0x0f, 0x41, 0x64,
-	0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
-	0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x22, 0x46, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x2d, 0x0a,
+	0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52,
+	0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xcd, 0x02, 0x0a,
+	0x0a, 0x54, 0x77, 0x65, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6c, 0x75,
+	0x72, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6c, 0x75,
+	0x72, 0x68, 0x61, 0x73, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c, 0x74, 0x5f, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x74, 0x54, 0x65, 0x78, 0x74,
+	0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69,
+	0x61, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0a, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3d, 0x0a,
+	0x0f, 0x52, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6c, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x94, 0x01, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x06, 0x74,
+	0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x6c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x54, 0x77, 0x65,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x94, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x74, 0x77,
+	0x65, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54, 0x77, 0x65,
+	0x65, 0x74, 0x52, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9a, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x49, 0x64, 0x73, 0x22, 0x49, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8a,
+	0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x95, 0x01, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52,
+	0x06, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x49, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x49,
+	0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x44, 0x0a, 0x0e, 0x41, 0x64, 0x64,
+	0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74,
+	0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x45, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x47, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74,
+	0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x48, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
 	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x46, 0x0a, 0x10, 0x53, 0x61, 0x76,
-	0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
-	0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x22, 0x47, 0x0a, 0x11, 0x53, 0x61, 0x76, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x47, 0x0a, 0x11, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x61, 0x76,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x48, 0x0a,
-	0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x66, 0x0a, 0x11, 0x41, 0x64, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x22, 0x48, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x69, 0x0a, 0x14, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17,
 	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x45, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x10, 0x41, 0x64,
+	0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
 	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
 	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x55, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x42, 0x79,
-	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65,
-	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65,
-	0x65, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x5f,
-	0x74, 0x6f, 0x5f, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x54, 0x6f, 0x47, 0x65, 0x74, 0x22, 0x68, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x54, 0x77, 0x65, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1c, 0x0a, 0x05, 0x74, 0x77, 0x65, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x06, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x05, 0x74, 0x77, 0x65, 0x65, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x67, 0x65, 0x22, 0x46, 0x0a, 0x10, 0x53, 0x61, 0x76, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x11, 0x53, 0x61,
+	0x76, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
 	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x32, 0xd9, 0x06, 0x0a, 0x0c, 0x54, 0x77, 0x65, 0x65, 0x74, 0x53, 0x65, 0x72,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x47, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x61, 0x76,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65,
+	0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x12,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x48, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x49, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4d, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x22, 0x68, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x74, 0x77, 0x65, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x06, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x05, 0x74, 0x77, 0x65, 0x65, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x51, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74,
+	0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69,
+	0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x77,
+	0x65, 0x65, 0x74, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x06, 0x74,
+	0x77, 0x65, 0x65, 0x74, 0x73, 0x22, 0x4c, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x4e, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x32, 0xe9, 0x07, 0x0a, 0x0c, 0x54, 0x77, 0x65, 0x65, 0x74, 0x53, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x77,
 	0x65, 0x65, 0x74, 0x12, 0x13, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65,
 	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
@@ -2010,15 +2513,24 @@ var file_pkg_proto_tweet_proto_rawDesc = []byte{
 	0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65,
 	0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x47,
 	0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65,
-	0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x61, 0x76,
-	0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x17, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65,
+	0x74, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65,
+	0x65, 0x74, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x47,
-	0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x54,
-	0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
+	0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x54,
+	0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x43, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74,
+	0x73, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x4c,
+	0x69, 0x6b, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x18, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
 	0x0c, 0x5a, 0x0a, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x06, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
@@ -2035,78 +2547,91 @@ func file_pkg_proto_tweet_proto_rawDescGZIP() []byte {
 	return file_pkg_proto_tweet_proto_rawDescData
 }
 
-var file_pkg_proto_tweet_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_pkg_proto_tweet_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_pkg_proto_tweet_proto_goTypes = []any{
-	(*Tweet)(nil),                   // 0: Tweet
-	(*GetSavedTweetsRequest)(nil),   // 1: GetSavedTweetsRequest
-	(*GetSavedTweetsResponse)(nil),  // 2: GetSavedTweetsResponse
-	(*GetLikedTweetsRequest)(nil),   // 3: GetLikedTweetsRequest
-	(*GetLikedTweetsResponse)(nil),  // 4: GetLikedTweetsResponse
-	(*CreateTweetRequest)(nil),      // 5: CreateTweetRequest
-	(*CreateTweetResponse)(nil),     // 6: CreateTweetResponse
-	(*GetTweetsByUserRequest)(nil),  // 7: GetTweetsByUserRequest
-	(*GetTweetsByUserResponse)(nil), // 8: GetTweetsByUserResponse
-	(*UpdateTweetRequest)(nil),      // 9: UpdateTweetRequest
-	(*UpdateTweetResponse)(nil),     // 10: UpdateTweetResponse
-	(*AddLikeRequest)(nil),          // 11: AddLikeRequest
-	(*AddLikeResponse)(nil),         // 12: AddLikeResponse
-	(*RemoveLikeRequest)(nil),       // 13: RemoveLikeRequest
-	(*RemoveLikeResponse)(nil),      // 14: RemoveLikeResponse
-	(*AddCommentRequest)(nil),       // 15: AddCommentRequest
-	(*AddCommentResponse)(nil),      // 16: AddCommentResponse
-	(*RemoveCommentRequest)(nil),    // 17: RemoveCommentRequest
-	(*RemoveCommentResponse)(nil),   // 18: RemoveCommentResponse
-	(*AddShareRequest)(nil),         // 19: AddShareRequest
-	(*AddShareResponse)(nil),        // 20: AddShareResponse
-	(*SaveTweetRequest)(nil),        // 21: SaveTweetRequest
-	(*SaveTweetResponse)(nil),       // 22: SaveTweetResponse
-	(*RemoveSaveRequest)(nil),       // 23: RemoveSaveRequest
-	(*RemoveSaveResponse)(nil),      // 24: RemoveSaveResponse
-	(*DeleteTweetRequest)(nil),      // 25: DeleteTweetRequest
-	(*DeleteTweetResponse)(nil),     // 26: DeleteTweetResponse
-	(*GetTweetByIDRequest)(nil),     // 27: GetTweetByIDRequest
-	(*GetTweetByIDResponse)(nil),    // 28: GetTweetByIDResponse
-	(*timestamppb.Timestamp)(nil),   // 29: google.protobuf.Timestamp
+	(*Tweet)(nil),                    // 0: Tweet
+	(*TweetMedia)(nil),               // 1: TweetMedia
+	(*GetSavedTweetsRequest)(nil),    // 2: GetSavedTweetsRequest
+	(*GetSavedTweetsResponse)(nil),   // 3: GetSavedTweetsResponse
+	(*GetLikedTweetsRequest)(nil),    // 4: GetLikedTweetsRequest
+	(*GetLikedTweetsResponse)(nil),   // 5: GetLikedTweetsResponse
+	(*CreateTweetRequest)(nil),       // 6: CreateTweetRequest
+	(*CreateTweetResponse)(nil),      // 7: CreateTweetResponse
+	(*GetTweetsByUserRequest)(nil),   // 8: GetTweetsByUserRequest
+	(*GetTweetsByUserResponse)(nil),  // 9: GetTweetsByUserResponse
+	(*UpdateTweetRequest)(nil),       // 10: UpdateTweetRequest
+	(*UpdateTweetResponse)(nil),      // 11: UpdateTweetResponse
+	(*AddLikeRequest)(nil),           // 12: AddLikeRequest
+	(*AddLikeResponse)(nil),          // 13: AddLikeResponse
+	(*RemoveLikeRequest)(nil),        // 14: RemoveLikeRequest
+	(*RemoveLikeResponse)(nil),       // 15: RemoveLikeResponse
+	(*AddCommentRequest)(nil),        // 16: AddCommentRequest
+	(*AddCommentResponse)(nil),       // 17: AddCommentResponse
+	(*RemoveCommentRequest)(nil),     // 18: RemoveCommentRequest
+	(*RemoveCommentResponse)(nil),    // 19: RemoveCommentResponse
+	(*AddShareRequest)(nil),          // 20: AddShareRequest
+	(*AddShareResponse)(nil),         // 21: AddShareResponse
+	(*SaveTweetRequest)(nil),         // 22: SaveTweetRequest
+	(*SaveTweetResponse)(nil),        // 23: SaveTweetResponse
+	(*RemoveSaveRequest)(nil),        // 24: RemoveSaveRequest
+	(*RemoveSaveResponse)(nil),       // 25: RemoveSaveResponse
+	(*DeleteTweetRequest)(nil),       // 26: DeleteTweetRequest
+	(*DeleteTweetResponse)(nil),      // 27: DeleteTweetResponse
+	(*GetTweetByIDRequest)(nil),      // 28: GetTweetByIDRequest
+	(*GetTweetByIDResponse)(nil),     // 29: GetTweetByIDResponse
+	(*GetTweetsByIdsRequest)(nil),    // 30: GetTweetsByIdsRequest
+	(*GetTweetsByIdsResponse)(nil),   // 31: GetTweetsByIdsResponse
+	(*AdminDeleteTweetRequest)(nil),  // 32: AdminDeleteTweetRequest
+	(*AdminDeleteTweetResponse)(nil), // 33: AdminDeleteTweetResponse
+	nil,                              // 34: TweetMedia.RenditionsEntry
+	(*timestamppb.Timestamp)(nil),    // 35: google.protobuf.Timestamp
 }
 var file_pkg_proto_tweet_proto_depIdxs = []int32{
-	29, // 0: Tweet.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 1: GetSavedTweetsResponse.tweets:type_name -> Tweet
-	0,  // 2: GetLikedTweetsResponse.tweets:type_name -> Tweet
-	0,  // 3: GetTweetsByUserResponse.tweets:type_name -> Tweet
-	0,  // 4: GetTweetByIDResponse.tweet:type_name -> Tweet
-	5,  // 5: TweetService.CreateTweet:input_type -> CreateTweetRequest
-	7,  // 6: TweetService.GetTweetsByUser:input_type -> GetTweetsByUserRequest
-	9,  // 7: TweetService.UpdateTweet:input_type -> UpdateTweetRequest
-	25, // 8: TweetService.DeleteTweet:input_type -> DeleteTweetRequest
-	11, // 9: TweetService.AddLike:input_type -> AddLikeRequest
-	13, // 10: TweetService.RemoveLike:input_type -> RemoveLikeRequest
-	15, // 11: TweetService.AddComment:input_type -> AddCommentRequest
-	17, // 12: TweetService.RemoveComment:input_type -> RemoveCommentRequest
-	19, // 13: TweetService.AddShare:input_type -> AddShareRequest
-	21, // 14: TweetService.SaveTweet:input_type -> SaveTweetRequest
-	23, // 15: TweetService.RemoveSave:input_type -> RemoveSaveRequest
-	27, // 16: TweetService.GetTweetByID:input_type -> GetTweetByIDRequest
-	1,  // 17: TweetService.GetSavedTweets:input_type -> GetSavedTweetsRequest
-	3,  // 18: TweetService.GetLikedTweets:input_type -> GetLikedTweetsRequest
-	6,  // 19: TweetService.CreateTweet:output_type -> CreateTweetResponse
-	8,  // 20: TweetService.GetTweetsByUser:output_type -> GetTweetsByUserResponse
-	10, // 21: TweetService.UpdateTweet:output_type -> UpdateTweetResponse
-	26, // 22: TweetService.DeleteTweet:output_type -> DeleteTweetResponse
-	12, // 23: TweetService.AddLike:output_type -> AddLikeResponse
-	14, // 24: TweetService.RemoveLike:output_type -> RemoveLikeResponse
-	16, // 25: TweetService.AddComment:output_type -> AddCommentResponse
-	18, // 26: TweetService.RemoveComment:output_type -> RemoveCommentResponse
-	20, // 27: TweetService.AddShare:output_type -> AddShareResponse
-	22, // 28: TweetService.SaveTweet:output_type -> SaveTweetResponse
-	24, // 29: TweetService.RemoveSave:output_type -> RemoveSaveResponse
-	28, // 30: TweetService.GetTweetByID:output_type -> GetTweetByIDResponse
-	2,  // 31: TweetService.GetSavedTweets:output_type -> GetSavedTweetsResponse
-	4,  // 32: TweetService.GetLikedTweets:output_type -> GetLikedTweetsResponse
-	19, // [19:33] is the sub-list for method output_type
-	5,  // [5:19] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	35, // 0: Tweet.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 1: Tweet.attachments:type_name -> TweetMedia
+	34, // 2: TweetMedia.renditions:type_name -> TweetMedia.RenditionsEntry
+	0,  // 3: GetSavedTweetsResponse.tweets:type_name -> Tweet
+	0,  // 4: GetLikedTweetsResponse.tweets:type_name -> Tweet
+	0,  // 5: GetTweetsByUserResponse.tweets:type_name -> Tweet
+	0,  // 6: GetTweetByIDResponse.tweet:type_name -> Tweet
+	0,  // 7: GetTweetsByIdsResponse.tweets:type_name -> Tweet
+	6,  // 8: TweetService.CreateTweet:input_type -> CreateTweetRequest
+	8,  // 9: TweetService.GetTweetsByUser:input_type -> GetTweetsByUserRequest
+	10, // 10: TweetService.UpdateTweet:input_type -> UpdateTweetRequest
+	26, // 11: TweetService.DeleteTweet:input_type -> DeleteTweetRequest
+	12, // 12: TweetService.AddLike:input_type -> AddLikeRequest
+	14, // 13: TweetService.RemoveLike:input_type -> RemoveLikeRequest
+	16, // 14: TweetService.AddComment:input_type -> AddCommentRequest
+	18, // 15: TweetService.RemoveComment:input_type -> RemoveCommentRequest
+	20, // 16: TweetService.AddShare:input_type -> AddShareRequest
+	22, // 17: TweetService.SaveTweet:input_type -> SaveTweetRequest
+	24, // 18: TweetService.RemoveSave:input_type -> RemoveSaveRequest
+	28, // 19: TweetService.GetTweetByID:input_type -> GetTweetByIDRequest
+	30, // 20: TweetService.GetTweetsByIds:input_type -> GetTweetsByIdsRequest
+	2,  // 21: TweetService.GetSavedTweets:input_type -> GetSavedTweetsRequest
+	4,  // 22: TweetService.GetLikedTweets:input_type -> GetLikedTweetsRequest
+	32, // 23: TweetService.AdminDeleteTweet:input_type -> AdminDeleteTweetRequest
+	7,  // 24: TweetService.CreateTweet:output_type -> CreateTweetResponse
+	9,  // 25: TweetService.GetTweetsByUser:output_type -> GetTweetsByUserResponse
+	11, // 26: TweetService.UpdateTweet:output_type -> UpdateTweetResponse
+	27, // 27: TweetService.DeleteTweet:output_type -> DeleteTweetResponse
+	13, // 28: TweetService.AddLike:output_type -> AddLikeResponse
+	15, // 29: TweetService.RemoveLike:output_type -> RemoveLikeResponse
+	17, // 30: TweetService.AddComment:output_type -> AddCommentResponse
+	19, // 31: TweetService.RemoveComment:output_type -> RemoveCommentResponse
+	21, // 32: TweetService.AddShare:output_type -> AddShareResponse
+	23, // 33: TweetService.SaveTweet:output_type -> SaveTweetResponse
+	25, // 34: TweetService.RemoveSave:output_type -> RemoveSaveResponse
+	29, // 35: TweetService.GetTweetByID:output_type -> GetTweetByIDResponse
+	31, // 36: TweetService.GetTweetsByIds:output_type -> GetTweetsByIdsResponse
+	3,  // 37: TweetService.GetSavedTweets:output_type -> GetSavedTweetsResponse
+	5,  // 38: TweetService.GetLikedTweets:output_type -> GetLikedTweetsResponse
+	33, // 39: TweetService.AdminDeleteTweet:output_type -> AdminDeleteTweetResponse
+	24, // [24:40] is the sub-list for method output_type
+	8,  // [8:24] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_tweet_proto_init() }
@@ -2128,7 +2653,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*GetSavedTweetsRequest); i {
+			switch v := v.(*TweetMedia); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2140,7 +2665,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*GetSavedTweetsResponse); i {
+			switch v := v.(*GetSavedTweetsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2152,7 +2677,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*GetLikedTweetsRequest); i {
+			switch v := v.(*GetSavedTweetsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2164,7 +2689,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*GetLikedTweetsResponse); i {
+			switch v := v.(*GetLikedTweetsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2176,7 +2701,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateTweetRequest); i {
+			switch v := v.(*GetLikedTweetsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2188,7 +2713,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateTweetResponse); i {
+			switch v := v.(*CreateTweetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2200,7 +2725,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*GetTweetsByUserRequest); i {
+			switch v := v.(*CreateTweetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2212,7 +2737,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*GetTweetsByUserResponse); i {
+			switch v := v.(*GetTweetsByUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2224,7 +2749,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateTweetRequest); i {
+			switch v := v.(*GetTweetsByUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2236,7 +2761,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateTweetResponse); i {
+			switch v := v.(*UpdateTweetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2248,7 +2773,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*AddLikeRequest); i {
+			switch v := v.(*UpdateTweetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2260,7 +2785,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*AddLikeResponse); i {
+			switch v := v.(*AddLikeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2272,7 +2797,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*RemoveLikeRequest); i {
+			switch v := v.(*AddLikeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2284,7 +2809,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*RemoveLikeResponse); i {
+			switch v := v.(*RemoveLikeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2296,7 +2821,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*AddCommentRequest); i {
+			switch v := v.(*RemoveLikeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2308,7 +2833,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*AddCommentResponse); i {
+			switch v := v.(*AddCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2320,7 +2845,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*RemoveCommentRequest); i {
+			switch v := v.(*AddCommentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2332,7 +2857,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*RemoveCommentResponse); i {
+			switch v := v.(*RemoveCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2344,7 +2869,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*AddShareRequest); i {
+			switch v := v.(*RemoveCommentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2356,7 +2881,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*AddShareResponse); i {
+			switch v := v.(*AddShareRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2368,7 +2893,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*SaveTweetRequest); i {
+			switch v := v.(*AddShareResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2380,7 +2905,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*SaveTweetResponse); i {
+			switch v := v.(*SaveTweetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2392,7 +2917,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*RemoveSaveRequest); i {
+			switch v := v.(*SaveTweetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2404,7 +2929,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*RemoveSaveResponse); i {
+			switch v := v.(*RemoveSaveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2416,7 +2941,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteTweetRequest); i {
+			switch v := v.(*RemoveSaveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2428,7 +2953,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteTweetResponse); i {
+			switch v := v.(*DeleteTweetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2440,7 +2965,7 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*GetTweetByIDRequest); i {
+			switch v := v.(*DeleteTweetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2452,6 +2977,18 @@ func file_pkg_proto_tweet_proto_init() {
 			}
 		}
 		file_pkg_proto_tweet_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTweetByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_tweet_proto_msgTypes[29].Exporter = func(v any, i int) any {
 			switch v := v.(*GetTweetByIDResponse); i {
 			case 0:
 				return &v.state
@@ -2463,6 +3000,54 @@ func file_pkg_proto_tweet_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_proto_tweet_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTweetsByIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_tweet_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTweetsByIdsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_tweet_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*AdminDeleteTweetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_tweet_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*AdminDeleteTweetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2470,7 +3055,7 @@ func file_pkg_proto_tweet_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_proto_tweet_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   29,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
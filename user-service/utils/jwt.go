@@ -1,18 +1,46 @@
 package utils
 
 import (
-	"os"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/hex"
 	"time"
 
 	jwt "github.com/dgrijalva/jwt-go"
 )
 
-func GenerateJWT(userID int, username string) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+const (
+	AccessTokenTTL  = 15 * time.Minute
+	RefreshTokenTTL = 30 * 24 * time.Hour
+)
+
+// GenerateJWT qisqa muddatli access token yaratadi va kid kaliti bilan RS256 da imzolaydi, jti - sessiya ID si
+func GenerateJWT(kid string, key *rsa.PrivateKey, userID int, username, role, sessionID string) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
 		"user_id":  userID,
 		"username": username,
-		"exp":      time.Now().Add(time.Hour * 24).Unix(),
+		"role":     role,
+		"jti":      sessionID,
+		"exp":      time.Now().Add(AccessTokenTTL).Unix(),
 	})
 
-	return token.SignedString([]byte(os.Getenv("JWT_SECRET")))
+	token.Header["kid"] = kid
+
+	return token.SignedString(key)
+}
+
+// GenerateToken n baytli tasodifiy hex satr qaytaradi
+func GenerateToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// HashToken refresh tokenni Redisda ochiq saqlamaslik uchun hash qiladi
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
 }
@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// sealedPrefix shifrlangan qiymat formati versiyasi, ochiq matndan ajratish uchun ham
+const sealedPrefix = "v1:"
+
+// SecretBox bazada saqlanadigan maxfiy qiymatlarni (TOTP secret) server kaliti
+// bilan AES-256-GCM da shifrlaydi. Bazadan o'qish sizib chiqsa ham kalitsiz
+// secretlarni tiklab bo'lmaydi.
+type SecretBox struct {
+	aead cipher.AEAD
+}
+
+// NewSecretBox base64 dagi 32 baytlik kalitni qabul qiladi
+func NewSecretBox(key string) (*SecretBox, error) {
+	if strings.TrimSpace(key) == "" {
+		return nil, errors.New("encryption key is not set, generate one with: openssl rand -base64 32")
+	}
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(key))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding encryption key: %v", err)
+	}
+	if len(raw) != 32 {
+		return nil, fmt.Errorf("encryption key must be 32 bytes, got %d", len(raw))
+	}
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &SecretBox{aead: aead}, nil
+}
+
+// Seal qiymatni shifrlaydi. aad (masalan "user_totp:42") shifrlangan matnni
+// qatorga bog'laydi: boshqa foydalanuvchi qatoriga ko'chirilgan secret ochilmaydi.
+func (b *SecretBox) Seal(plaintext string, aad []byte) (string, error) {
+	nonce := make([]byte, b.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := b.aead.Seal(nonce, nonce, []byte(plaintext), aad)
+	return sealedPrefix + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// Open Seal qaytargan qiymatni ochadi
+func (b *SecretBox) Open(sealed string, aad []byte) (string, error) {
+	encoded, ok := strings.CutPrefix(sealed, sealedPrefix)
+	if !ok {
+		return "", errors.New("value is not sealed")
+	}
+	data, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", err
+	}
+	if len(data) < b.aead.NonceSize() {
+		return "", errors.New("sealed value is too short")
+	}
+	nonce, ciphertext := data[:b.aead.NonceSize()], data[b.aead.NonceSize():]
+	plaintext, err := b.aead.Open(nil, nonce, ciphertext, aad)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// IsSealed qiymat Seal bilan shifrlanganini tekshiradi
+func IsSealed(value string) bool {
+	return strings.HasPrefix(value, sealedPrefix)
+}
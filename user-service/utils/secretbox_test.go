@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func newTestBox(t *testing.T) *SecretBox {
+	t.Helper()
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatal(err)
+	}
+	box, err := NewSecretBox(base64.StdEncoding.EncodeToString(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return box
+}
+
+func TestSecretBox(t *testing.T) {
+	box := newTestBox(t)
+	aad := []byte("user_totp:7")
+
+	sealed, err := box.Seal("JBSWY3DPEHPK3PXP", aad)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsSealed(sealed) || strings.Contains(sealed, "JBSWY3DPEHPK3PXP") {
+		t.Fatalf("sealed value %q", sealed)
+	}
+	if again, _ := box.Seal("JBSWY3DPEHPK3PXP", aad); again == sealed {
+		t.Error("nonce reused")
+	}
+	if opened, err := box.Open(sealed, aad); err != nil || opened != "JBSWY3DPEHPK3PXP" {
+		t.Fatalf("Open = %q, %v", opened, err)
+	}
+
+	// boshqa qatorga ko'chirilgan, boshqa kalit bilan yoki buzilgan qiymat ochilmaydi
+	if _, err := box.Open(sealed, []byte("user_totp:8")); err == nil {
+		t.Error("opened with another aad")
+	}
+	if _, err := newTestBox(t).Open(sealed, aad); err == nil {
+		t.Error("opened with another key")
+	}
+	tampered := sealed[:len(sealed)-2] + "AA"
+	if tampered == sealed {
+		tampered = sealed[:len(sealed)-2] + "BB"
+	}
+	for _, value := range []string{tampered, "JBSWY3DPEHPK3PXP", "v1:", "v1:!!"} {
+		if _, err := box.Open(value, aad); err == nil {
+			t.Errorf("Open(%q) succeeded", value)
+		}
+	}
+}
+
+func TestNewSecretBox(t *testing.T) {
+	for _, key := range []string{"", "  ", "not base64", base64.StdEncoding.EncodeToString(make([]byte, 16))} {
+		if _, err := NewSecretBox(key); err == nil {
+			t.Errorf("NewSecretBox(%q) accepted", key)
+		}
+	}
+}
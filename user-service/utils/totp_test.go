@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+// RFC 6238 ilova B dagi SHA1 vektorlari (oxirgi 6 raqam)
+var rfcSecret = totpEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestTOTPCode(t *testing.T) {
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, tt := range tests {
+		got, err := TOTPCode(rfcSecret, tt.unix/TOTPPeriod)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("TOTPCode at %d = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+	if _, err := TOTPCode("not base32!", 1); err == nil {
+		t.Error("invalid secret accepted")
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	current := now.Unix() / TOTPPeriod
+	code := func(step int64) string {
+		c, err := TOTPCode(rfcSecret, step)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+
+	for _, step := range []int64{current, current - 1, current + 1} {
+		if got, ok := ValidateTOTP(rfcSecret, code(step), now); !ok || got != step {
+			t.Errorf("code for step %d: got step %d, ok %v", step, got, ok)
+		}
+	}
+	if _, ok := ValidateTOTP(rfcSecret, " "+code(current)+" ", now); !ok {
+		t.Error("code with surrounding spaces rejected")
+	}
+	for _, c := range []string{code(current - 2), code(current + 2), "", "12345", "1234567"} {
+		if _, ok := ValidateTOTP(rfcSecret, c, now); ok {
+			t.Errorf("code %q accepted", c)
+		}
+	}
+}